@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"encoding/json"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+)
+
+// Some services accept large, deeply nested settings that are exposed as JSON
+// documents in the format of the service API rather than as nested blocks.
+
+// apiObjectJsonAreEquivalent decodes both JSON documents into their API structures, discarding
+// unknown keys and formatting differences. The API populates omitted settings with their
+// default values, so values that are only present in the API document are ignored.
+func apiObjectJsonAreEquivalent(apiJson, configuredJson string, apiObject1, apiObject2 interface{}) (bool, error) {
+	canonicalJson1, err := canonicalizeApiObjectJson(apiJson, apiObject1)
+	if err != nil {
+		return false, err
+	}
+
+	canonicalJson2, err := canonicalizeApiObjectJson(configuredJson, apiObject2)
+	if err != nil {
+		return false, err
+	}
+
+	var apiValue, configuredValue interface{}
+
+	if err := json.Unmarshal(canonicalJson1, &apiValue); err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal(canonicalJson2, &configuredValue); err != nil {
+		return false, err
+	}
+
+	equal := apiObjectJsonValueContains(apiValue, configuredValue)
+	if !equal {
+		log.Printf("[DEBUG] Canonical API object JSON documents are not equivalent.\nAPI: %s\nConfigured: %s\n",
+			canonicalJson1, canonicalJson2)
+	}
+	return equal, nil
+}
+
+// apiObjectJsonValueContains reports whether every value in configuredValue is present in apiValue.
+// Lists must have the same length and are compared element by element.
+func apiObjectJsonValueContains(apiValue, configuredValue interface{}) bool {
+	switch configured := configuredValue.(type) {
+	case map[string]interface{}:
+		api, ok := apiValue.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for k, v := range configured {
+			if !apiObjectJsonValueContains(api[k], v) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		api, ok := apiValue.([]interface{})
+		if !ok || len(api) != len(configured) {
+			return false
+		}
+
+		for i, v := range configured {
+			if !apiObjectJsonValueContains(api[i], v) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(apiValue, configuredValue)
+	}
+}
+
+func canonicalizeApiObjectJson(s string, apiObject interface{}) ([]byte, error) {
+	if err := expandApiObjectJson(s, apiObject); err != nil {
+		return nil, err
+	}
+
+	return jsonutil.BuildJSON(apiObject)
+}
+
+// expandApiObjectJson decodes a JSON document using the API field names into apiObject.
+func expandApiObjectJson(s string, apiObject interface{}) error {
+	return jsonutil.UnmarshalJSON(apiObject, strings.NewReader(s))
+}
+
+// flattenApiObjectJson encodes apiObject into a JSON document using the API field names.
+func flattenApiObjectJson(apiObject interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// assumeRoleProvider is an AWS Go SDK credentials provider which calls the
+// STS AssumeRole API with the provider assume_role options that the
+// shared AWS Go SDK session handling does not support, such as policy_arns
+// and session tags.
+type assumeRoleProvider struct {
+	credentials.Expiry
+
+	client            *sts.STS
+	duration          time.Duration
+	externalID        string
+	policy            string
+	policyARNs        []string
+	roleARN           string
+	sessionName       string
+	tags              map[string]string
+	transitiveTagKeys []string
+}
+
+// Retrieve returns new temporary credentials from the STS AssumeRole API.
+func (p *assumeRoleProvider) Retrieve() (credentials.Value, error) {
+	sessionName := p.sessionName
+	if sessionName == "" {
+		sessionName = strconv.FormatInt(time.Now().UTC().UnixNano(), 10)
+	}
+
+	duration := p.duration
+	if duration == 0 {
+		duration = stscreds.DefaultDuration
+	}
+
+	input := &sts.AssumeRoleInput{
+		DurationSeconds: aws.Int64(int64(duration / time.Second)),
+		RoleArn:         aws.String(p.roleARN),
+		RoleSessionName: aws.String(sessionName),
+	}
+
+	if p.externalID != "" {
+		input.ExternalId = aws.String(p.externalID)
+	}
+
+	if p.policy != "" {
+		input.Policy = aws.String(p.policy)
+	}
+
+	for _, policyARN := range p.policyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	keys := make([]string, 0, len(p.tags))
+	for k := range p.tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		input.Tags = append(input.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(p.tags[k]),
+		})
+	}
+
+	if len(p.transitiveTagKeys) > 0 {
+		input.TransitiveTagKeys = aws.StringSlice(p.transitiveTagKeys)
+	}
+
+	output, err := p.client.AssumeRole(input)
+
+	if err != nil {
+		return credentials.Value{ProviderName: stscreds.ProviderName}, err
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), 0)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    stscreds.ProviderName,
+	}, nil
+}
+
+// assumeRoleExtended returns whether any assume_role options are configured
+// which require the provider to assume the role itself.
+func (c *Config) assumeRoleExtended() bool {
+	return c.AssumeRoleARN != "" && (c.AssumeRoleDurationSeconds > 0 ||
+		len(c.AssumeRolePolicyARNs) > 0 ||
+		len(c.AssumeRoleTags) > 0 ||
+		len(c.AssumeRoleTransitiveTagKeys) > 0)
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestAssumeRoleProvider_Retrieve(t *testing.T) {
+	var requestBody url.Values
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(500)
+			return
+		}
+
+		requestBody, err = url.ParseQuery(string(body))
+		if err != nil {
+			w.WriteHeader(500)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, testAssumeRoleResponse, time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339))
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:    aws.String(ts.URL),
+		Region:      aws.String("us-east-1"),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	provider := &assumeRoleProvider{
+		client:            sts.New(sess),
+		duration:          3600 * time.Second,
+		externalID:        "external-id",
+		policyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		roleARN:           "arn:aws:iam::123456789012:role/test",
+		sessionName:       "session-name",
+		tags:              map[string]string{"Project": "test", "CostCenter": "1234"},
+		transitiveTagKeys: []string{"Project"},
+	}
+
+	value, err := provider.Retrieve()
+	if err != nil {
+		t.Fatalf("error retrieving credentials: %s", err)
+	}
+
+	if value.AccessKeyID != "ASIAEXAMPLE" {
+		t.Errorf("expected AccessKeyID %q, got %q", "ASIAEXAMPLE", value.AccessKeyID)
+	}
+
+	if provider.IsExpired() {
+		t.Errorf("expected credentials not to be expired")
+	}
+
+	expected := map[string]string{
+		"Action":                     "AssumeRole",
+		"DurationSeconds":            "3600",
+		"ExternalId":                 "external-id",
+		"PolicyArns.member.1.arn":    "arn:aws:iam::aws:policy/ReadOnlyAccess",
+		"RoleArn":                    "arn:aws:iam::123456789012:role/test",
+		"RoleSessionName":            "session-name",
+		"Tags.member.1.Key":          "CostCenter",
+		"Tags.member.1.Value":        "1234",
+		"Tags.member.2.Key":          "Project",
+		"Tags.member.2.Value":        "test",
+		"TransitiveTagKeys.member.1": "Project",
+	}
+
+	for k, v := range expected {
+		if got := requestBody.Get(k); got != v {
+			t.Errorf("expected request parameter %s to be %q, got %q", k, v, got)
+		}
+	}
+}
+
+func TestConfigAssumeRoleExtended(t *testing.T) {
+	cases := []struct {
+		Config   Config
+		Expected bool
+	}{
+		{
+			Config:   Config{},
+			Expected: false,
+		},
+		{
+			Config:   Config{AssumeRoleARN: "arn:aws:iam::123456789012:role/test", AssumeRoleSessionName: "test"},
+			Expected: false,
+		},
+		{
+			Config:   Config{AssumeRoleDurationSeconds: 3600},
+			Expected: false,
+		},
+		{
+			Config:   Config{AssumeRoleARN: "arn:aws:iam::123456789012:role/test", AssumeRoleDurationSeconds: 3600},
+			Expected: true,
+		},
+		{
+			Config:   Config{AssumeRoleARN: "arn:aws:iam::123456789012:role/test", AssumeRolePolicyARNs: []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}},
+			Expected: true,
+		},
+		{
+			Config:   Config{AssumeRoleARN: "arn:aws:iam::123456789012:role/test", AssumeRoleTags: map[string]string{"Project": "test"}},
+			Expected: true,
+		},
+		{
+			Config:   Config{AssumeRoleARN: "arn:aws:iam::123456789012:role/test", AssumeRoleTransitiveTagKeys: []string{"Project"}},
+			Expected: true,
+		},
+	}
+
+	for i, tc := range cases {
+		if got := tc.Config.assumeRoleExtended(); got != tc.Expected {
+			t.Errorf("case %d: expected %t, got %t", i, tc.Expected, got)
+		}
+	}
+}
+
+const testAssumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/test/session-name</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:session-name</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+      <SecretAccessKey>secretKey</SecretAccessKey>
+      <SessionToken>sessionToken</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
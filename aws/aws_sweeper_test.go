@@ -1,17 +1,59 @@
 package aws
 
 import (
+	"flag"
 	"fmt"
+	"log"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
+// The -sweep and -sweep-run flags are defined by the helper/resource package,
+// but sweepers are registered with and run by the sweep package.
+var (
+	flagSweepDryRun             = flag.Bool("sweep-dry-run", false, "List the resources the sweepers would delete, without deleting them")
+	flagSweepParallelism        = flag.Int("sweep-parallelism", 4, "Maximum number of sweepers to run concurrently")
+	flagSweepServiceConcurrency = flag.Int("sweep-service-concurrency", 4, "Maximum number of concurrent sweeper requests to each AWS service")
+)
+
+// sweepRunner is the sweep.Runner configured from the command line flags.
+var sweepRunner = &sweep.Runner{}
+
 func TestMain(m *testing.M) {
+	flag.Parse()
+
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		os.Exit(runSweepers(regions, flag.Lookup("sweep-run").Value.String()))
+	}
+
 	resource.TestMain(m)
 }
 
+// runSweepers runs the sweepers matching the filter in each of the comma
+// separated regions and returns the process exit code.
+func runSweepers(regions, filter string) int {
+	sweepRunner.DryRun = *flagSweepDryRun
+	sweepRunner.Parallelism = *flagSweepParallelism
+	sweepRunner.ServiceConcurrency = *flagSweepServiceConcurrency
+
+	exitCode := 0
+
+	for _, region := range strings.Split(regions, ",") {
+		region = strings.TrimSpace(region)
+
+		if err := sweepRunner.Run(region, filter); err != nil {
+			log.Printf("[ERR] error running sweepers in region (%s): %s", region, err)
+			exitCode = 1
+		}
+	}
+
+	return exitCode
+}
+
 // sharedClientForRegion returns a common AWSClient setup needed for the sweeper
 // functions for a given region
 func sharedClientForRegion(region string) (interface{}, error) {
@@ -20,8 +62,9 @@ func sharedClientForRegion(region string) (interface{}, error) {
 	}
 
 	conf := &Config{
-		MaxRetries: 5,
-		Region:     region,
+		MaxRetries:      5,
+		Region:          region,
+		requestHandlers: sweepRunner.ConfigureHandlers,
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -32,3 +75,12 @@ func sharedClientForRegion(region string) (interface{}, error) {
 
 	return client, nil
 }
+
+// testSweepDryRun returns whether the sweepers are doing a dry run. Requests
+// which modify resources are not sent during a dry run, so sweepers must not
+// wait for the deletion of resources to complete. Requests made by functions
+// which also wait should instead be recorded with
+// sweepRunner.RecordDryRunRequest.
+func testSweepDryRun() bool {
+	return sweepRunner.DryRun
+}
@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/batch"
+)
+
+// batchJobDefinitionContainerPropertiesAreEquivalent determines equality between two Batch container properties JSON strings
+func batchJobDefinitionContainerPropertiesAreEquivalent(props1, props2 string) (bool, error) {
+	canonicalJson1, err := canonicalizeBatchContainerProperties(props1)
+	if err != nil {
+		return false, err
+	}
+
+	canonicalJson2, err := canonicalizeBatchContainerProperties(props2)
+	if err != nil {
+		return false, err
+	}
+
+	equal := bytes.Equal(canonicalJson1, canonicalJson2)
+	if !equal {
+		log.Printf("[DEBUG] Canonical Batch container properties are not equal.\nFirst: %s\nSecond: %s\n",
+			canonicalJson1, canonicalJson2)
+	}
+	return equal, nil
+}
+
+func canonicalizeBatchContainerProperties(props string) ([]byte, error) {
+	var cp *batch.ContainerProperties
+	if err := json.Unmarshal([]byte(props), &cp); err != nil {
+		return nil, err
+	}
+
+	if cp == nil {
+		cp = &batch.ContainerProperties{}
+	}
+
+	reduceBatchContainerProperties(cp)
+
+	return jsonutil.BuildJSON(cp)
+}
+
+// reduceBatchContainerProperties removes the differences between container
+// properties as configured and as returned by the API.
+func reduceBatchContainerProperties(cp *batch.ContainerProperties) {
+	// The API does not return environment variables with empty values
+	environment := make([]*batch.KeyValuePair, 0, len(cp.Environment))
+	for _, kvp := range cp.Environment {
+		if aws.StringValue(kvp.Value) != "" {
+			environment = append(environment, kvp)
+		}
+	}
+	cp.Environment = environment
+
+	// Deal with fields which may be re-ordered in the API
+	sort.Slice(cp.Environment, func(i, j int) bool {
+		return aws.StringValue(cp.Environment[i].Name) < aws.StringValue(cp.Environment[j].Name)
+	})
+
+	// The API returns empty lists for lists which are not configured, so set
+	// all empty slices to nil
+	properties := reflect.ValueOf(cp).Elem()
+	for i := 0; i < properties.NumField(); i++ {
+		sf := properties.Field(i)
+
+		if sf.Kind() == reflect.Slice && !sf.IsNil() && sf.Len() == 0 {
+			sf.Set(reflect.Zero(sf.Type()))
+		}
+	}
+}
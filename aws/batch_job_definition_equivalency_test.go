@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestBatchJobDefinitionContainerPropertiesAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Props1        string
+		Props2        string
+		ExpectEqual   bool
+		ExpectedError bool
+	}{
+		{
+			Name: "empty lists returned by the API",
+			Props1: `{
+  "command": ["ls", "-la"],
+  "image": "busybox",
+  "memory": 512,
+  "vcpus": 1
+}`,
+			Props2: `{
+  "command": ["ls", "-la"],
+  "environment": [],
+  "image": "busybox",
+  "memory": 512,
+  "mountPoints": [],
+  "resourceRequirements": [],
+  "ulimits": [],
+  "vcpus": 1,
+  "volumes": []
+}`,
+			ExpectEqual: true,
+		},
+		{
+			Name: "reordered environment variables",
+			Props1: `{
+  "environment": [
+    {"name": "VAR1", "value": "Value1"},
+    {"name": "VAR2", "value": "Value2"}
+  ],
+  "image": "busybox",
+  "memory": 512,
+  "vcpus": 1
+}`,
+			Props2: `{
+  "environment": [
+    {"name": "VAR2", "value": "Value2"},
+    {"name": "VAR1", "value": "Value1"}
+  ],
+  "image": "busybox",
+  "memory": 512,
+  "vcpus": 1
+}`,
+			ExpectEqual: true,
+		},
+		{
+			Name: "environment variable with empty value",
+			Props1: `{
+  "environment": [
+    {"name": "VAR1", "value": "Value1"},
+    {"name": "VAR2", "value": ""}
+  ],
+  "image": "busybox",
+  "memory": 512,
+  "vcpus": 1
+}`,
+			Props2: `{
+  "environment": [
+    {"name": "VAR1", "value": "Value1"}
+  ],
+  "image": "busybox",
+  "memory": 512,
+  "vcpus": 1
+}`,
+			ExpectEqual: true,
+		},
+		{
+			Name: "different memory",
+			Props1: `{
+  "image": "busybox",
+  "memory": 512,
+  "vcpus": 1
+}`,
+			Props2: `{
+  "image": "busybox",
+  "memory": 1024,
+  "vcpus": 1
+}`,
+			ExpectEqual: false,
+		},
+		{
+			Name:          "invalid JSON",
+			Props1:        `{"image": "busybox"`,
+			Props2:        `{"image": "busybox"}`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			equal, err := batchJobDefinitionContainerPropertiesAreEquivalent(tc.Props1, tc.Props2)
+
+			if tc.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if equal != tc.ExpectEqual {
+				t.Errorf("expected equivalence %t, got %t", tc.ExpectEqual, equal)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/aws/aws-sdk-go/service/amplify"
@@ -138,6 +139,7 @@ import (
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
 )
 
 type Config struct {
@@ -149,16 +151,30 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	AssumeRoleARN               string
+	AssumeRoleDurationSeconds   int
+	AssumeRoleExternalID        string
+	AssumeRolePolicy            string
+	AssumeRolePolicyARNs        []string
+	AssumeRoleSessionName       string
+	AssumeRoleTags              map[string]string
+	AssumeRoleTransitiveTagKeys []string
 
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints map[string]string
-	Insecure  bool
+	DefaultTags          map[string]interface{}
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+
+	Endpoints      map[string]string
+	Insecure       bool
+	CustomCABundle string
+	HTTPProxy      string
+
+	RateLimitAdaptive          bool
+	RateLimitBurst             int
+	RateLimitRequestsPerSecond float64
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -166,6 +182,10 @@ type Config struct {
 	SkipRequestingAccountId bool
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
+
+	// requestHandlers, when set, is called to customize the request handlers
+	// of all service clients, e.g. by the acceptance test sweepers.
+	requestHandlers func(*request.Handlers)
 }
 
 type AWSClient struct {
@@ -206,6 +226,7 @@ type AWSClient struct {
 	datapipelineconn                    *datapipeline.DataPipeline
 	datasyncconn                        *datasync.DataSync
 	daxconn                             *dax.DAX
+	defaultTags                         map[string]interface{}
 	devicefarmconn                      *devicefarm.DeviceFarm
 	dlmconn                             *dlm.DLM
 	dmsconn                             *databasemigrationservice.DatabaseMigrationService
@@ -235,6 +256,8 @@ type AWSClient struct {
 	glueconn                            *glue.Glue
 	guarddutyconn                       *guardduty.GuardDuty
 	iamconn                             *iam.IAM
+	ignoreTagKeyPrefixes                []string
+	ignoreTagKeys                       []string
 	inspectorconn                       *inspector.Inspector
 	iotconn                             *iot.IoT
 	iotanalyticsconn                    *iotanalytics.IoTAnalytics
@@ -253,6 +276,7 @@ type AWSClient struct {
 	macieconn                           *macie.Macie
 	managedblockchainconn               *managedblockchain.ManagedBlockchain
 	mediaconnectconn                    *mediaconnect.MediaConnect
+	mediaconvertaccountconn             *mediaconvert.MediaConvert
 	mediaconvertconn                    *mediaconvert.MediaConvert
 	medialiveconn                       *medialive.MediaLive
 	mediapackageconn                    *mediapackage.MediaPackage
@@ -269,6 +293,7 @@ type AWSClient struct {
 	quicksightconn                      *quicksight.QuickSight
 	r53conn                             *route53.Route53
 	ramconn                             *ram.RAM
+	rateLimiter                         *ratelimit.Limiter
 	rdsconn                             *rds.RDS
 	redshiftconn                        *redshift.Redshift
 	region                              string
@@ -339,7 +364,15 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
-	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	var sess *session.Session
+	var accountID, partition string
+	var err error
+
+	if c.requiresCustomSession() {
+		sess, accountID, partition, err = c.getSession(awsbaseConfig)
+	} else {
+		sess, accountID, partition, err = awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -352,6 +385,19 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	if c.requestHandlers != nil {
+		c.requestHandlers(&sess.Handlers)
+	}
+
+	// Limit the rate of requests to each service once, instead of retrying
+	// throttled requests in each resource.
+	rateLimiter := ratelimit.New(ratelimit.Config{
+		Adaptive:          c.RateLimitAdaptive,
+		Burst:             c.RateLimitBurst,
+		RequestsPerSecond: c.RateLimitRequestsPerSecond,
+	})
+	rateLimiter.ConfigureHandlers(&sess.Handlers)
+
 	dnsSuffix := "amazonaws.com"
 	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
 		dnsSuffix = p.DNSSuffix()
@@ -395,6 +441,7 @@ func (c *Config) Client() (interface{}, error) {
 		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
 		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
 		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
+		defaultTags:                         c.DefaultTags,
 		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
 		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
 		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
@@ -423,6 +470,8 @@ func (c *Config) Client() (interface{}, error) {
 		glueconn:                            glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glue"])})),
 		guarddutyconn:                       guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["guardduty"])})),
 		iamconn:                             iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])})),
+		ignoreTagKeyPrefixes:                c.IgnoreTagKeyPrefixes,
+		ignoreTagKeys:                       c.IgnoreTagKeys,
 		inspectorconn:                       inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["inspector"])})),
 		iotconn:                             iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iot"])})),
 		iotanalyticsconn:                    iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iotanalytics"])})),
@@ -456,6 +505,7 @@ func (c *Config) Client() (interface{}, error) {
 		pricingconn:                         pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pricing"])})),
 		quicksightconn:                      quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["quicksight"])})),
 		ramconn:                             ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])})),
+		rateLimiter:                         rateLimiter,
 		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
 		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
 		region:                              c.Region,
@@ -622,23 +672,12 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	})
 
-	client.kafkaconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
 	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
 		if r.Operation.Name == "CreateStream" {
 			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
 				r.Retryable = aws.Bool(true)
 			}
 		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
 	})
 
 	client.organizationsconn.Handlers.Retry.PushBack(func(r *request.Request) {
@@ -656,6 +695,12 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	})
 
+	// MediaConvert requests are sent to an account-specific endpoint that is
+	// discovered on first use, unless an endpoint has been configured explicitly.
+	if c.Endpoints["mediaconvert"] != "" {
+		client.mediaconvertaccountconn = client.mediaconvertconn
+	}
+
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
 		if err != nil {
@@ -670,6 +715,44 @@ func (c *Config) Client() (interface{}, error) {
 	return client, nil
 }
 
+// mediaConvertAccountConn returns a MediaConvert client for the account-specific
+// endpoint, which is discovered with DescribeEndpoints and then cached.
+func (c *AWSClient) mediaConvertAccountConn() (*mediaconvert.MediaConvert, error) {
+	const mutexKey = "mediaconvertaccountconn"
+	awsMutexKV.Lock(mutexKey)
+	defer awsMutexKV.Unlock(mutexKey)
+
+	if c.mediaconvertaccountconn != nil {
+		return c.mediaconvertaccountconn, nil
+	}
+
+	output, err := c.mediaconvertconn.DescribeEndpoints(&mediaconvert.DescribeEndpointsInput{
+		Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error describing MediaConvert endpoints: %s", err)
+	}
+
+	if output == nil || len(output.Endpoints) == 0 || output.Endpoints[0] == nil || aws.StringValue(output.Endpoints[0].Url) == "" {
+		return nil, fmt.Errorf("error describing MediaConvert endpoints: empty response")
+	}
+
+	endpoint := aws.StringValue(output.Endpoints[0].Url)
+	log.Printf("[DEBUG] Using MediaConvert endpoint: %s", endpoint)
+
+	// Copy the default client so that the request handlers (e.g. rate limiting)
+	// and credentials are shared, replacing only the endpoint.
+	accountClient := *c.mediaconvertconn.Client
+	accountClient.Config.Endpoint = aws.String(endpoint)
+	accountClient.Endpoint = endpoint
+	accountClient.Handlers = accountClient.Handlers.Copy()
+
+	c.mediaconvertaccountconn = &mediaconvert.MediaConvert{Client: &accountClient}
+
+	return c.mediaconvertaccountconn, nil
+}
+
 func hasEc2Classic(platforms []string) bool {
 	for _, p := range platforms {
 		if p == "EC2" {
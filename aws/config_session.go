@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// requiresCustomSession returns whether the provider configuration needs
+// options that the shared AWS Go SDK session handling does not support, in
+// which case the provider builds the session credentials and HTTP client
+// itself via getSession.
+func (c *Config) requiresCustomSession() bool {
+	return c.assumeRoleExtended() || c.CustomCABundle != "" || c.HTTPProxy != ""
+}
+
+// getSession returns an AWS Go SDK session along with the account ID and
+// partition of the credentials in use. The base credentials are resolved by
+// the shared AWS Go SDK session handling, while the HTTP client, any
+// assume_role and the credentials validation are handled here so that all
+// requests, including those to STS and IAM, use the same HTTP client.
+func (c *Config) getSession(awsbaseConfig *awsbase.Config) (*session.Session, string, string, error) {
+	baseConfig := *awsbaseConfig
+	baseConfig.AssumeRoleARN = ""
+	baseConfig.AssumeRoleExternalID = ""
+	baseConfig.AssumeRolePolicy = ""
+	baseConfig.AssumeRoleSessionName = ""
+	baseConfig.SkipCredsValidation = true
+
+	sess, err := awsbase.GetSession(&baseConfig)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	sess = sess.Copy(&aws.Config{HTTPClient: httpClient})
+
+	if c.AssumeRoleARN != "" {
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, PolicyARNs: %v, DurationSeconds: %d)",
+			c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy, c.AssumeRolePolicyARNs, c.AssumeRoleDurationSeconds)
+
+		provider := &assumeRoleProvider{
+			client:            sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})),
+			duration:          time.Duration(c.AssumeRoleDurationSeconds) * time.Second,
+			externalID:        c.AssumeRoleExternalID,
+			policy:            c.AssumeRolePolicy,
+			policyARNs:        c.AssumeRolePolicyARNs,
+			roleARN:           c.AssumeRoleARN,
+			sessionName:       c.AssumeRoleSessionName,
+			tags:              c.AssumeRoleTags,
+			transitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
+		}
+
+		sess = sess.Copy(&aws.Config{Credentials: credentials.NewCredentials(provider)})
+
+		if _, err := sess.Config.Credentials.Get(); err != nil {
+			return nil, "", "", fmt.Errorf("The role %q cannot be assumed.\n\n"+
+				"  There are a number of possible causes of this - the most common are:\n"+
+				"    * The credentials used in order to assume the role are invalid\n"+
+				"    * The credentials do not have appropriate permission to assume the role\n"+
+				"    * The role ARN is not valid\n\n"+
+				"Error: %s", c.AssumeRoleARN, err)
+		}
+	}
+
+	iamClient := iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])}))
+	stsClient := sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsClient)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error validating provider credentials: %s", err)
+		}
+
+		return sess, accountID, partition, nil
+	}
+
+	if c.AssumeRoleARN != "" {
+		roleARN, err := arn.Parse(c.AssumeRoleARN)
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error parsing assume_role role_arn (%s): %s", c.AssumeRoleARN, err)
+		}
+
+		return sess, roleARN.AccountID, roleARN.Partition, nil
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if credentialsValue, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = credentialsValue.ProviderName
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iamClient, stsClient, credentialsProviderName)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %s", err)
+		}
+
+		return sess, accountID, partition, nil
+	}
+
+	var partition string
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return sess, "", partition, nil
+}
+
+// httpClient returns the HTTP client used for all AWS API requests, with the
+// insecure, custom_ca_bundle and http_proxy provider configuration applied.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+	transport.TLSClientConfig = &tls.Config{}
+
+	if c.Insecure {
+		transport.TLSClientConfig.InsecureSkipVerify = true
+	}
+
+	if c.CustomCABundle != "" {
+		pem, err := c.customCABundlePEM()
+		if err != nil {
+			return nil, err
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("error loading custom_ca_bundle: no valid PEM encoded certificates found")
+		}
+
+		transport.TLSClientConfig.RootCAs = certPool
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing http_proxy (%s): %s", c.HTTPProxy, err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return client, nil
+}
+
+// customCABundlePEM returns the PEM contents of the custom_ca_bundle, which
+// may be given either inline or as a file path.
+func (c *Config) customCABundlePEM() ([]byte, error) {
+	if isPEM(c.CustomCABundle) {
+		return []byte(c.CustomCABundle), nil
+	}
+
+	pem, err := ioutil.ReadFile(c.CustomCABundle)
+	if err != nil {
+		return nil, fmt.Errorf("error reading custom_ca_bundle (%s): %s", c.CustomCABundle, err)
+	}
+
+	return pem, nil
+}
+
+// isPEM returns whether the given string looks like PEM encoded data rather
+// than a file path.
+func isPEM(s string) bool {
+	return strings.HasPrefix(strings.TrimSpace(s), "-----BEGIN ")
+}
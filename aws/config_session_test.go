@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigGetSession_CustomCABundle(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(testConfigSessionGetCallerIdentityHandler))
+	defer ts.Close()
+
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+
+	caBundleFile, err := ioutil.TempFile("", "tf-acc-test-ca-bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(caBundleFile.Name())
+
+	if _, err := caBundleFile.WriteString(caBundle); err != nil {
+		t.Fatal(err)
+	}
+	caBundleFile.Close()
+
+	cases := []struct {
+		Name           string
+		CustomCABundle string
+		Insecure       bool
+		ExpectedError  string
+	}{
+		{
+			Name:           "inline PEM",
+			CustomCABundle: caBundle,
+		},
+		{
+			Name:           "file path",
+			CustomCABundle: caBundleFile.Name(),
+		},
+		{
+			Name:     "insecure",
+			Insecure: true,
+		},
+		{
+			Name:           "invalid PEM",
+			CustomCABundle: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----\n",
+			ExpectedError:  "no valid PEM encoded certificates found",
+		},
+		{
+			Name:           "missing file",
+			CustomCABundle: caBundleFile.Name() + "-missing",
+			ExpectedError:  "error reading custom_ca_bundle",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c := testConfigSession(ts.URL)
+			c.CustomCABundle = tc.CustomCABundle
+			c.Insecure = tc.Insecure
+
+			_, accountID, partition, err := c.getSession(testConfigSessionAwsbaseConfig(c))
+
+			if tc.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", tc.ExpectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if accountID != "123456789012" {
+				t.Errorf("expected account ID %q, got %q", "123456789012", accountID)
+			}
+
+			if partition != "aws" {
+				t.Errorf("expected partition %q, got %q", "aws", partition)
+			}
+		})
+	}
+}
+
+func TestConfigHttpClient_UntrustedCertificate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(testConfigSessionGetCallerIdentityHandler))
+	defer ts.Close()
+
+	client, err := testConfigSession(ts.URL).httpClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := client.Get(ts.URL)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("expected certificate verification error, got none")
+	}
+
+	if !strings.Contains(err.Error(), "certificate") {
+		t.Fatalf("expected certificate verification error, got: %s", err)
+	}
+}
+
+func TestConfigGetSession_HTTPProxy(t *testing.T) {
+	var proxiedHost string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.URL.Host
+		testConfigSessionGetCallerIdentityHandler(w, r)
+	}))
+	defer proxy.Close()
+
+	c := testConfigSession("http://sts.example.test")
+	c.HTTPProxy = proxy.URL
+
+	_, accountID, _, err := c.getSession(testConfigSessionAwsbaseConfig(c))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if accountID != "123456789012" {
+		t.Errorf("expected account ID %q, got %q", "123456789012", accountID)
+	}
+
+	if proxiedHost != "sts.example.test" {
+		t.Errorf("expected request for %q through proxy, got %q", "sts.example.test", proxiedHost)
+	}
+}
+
+func TestIsPEM(t *testing.T) {
+	cases := []struct {
+		Value    string
+		Expected bool
+	}{
+		{
+			Value:    "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+			Expected: true,
+		},
+		{
+			Value:    "\n  -----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+			Expected: true,
+		},
+		{
+			Value:    "/etc/ssl/certs/ca-bundle.pem",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := isPEM(tc.Value); got != tc.Expected {
+			t.Errorf("expected isPEM(%q) to be %t, got %t", tc.Value, tc.Expected, got)
+		}
+	}
+}
+
+func testConfigSession(stsEndpoint string) *Config {
+	return &Config{
+		AccessKey:            "accessKey",
+		SecretKey:            "secretKey",
+		Region:               "us-east-1",
+		Endpoints:            map[string]string{"sts": stsEndpoint},
+		SkipMetadataApiCheck: true,
+	}
+}
+
+func testConfigSessionAwsbaseConfig(c *Config) *awsbase.Config {
+	return &awsbase.Config{
+		AccessKey:            c.AccessKey,
+		Insecure:             c.Insecure,
+		MaxRetries:           1,
+		Region:               c.Region,
+		SecretKey:            c.SecretKey,
+		SkipMetadataApiCheck: c.SkipMetadataApiCheck,
+		StsEndpoint:          c.Endpoints["sts"],
+	}
+}
+
+func testConfigSessionGetCallerIdentityHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/xml")
+	fmt.Fprint(w, `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`)
+}
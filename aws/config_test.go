@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
 
@@ -40,6 +41,56 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestAWSClientMediaConvertAccountConn(t *testing.T) {
+	mediaconvertEndpoints := []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/2017-08-29/endpoints",
+				Body:   `{"mode":"DEFAULT"}`,
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        `{"endpoints":[{"url":"https://abcd1234.mediaconvert.us-east-1.amazonaws.com"}]}`,
+				ContentType: "application/json",
+			},
+		},
+	}
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("MediaConvert", mediaconvertEndpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	client := &AWSClient{
+		mediaconvertconn: mediaconvert.New(sess),
+	}
+	defaultEndpoint := client.mediaconvertconn.Endpoint
+
+	conn, err := client.mediaConvertAccountConn()
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	expectedEndpoint := "https://abcd1234.mediaconvert.us-east-1.amazonaws.com"
+	if conn.Endpoint != expectedEndpoint {
+		t.Fatalf("Received endpoint: %q\nExpected: %q\n", conn.Endpoint, expectedEndpoint)
+	}
+
+	if client.mediaconvertconn.Endpoint != defaultEndpoint {
+		t.Fatalf("Default client endpoint changed to: %q\nExpected: %q\n", client.mediaconvertconn.Endpoint, defaultEndpoint)
+	}
+
+	cachedConn, err := client.mediaConvertAccountConn()
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	if cachedConn != conn {
+		t.Fatalf("Expected the account client to be cached")
+	}
+}
+
 var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
   <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
   <accountAttributeSet>
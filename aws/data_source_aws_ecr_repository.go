@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsEcrRepository() *schema.Resource {
@@ -63,9 +64,16 @@ func dataSourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", repository.RepositoryName)
 	d.Set("repository_url", repository.RepositoryUri)
 
-	if err := getTagsECR(conn, d); err != nil {
+	tagsResp, err := conn.ListTagsForResource(&ecr.ListTagsForResourceInput{
+		ResourceArn: repository.RepositoryArn,
+	})
+	if err != nil {
 		return fmt.Errorf("error getting ECR repository tags: %s", err)
 	}
 
+	if err := d.Set("tags", keyvaluetags.EcrKeyValueTags(tagsResp.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
@@ -2,12 +2,11 @@ package aws
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/finder"
 )
 
 func dataSourceAwsEksCluster() *schema.Resource {
@@ -107,21 +106,16 @@ func dataSourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error
 	conn := meta.(*AWSClient).eksconn
 	name := d.Get("name").(string)
 
-	input := &eks.DescribeClusterInput{
-		Name: aws.String(name),
+	cluster, err := finder.ClusterByName(conn, name)
+
+	if isResourceNotFoundError(err) {
+		return fmt.Errorf("EKS Cluster (%s) not found", name)
 	}
 
-	log.Printf("[DEBUG] Reading EKS Cluster: %s", input)
-	output, err := conn.DescribeCluster(input)
 	if err != nil {
 		return fmt.Errorf("error reading EKS Cluster (%s): %s", name, err)
 	}
 
-	cluster := output.Cluster
-	if cluster == nil {
-		return fmt.Errorf("EKS Cluster (%s) not found", name)
-	}
-
 	d.SetId(name)
 	d.Set("arn", cluster.Arn)
 
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsElasticSearchDomain() *schema.Resource {
@@ -358,7 +359,7 @@ func dataSourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error retrieving tags for elasticsearch_domain: %s", err)
 	}
 
-	if err := d.Set("tags", tagsToMapElasticsearchService(tagResp.TagList)); err != nil {
+	if err := d.Set("tags", keyvaluetags.ElasticsearchserviceKeyValueTags(tagResp.TagList).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsKinesisStream() *schema.Resource {
@@ -89,7 +90,7 @@ func dataSourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tagsToMapKinesis(tags.Tags))
+	d.Set("tags", keyvaluetags.KinesisKeyValueTags(tags.Tags).IgnoreAws().Map())
 
 	return nil
 }
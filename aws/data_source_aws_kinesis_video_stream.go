@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisvideo/finder"
+)
+
+func dataSourceAwsKinesisVideoStream() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsKinesisVideoStreamRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_retention_in_hours": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"media_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsKinesisVideoStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	name := d.Get("name").(string)
+	stream, err := finder.StreamByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Video Stream (%s): %s", name, err)
+	}
+
+	arn := aws.StringValue(stream.StreamARN)
+	d.SetId(arn)
+	d.Set("arn", arn)
+	d.Set("creation_time", aws.TimeValue(stream.CreationTime).Format(time.RFC3339))
+	d.Set("data_retention_in_hours", stream.DataRetentionInHours)
+	d.Set("device_name", stream.DeviceName)
+	d.Set("kms_key_id", stream.KmsKeyId)
+	d.Set("media_type", stream.MediaType)
+	d.Set("name", stream.StreamName)
+	d.Set("status", stream.Status)
+	d.Set("version", stream.Version)
+
+	tags, err := keyvaluetags.KinesisvideoListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Video Stream (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsKinesisVideoStream_basic(t *testing.T) {
+	resourceName := "aws_kinesis_video_stream.test"
+	dataSourceName := "data.aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsKinesisVideoStreamConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creation_time", resourceName, "creation_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_retention_in_hours", resourceName, "data_retention_in_hours"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "device_name", resourceName, "device_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "media_type", resourceName, "media_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", kinesisvideo.StatusActive),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Name", resourceName, "tags.Name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsKinesisVideoStreamConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+  device_name             = "camera"
+  media_type              = "video/h264"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_kinesis_video_stream" "test" {
+  name = "${aws_kinesis_video_stream.test.name}"
+}
+`, rName)
+}
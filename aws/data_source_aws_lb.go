@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsLb() *schema.Resource {
@@ -146,5 +147,18 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(aws.StringValue(describeResp.LoadBalancers[0].LoadBalancerArn))
 
-	return flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0])
+	if err := flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0]); err != nil {
+		return err
+	}
+
+	tags, err := keyvaluetags.Elbv2ListTags(elbconn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving LB Tags: %s", err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().Map()); err != nil {
+		log.Printf("[WARN] Error setting tags for AWS LB (%s): %s", d.Id(), err)
+	}
+
+	return nil
 }
@@ -205,5 +205,14 @@ func dataSourceAwsmQBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return resourceAwsMqBrokerRead(d, meta)
+	tags, err := readAwsMqBroker(d, meta)
+	if err != nil || d.Id() == "" {
+		return err
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsRedshiftCluster() *schema.Resource {
@@ -248,7 +249,7 @@ func dataSourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{})
 	d.Set("port", rsc.Endpoint.Port)
 	d.Set("preferred_maintenance_window", rsc.PreferredMaintenanceWindow)
 	d.Set("publicly_accessible", rsc.PubliclyAccessible)
-	d.Set("tags", tagsToMapRedshift(rsc.Tags))
+	d.Set("tags", keyvaluetags.RedshiftKeyValueTags(rsc.Tags).IgnoreAws().Map())
 	d.Set("vpc_id", rsc.VpcId)
 
 	var vpcg []string
@@ -0,0 +1,249 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const iamNamespace = "https://iam.amazonaws.com/doc/2010-05-08/"
+
+// IAM is an in-memory fake of the IAM role API operations.
+type IAM struct {
+	mu     sync.Mutex
+	roles  map[string]*iamRole
+	nextID int
+}
+
+type iamRole struct {
+	arn                    string
+	assumeRolePolicy       string
+	createDate             time.Time
+	description            string
+	id                     string
+	maxSessionDuration     string
+	name                   string
+	path                   string
+	permissionsBoundaryArn string
+	tags                   map[string]string
+}
+
+// RegisterIAM registers fake IAM role operations with the server.
+func RegisterIAM(s *Server) *IAM {
+	f := &IAM{
+		roles: make(map[string]*iamRole),
+	}
+
+	s.Handle("iam", "CreateRole", f.createRole)
+	s.Handle("iam", "DeleteRole", f.deleteRole)
+	s.Handle("iam", "DeleteRolePermissionsBoundary", f.deleteRolePermissionsBoundary)
+	s.Handle("iam", "GetRole", f.getRole)
+	s.Handle("iam", "ListAttachedRolePolicies", f.listAttachedRolePolicies)
+	s.Handle("iam", "ListInstanceProfilesForRole", f.listInstanceProfilesForRole)
+	s.Handle("iam", "ListRolePolicies", f.listRolePolicies)
+	s.Handle("iam", "PutRolePermissionsBoundary", f.putRolePermissionsBoundary)
+	s.Handle("iam", "TagRole", f.tagRole)
+	s.Handle("iam", "UntagRole", f.untagRole)
+	s.Handle("iam", "UpdateAssumeRolePolicy", f.updateAssumeRolePolicy)
+	s.Handle("iam", "UpdateRole", f.updateRole)
+	s.Handle("iam", "UpdateRoleDescription", f.updateRoleDescription)
+
+	return f
+}
+
+// RoleNames returns the names of all existing roles.
+func (f *IAM) RoleNames() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	names := make([]string, 0, len(f.roles))
+	for name := range f.roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (f *IAM) createRole(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	name := req.Param("RoleName")
+	if _, ok := f.roles[name]; ok {
+		return ErrorResponse(http.StatusConflict, "EntityAlreadyExists", fmt.Sprintf("Role with name %s already exists.", name))
+	}
+
+	path := req.Param("Path")
+	if path == "" {
+		path = "/"
+	}
+
+	maxSessionDuration := req.Param("MaxSessionDuration")
+	if maxSessionDuration == "" {
+		maxSessionDuration = "3600"
+	}
+
+	f.nextID++
+	role := &iamRole{
+		arn:                    fmt.Sprintf("arn:aws:iam::%s:role%s%s", AccountID, path, name),
+		assumeRolePolicy:       req.Param("AssumeRolePolicyDocument"),
+		createDate:             time.Now().UTC().Truncate(time.Second),
+		description:            req.Param("Description"),
+		id:                     fmt.Sprintf("AROA%016d", f.nextID),
+		maxSessionDuration:     maxSessionDuration,
+		name:                   name,
+		path:                   path,
+		permissionsBoundaryArn: req.Param("PermissionsBoundary"),
+		tags:                   req.ParamMap("Tags.member", "Key", "Value"),
+	}
+	f.roles[name] = role
+
+	return XMLResponse("CreateRole", iamNamespace, role.xml())
+}
+
+func (f *IAM) deleteRole(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.roles[req.Param("RoleName")]; !ok {
+		return iamNoSuchRoleResponse(req.Param("RoleName"))
+	}
+
+	delete(f.roles, req.Param("RoleName"))
+
+	return XMLResponse("DeleteRole", iamNamespace, "")
+}
+
+func (f *IAM) deleteRolePermissionsBoundary(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		role.permissionsBoundaryArn = ""
+		return ""
+	})
+}
+
+func (f *IAM) getRole(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		return role.xml()
+	})
+}
+
+func (f *IAM) listAttachedRolePolicies(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		return "<AttachedPolicies></AttachedPolicies><IsTruncated>false</IsTruncated>"
+	})
+}
+
+func (f *IAM) listInstanceProfilesForRole(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		return "<InstanceProfiles></InstanceProfiles><IsTruncated>false</IsTruncated>"
+	})
+}
+
+func (f *IAM) listRolePolicies(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		return "<PolicyNames></PolicyNames><IsTruncated>false</IsTruncated>"
+	})
+}
+
+func (f *IAM) putRolePermissionsBoundary(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		role.permissionsBoundaryArn = req.Param("PermissionsBoundary")
+		return ""
+	})
+}
+
+func (f *IAM) tagRole(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		for k, v := range req.ParamMap("Tags.member", "Key", "Value") {
+			role.tags[k] = v
+		}
+		return ""
+	})
+}
+
+func (f *IAM) untagRole(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		for _, k := range req.ParamList("TagKeys.member") {
+			delete(role.tags, k)
+		}
+		return ""
+	})
+}
+
+func (f *IAM) updateAssumeRolePolicy(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		role.assumeRolePolicy = req.Param("PolicyDocument")
+		return ""
+	})
+}
+
+func (f *IAM) updateRole(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		if _, ok := req.Params["Description"]; ok {
+			role.description = req.Param("Description")
+		}
+		if v := req.Param("MaxSessionDuration"); v != "" {
+			role.maxSessionDuration = v
+		}
+		return ""
+	})
+}
+
+func (f *IAM) updateRoleDescription(req *Request) *Response {
+	return f.updateExistingRole(req, func(role *iamRole) string {
+		role.description = req.Param("Description")
+		return role.xml()
+	})
+}
+
+// updateExistingRole calls update with the role named in the request, and
+// responds with the returned XML result elements.
+func (f *IAM) updateExistingRole(req *Request, update func(*iamRole) string) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	role, ok := f.roles[req.Param("RoleName")]
+	if !ok {
+		return iamNoSuchRoleResponse(req.Param("RoleName"))
+	}
+
+	return XMLResponse(req.Operation, iamNamespace, update(role))
+}
+
+func (r *iamRole) xml() string {
+	var b strings.Builder
+
+	b.WriteString("<Role>")
+	b.WriteString(XMLElement("Arn", r.arn))
+	// IAM returns the policy document URL encoded.
+	b.WriteString(XMLElement("AssumeRolePolicyDocument", strings.Replace(url.QueryEscape(r.assumeRolePolicy), "+", "%20", -1)))
+	b.WriteString(XMLElement("CreateDate", r.createDate.Format(time.RFC3339)))
+	if r.description != "" {
+		b.WriteString(XMLElement("Description", r.description))
+	}
+	b.WriteString(XMLElement("MaxSessionDuration", r.maxSessionDuration))
+	b.WriteString(XMLElement("Path", r.path))
+	if r.permissionsBoundaryArn != "" {
+		b.WriteString("<PermissionsBoundary>")
+		b.WriteString(XMLElement("PermissionsBoundaryArn", r.permissionsBoundaryArn))
+		b.WriteString(XMLElement("PermissionsBoundaryType", "Policy"))
+		b.WriteString("</PermissionsBoundary>")
+	}
+	b.WriteString(XMLElement("RoleId", r.id))
+	b.WriteString(XMLElement("RoleName", r.name))
+	if len(r.tags) > 0 {
+		b.WriteString("<Tags>" + XMLEntries(r.tags, "member", "Key", "Value") + "</Tags>")
+	}
+	b.WriteString("</Role>")
+
+	return b.String()
+}
+
+func iamNoSuchRoleResponse(name string) *Response {
+	return ErrorResponse(http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role with name %s cannot be found.", name))
+}
@@ -0,0 +1,134 @@
+package fakeaws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// AccountID is the AWS account ID of the fake credentials.
+const AccountID = "123456789012"
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/\d{8}/([^/]+)/([^/]+)/aws4_request`)
+
+// Request is an AWS API request received by the fake endpoint server.
+type Request struct {
+	// Service is the service name prefix of the request path.
+	Service string
+
+	// Operation is the API action name for query and JSON protocol requests,
+	// or the HTTP method and path for REST protocol requests.
+	Operation string
+
+	// Method, Path and Query are those of the HTTP request, with the service
+	// name prefix removed from Path.
+	Method string
+	Path   string
+	Query  url.Values
+
+	// Header is the HTTP request header.
+	Header http.Header
+
+	// Params are the form encoded parameters of query protocol requests.
+	Params url.Values
+
+	// Body is the raw HTTP request body.
+	Body []byte
+}
+
+func newRequest(r *http.Request) (*Request, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %s", err)
+	}
+
+	service, path := serviceFromPath(r.URL.Path)
+
+	req := &Request{
+		Service: service,
+		Method:  r.Method,
+		Path:    path,
+		Query:   r.URL.Query(),
+		Header:  r.Header,
+		Body:    body,
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		req.Params, err = url.ParseQuery(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing request body: %s", err)
+		}
+	}
+
+	switch {
+	case req.Params.Get("Action") != "":
+		req.Operation = req.Params.Get("Action")
+	case r.Header.Get("X-Amz-Target") != "":
+		target := r.Header.Get("X-Amz-Target")
+		req.Operation = target[strings.LastIndex(target, ".")+1:]
+	default:
+		req.Operation = fmt.Sprintf("%s %s", r.Method, path)
+	}
+
+	return req, nil
+}
+
+// key returns a representation of the request used to match it against
+// recorded interactions. It is independent of signing and timing headers.
+func (r *Request) key() string {
+	if r.Params != nil {
+		return r.Params.Encode()
+	}
+
+	if len(r.Query) > 0 {
+		return fmt.Sprintf("%s?%s %s", r.Path, r.Query.Encode(), r.Body)
+	}
+
+	return fmt.Sprintf("%s %s", r.Path, r.Body)
+}
+
+// Param returns the value of the given query protocol parameter.
+func (r *Request) Param(name string) string {
+	return r.Params.Get(name)
+}
+
+// ParamList returns the values of the given query protocol list parameter,
+// e.g. ParamList("TagKeys.member") for the TagKeys.member.N parameters.
+func (r *Request) ParamList(prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%s.%d", prefix, i)
+		if _, ok := r.Params[name]; !ok {
+			return values
+		}
+		values = append(values, r.Params.Get(name))
+	}
+}
+
+// ParamMap returns the entries of the given query protocol map or list of
+// key-value structures, e.g. ParamMap("Tags.member", "Key", "Value") for the
+// Tags.member.N.Key and Tags.member.N.Value parameters.
+func (r *Request) ParamMap(prefix, keyName, valueName string) map[string]string {
+	values := make(map[string]string)
+
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%s.%d.%s", prefix, i, keyName)
+		if _, ok := r.Params[name]; !ok {
+			return values
+		}
+		values[r.Params.Get(name)] = r.Params.Get(fmt.Sprintf("%s.%d.%s", prefix, i, valueName))
+	}
+}
+
+// Region returns the AWS region the request was signed for.
+func (r *Request) Region() string {
+	if matches := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); matches != nil {
+		return matches[1]
+	}
+
+	return "us-east-1"
+}
@@ -0,0 +1,78 @@
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const requestID = "01234567-89ab-cdef-0123-456789abcdef"
+
+// Response is a fake AWS API response.
+type Response struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body"`
+}
+
+// XMLResponse returns a successful query protocol response for the given
+// operation with the given XML result elements.
+func XMLResponse(operation, namespace, result string) *Response {
+	return &Response{
+		StatusCode:  http.StatusOK,
+		ContentType: "text/xml",
+		Body: fmt.Sprintf(`<%[1]sResponse xmlns=%[2]q><%[1]sResult>%[3]s</%[1]sResult><ResponseMetadata><RequestId>%[4]s</RequestId></ResponseMetadata></%[1]sResponse>`,
+			operation, namespace, result, requestID),
+	}
+}
+
+// ErrorResponse returns a query protocol error response.
+func ErrorResponse(statusCode int, code, message string) *Response {
+	errorType := "Sender"
+	if statusCode >= http.StatusInternalServerError {
+		errorType = "Receiver"
+	}
+
+	return &Response{
+		StatusCode:  statusCode,
+		ContentType: "text/xml",
+		Body: fmt.Sprintf(`<ErrorResponse><Error><Type>%s</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`,
+			errorType, XMLEscape(code), XMLEscape(message), requestID),
+	}
+}
+
+// XMLEscape returns the given string escaped for use as XML character data.
+func XMLEscape(s string) string {
+	var buf bytes.Buffer
+
+	// xml.EscapeText only returns errors from the underlying writer.
+	_ = xml.EscapeText(&buf, []byte(s))
+
+	return buf.String()
+}
+
+// XMLElement returns an XML element with the given name and escaped value.
+func XMLElement(name, value string) string {
+	return fmt.Sprintf("<%[1]s>%[2]s</%[1]s>", name, XMLEscape(value))
+}
+
+// XMLEntries returns the XML elements of the given map, sorted by key, each
+// wrapped in entryName with the key and value in keyName and valueName
+// elements, e.g. XMLEntries(tags, "member", "Key", "Value").
+func XMLEntries(m map[string]string, entryName, keyName, valueName string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&b, "<%[1]s>%[2]s%[3]s</%[1]s>", entryName, XMLElement(keyName, k), XMLElement(valueName, m[k]))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,227 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// HandlerFunc handles a fake AWS API operation request.
+type HandlerFunc func(*Request) *Response
+
+// Interaction is a recorded AWS API request and its response.
+type Interaction struct {
+	Service   string    `json:"service"`
+	Operation string    `json:"operation"`
+	Request   string    `json:"request"`
+	Response  *Response `json:"response"`
+	used      bool
+}
+
+// Server is an in-process fake AWS API endpoint.
+//
+// Each AWS service is served under its own path prefix, see URL, so that
+// the provider endpoints configuration can point every service at the same
+// server. Requests are answered by, in order of precedence:
+//
+//   - a replayed Interaction loaded with LoadCassette
+//   - a HandlerFunc registered with Handle, e.g. by the in-memory service fakes
+//   - the real AWS API, when enabled with Upstream
+//
+// Every request and response is recorded and can be saved with SaveCassette
+// for later replay.
+type Server struct {
+	httpServer *httptest.Server
+
+	mu           sync.Mutex
+	handlers     map[string]HandlerFunc
+	interactions []*Interaction
+	replay       []*Interaction
+	upstream     *upstream
+}
+
+// NewServer starts and returns a new fake AWS API endpoint server.
+// The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		handlers: make(map[string]HandlerFunc),
+	}
+
+	s.httpServer = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// URL returns the endpoint URL of the given service, as used in the provider
+// endpoints configuration.
+func (s *Server) URL(service string) string {
+	return fmt.Sprintf("%s/%s", s.httpServer.URL, service)
+}
+
+// RootURL returns the base URL of the server.
+func (s *Server) RootURL() string {
+	return s.httpServer.URL
+}
+
+// Handle registers the handler for the given service and operation.
+// The operation is the API action name, e.g. CreateQueue.
+func (s *Server) Handle(service, operation string, handler HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[service+"/"+operation] = handler
+}
+
+// Interactions returns all requests and responses handled by the server.
+func (s *Server) Interactions() []*Interaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	interactions := make([]*Interaction, len(s.interactions))
+	copy(interactions, s.interactions)
+
+	return interactions
+}
+
+// SaveCassette writes all requests and responses handled by the server to
+// the given file as JSON, for later replay with LoadCassette.
+func (s *Server) SaveCassette(path string) error {
+	data, err := json.MarshalIndent(s.Interactions(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding cassette: %s", err)
+	}
+
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("error writing cassette (%s): %s", path, err)
+	}
+
+	return nil
+}
+
+// LoadCassette reads requests and responses previously saved with
+// SaveCassette from the given file. Matching requests are then answered
+// with the recorded responses, each at most once and in recorded order.
+func (s *Server) LoadCassette(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading cassette (%s): %s", path, err)
+	}
+
+	var interactions []*Interaction
+	if err := json.Unmarshal(data, &interactions); err != nil {
+		return fmt.Errorf("error decoding cassette (%s): %s", path, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.replay = append(s.replay, interactions...)
+
+	return nil
+}
+
+// UnusedReplays returns the loaded cassette interactions which have not yet
+// been replayed.
+func (s *Server) UnusedReplays() []*Interaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var unused []*Interaction
+	for _, interaction := range s.replay {
+		if !interaction.used {
+			unused = append(unused, interaction)
+		}
+	}
+
+	return unused
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := newRequest(r)
+	if err != nil {
+		writeResponse(w, ErrorResponse(http.StatusBadRequest, "InvalidRequest", err.Error()))
+		return
+	}
+
+	log.Printf("[DEBUG] Fake AWS API received %s %s request: %s", req.Service, req.Operation, req.key())
+
+	resp := s.handle(req)
+
+	s.mu.Lock()
+	s.interactions = append(s.interactions, &Interaction{
+		Service:   req.Service,
+		Operation: req.Operation,
+		Request:   req.key(),
+		Response:  resp,
+	})
+	s.mu.Unlock()
+
+	log.Printf("[DEBUG] Fake AWS API responding to %s %s request with %d: %s", req.Service, req.Operation, resp.StatusCode, resp.Body)
+
+	writeResponse(w, resp)
+}
+
+func (s *Server) handle(req *Request) *Response {
+	s.mu.Lock()
+	for _, interaction := range s.replay {
+		if !interaction.used && interaction.Service == req.Service && interaction.Operation == req.Operation && interaction.Request == req.key() {
+			interaction.used = true
+			s.mu.Unlock()
+			return interaction.Response
+		}
+	}
+	handler, ok := s.handlers[req.Service+"/"+req.Operation]
+	upstream := s.upstream
+	s.mu.Unlock()
+
+	if ok {
+		return handler(req)
+	}
+
+	if upstream != nil {
+		resp, err := upstream.roundTrip(req)
+		if err != nil {
+			return ErrorResponse(http.StatusBadGateway, "UpstreamError", err.Error())
+		}
+		return resp
+	}
+
+	return ErrorResponse(http.StatusBadRequest, "InvalidAction",
+		fmt.Sprintf("fake AWS API has no handler for %s %s", req.Service, req.Operation))
+}
+
+func writeResponse(w http.ResponseWriter, resp *Response) {
+	contentType := resp.ContentType
+	if contentType == "" {
+		contentType = "text/xml"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(resp.StatusCode)
+
+	fmt.Fprint(w, resp.Body)
+}
+
+// serviceFromPath returns the service name prefix of the given request path
+// and the remaining path.
+func serviceFromPath(path string) (string, string) {
+	path = strings.TrimPrefix(path, "/")
+
+	parts := strings.SplitN(path, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], "/"
+	}
+
+	return parts[0], "/" + parts[1]
+}
@@ -0,0 +1,213 @@
+package fakeaws
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func testSession(t *testing.T, s *Server, service string) *session.Session {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:    aws.String(s.URL(service)),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
+
+func TestServer_NoHandler(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	conn := sqs.New(testSession(t, s, "sqs"))
+
+	_, err := conn.ListQueues(&sqs.ListQueuesInput{})
+
+	if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "InvalidAction" {
+		t.Fatalf("expected InvalidAction error, got: %v", err)
+	}
+}
+
+func TestServer_SQS(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	fake := RegisterSQS(s)
+
+	conn := sqs.New(testSession(t, s, "sqs"))
+
+	createOutput, err := conn.CreateQueue(&sqs.CreateQueueInput{
+		QueueName:  aws.String("test"),
+		Attributes: aws.StringMap(map[string]string{"VisibilityTimeout": "60"}),
+		Tags:       aws.StringMap(map[string]string{"Key1": "Value1", "Key2": "Value2"}),
+	})
+	if err != nil {
+		t.Fatalf("error creating queue: %s", err)
+	}
+
+	queueURL := aws.StringValue(createOutput.QueueUrl)
+
+	if _, err := conn.UntagQueue(&sqs.UntagQueueInput{QueueUrl: aws.String(queueURL), TagKeys: aws.StringSlice([]string{"Key1"})}); err != nil {
+		t.Fatalf("error untagging queue: %s", err)
+	}
+
+	attributesOutput, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(queueURL),
+		AttributeNames: aws.StringSlice([]string{"All"}),
+	})
+	if err != nil {
+		t.Fatalf("error getting queue attributes: %s", err)
+	}
+
+	if got, expected := aws.StringValue(attributesOutput.Attributes["VisibilityTimeout"]), "60"; got != expected {
+		t.Errorf("expected VisibilityTimeout %q, got %q", expected, got)
+	}
+
+	if got, expected := aws.StringValue(attributesOutput.Attributes["QueueArn"]), "arn:aws:sqs:us-west-2:123456789012:test"; got != expected {
+		t.Errorf("expected QueueArn %q, got %q", expected, got)
+	}
+
+	tagsOutput, err := conn.ListQueueTags(&sqs.ListQueueTagsInput{QueueUrl: aws.String(queueURL)})
+	if err != nil {
+		t.Fatalf("error listing queue tags: %s", err)
+	}
+
+	if got := aws.StringValueMap(tagsOutput.Tags); len(got) != 1 || got["Key2"] != "Value2" {
+		t.Errorf("expected tags %v, got %v", map[string]string{"Key2": "Value2"}, got)
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)}); err != nil {
+		t.Fatalf("error deleting queue: %s", err)
+	}
+
+	if urls := fake.QueueURLs(); len(urls) != 0 {
+		t.Errorf("expected no queues, got: %v", urls)
+	}
+
+	_, err = conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: aws.String(queueURL)})
+
+	if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "AWS.SimpleQueueService.NonExistentQueue" {
+		t.Fatalf("expected AWS.SimpleQueueService.NonExistentQueue error, got: %v", err)
+	}
+}
+
+func TestServer_Cassette(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fakeaws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cassette := filepath.Join(dir, "cassette.json")
+
+	recordServer := NewServer()
+	defer recordServer.Close()
+	RegisterSNS(recordServer)
+
+	recordConn := sns.New(testSession(t, recordServer, "sns"))
+
+	if _, err := recordConn.CreateTopic(&sns.CreateTopicInput{Name: aws.String("test")}); err != nil {
+		t.Fatalf("error creating topic: %s", err)
+	}
+
+	input := &sns.GetTopicAttributesInput{TopicArn: aws.String("arn:aws:sns:us-west-2:123456789012:test")}
+
+	if _, err := recordConn.GetTopicAttributes(input); err != nil {
+		t.Fatalf("error getting topic attributes: %s", err)
+	}
+
+	if _, err := recordConn.DeleteTopic(&sns.DeleteTopicInput{TopicArn: input.TopicArn}); err != nil {
+		t.Fatalf("error deleting topic: %s", err)
+	}
+
+	if _, err := recordConn.GetTopicAttributes(input); err == nil {
+		t.Fatal("expected error getting deleted topic attributes, got none")
+	}
+
+	if err := recordServer.SaveCassette(cassette); err != nil {
+		t.Fatal(err)
+	}
+
+	replayServer := NewServer()
+	defer replayServer.Close()
+
+	if err := replayServer.LoadCassette(cassette); err != nil {
+		t.Fatal(err)
+	}
+
+	replayConn := sns.New(testSession(t, replayServer, "sns"))
+
+	// Identical requests are replayed in recorded order.
+	if _, err := replayConn.GetTopicAttributes(input); err != nil {
+		t.Fatalf("error replaying topic attributes: %s", err)
+	}
+
+	_, err = replayConn.GetTopicAttributes(input)
+
+	if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "NotFound" {
+		t.Fatalf("expected replayed NotFound error, got: %v", err)
+	}
+
+	if got, expected := len(replayServer.UnusedReplays()), 2; got != expected {
+		t.Errorf("expected %d unused replays, got %d", expected, got)
+	}
+}
+
+func TestServer_Upstream(t *testing.T) {
+	var authorization, path string
+
+	upstreamServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		path = r.URL.Path
+		writeResponse(w, XMLResponse("ListQueues", sqsNamespace, XMLElement("QueueUrl", "https://sqs.us-west-2.amazonaws.com/123456789012/upstream")))
+	}))
+	defer upstreamServer.Close()
+
+	s := NewServer()
+	defer s.Close()
+
+	s.Upstream(credentials.NewStaticCredentials("upstreamAccessKey", "upstreamSecretKey", ""), endpoints.ResolverFunc(
+		func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+			return endpoints.ResolvedEndpoint{URL: upstreamServer.URL}, nil
+		},
+	))
+
+	conn := sqs.New(testSession(t, s, "sqs"))
+
+	output, err := conn.ListQueues(&sqs.ListQueuesInput{})
+	if err != nil {
+		t.Fatalf("error listing queues: %s", err)
+	}
+
+	if got := aws.StringValueSlice(output.QueueUrls); len(got) != 1 || !strings.HasSuffix(got[0], "/upstream") {
+		t.Errorf("expected upstream queue URL, got: %v", got)
+	}
+
+	if !strings.Contains(authorization, "Credential=upstreamAccessKey/") || !strings.Contains(authorization, "/us-west-2/sqs/aws4_request") {
+		t.Errorf("expected request re-signed with upstream credentials, got Authorization: %s", authorization)
+	}
+
+	if path != "/" {
+		t.Errorf("expected upstream request path %q, got %q", "/", path)
+	}
+
+	if got := len(s.Interactions()); got != 1 {
+		t.Errorf("expected 1 recorded interaction, got %d", got)
+	}
+}
@@ -0,0 +1,171 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+const snsNamespace = "http://sns.amazonaws.com/doc/2010-03-31/"
+
+// SNS is an in-memory fake of the SNS topic API operations.
+type SNS struct {
+	mu     sync.Mutex
+	topics map[string]*snsTopic
+}
+
+type snsTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// RegisterSNS registers fake SNS topic operations with the server.
+func RegisterSNS(s *Server) *SNS {
+	f := &SNS{
+		topics: make(map[string]*snsTopic),
+	}
+
+	s.Handle("sns", "CreateTopic", f.createTopic)
+	s.Handle("sns", "DeleteTopic", f.deleteTopic)
+	s.Handle("sns", "GetTopicAttributes", f.getTopicAttributes)
+	s.Handle("sns", "ListTagsForResource", f.listTagsForResource)
+	s.Handle("sns", "SetTopicAttributes", f.setTopicAttributes)
+	s.Handle("sns", "TagResource", f.tagResource)
+	s.Handle("sns", "UntagResource", f.untagResource)
+
+	return f
+}
+
+// TopicARNs returns the ARNs of all existing topics.
+func (f *SNS) TopicARNs() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	arns := make([]string, 0, len(f.topics))
+	for arn := range f.topics {
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+
+	return arns
+}
+
+func (f *SNS) createTopic(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	arn := fmt.Sprintf("arn:aws:sns:%s:%s:%s", req.Region(), AccountID, req.Param("Name"))
+
+	// CreateTopic is idempotent for an existing topic.
+	if _, ok := f.topics[arn]; !ok {
+		attributes := map[string]string{
+			"Owner":    AccountID,
+			"TopicArn": arn,
+		}
+		for k, v := range req.ParamMap("Attributes.entry", "key", "value") {
+			attributes[k] = v
+		}
+
+		f.topics[arn] = &snsTopic{
+			attributes: attributes,
+			tags:       req.ParamMap("Tags.member", "Key", "Value"),
+		}
+	}
+
+	return XMLResponse("CreateTopic", snsNamespace, XMLElement("TopicArn", arn))
+}
+
+func (f *SNS) deleteTopic(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// DeleteTopic is idempotent for a missing topic.
+	delete(f.topics, req.Param("TopicArn"))
+
+	return XMLResponse("DeleteTopic", snsNamespace, "")
+}
+
+func (f *SNS) getTopicAttributes(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	topic, ok := f.topics[req.Param("TopicArn")]
+	if !ok {
+		return snsNotFoundResponse()
+	}
+
+	return XMLResponse("GetTopicAttributes", snsNamespace,
+		fmt.Sprintf("<Attributes>%s</Attributes>", XMLEntries(topic.attributes, "entry", "key", "value")))
+}
+
+func (f *SNS) listTagsForResource(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	topic, ok := f.topics[req.Param("ResourceArn")]
+	if !ok {
+		return snsResourceNotFoundResponse()
+	}
+
+	return XMLResponse("ListTagsForResource", snsNamespace,
+		fmt.Sprintf("<Tags>%s</Tags>", XMLEntries(topic.tags, "member", "Key", "Value")))
+}
+
+func (f *SNS) setTopicAttributes(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	topic, ok := f.topics[req.Param("TopicArn")]
+	if !ok {
+		return snsNotFoundResponse()
+	}
+
+	if v := req.Param("AttributeValue"); v != "" {
+		topic.attributes[req.Param("AttributeName")] = v
+	} else {
+		delete(topic.attributes, req.Param("AttributeName"))
+	}
+
+	return XMLResponse("SetTopicAttributes", snsNamespace, "")
+}
+
+func (f *SNS) tagResource(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	topic, ok := f.topics[req.Param("ResourceArn")]
+	if !ok {
+		return snsResourceNotFoundResponse()
+	}
+
+	for k, v := range req.ParamMap("Tags.member", "Key", "Value") {
+		topic.tags[k] = v
+	}
+
+	return XMLResponse("TagResource", snsNamespace, "")
+}
+
+func (f *SNS) untagResource(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	topic, ok := f.topics[req.Param("ResourceArn")]
+	if !ok {
+		return snsResourceNotFoundResponse()
+	}
+
+	for _, k := range req.ParamList("TagKeys.member") {
+		delete(topic.tags, k)
+	}
+
+	return XMLResponse("UntagResource", snsNamespace, "")
+}
+
+func snsNotFoundResponse() *Response {
+	return ErrorResponse(http.StatusNotFound, "NotFound", "Topic does not exist")
+}
+
+func snsResourceNotFoundResponse() *Response {
+	return ErrorResponse(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+}
@@ -0,0 +1,221 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+const sqsNamespace = "http://queue.amazonaws.com/doc/2012-11-05/"
+
+var sqsDefaultQueueAttributes = map[string]string{
+	"DelaySeconds":                  "0",
+	"MaximumMessageSize":            "262144",
+	"MessageRetentionPeriod":        "345600",
+	"ReceiveMessageWaitTimeSeconds": "0",
+	"VisibilityTimeout":             "30",
+}
+
+// SQS is an in-memory fake of the SQS queue API operations.
+type SQS struct {
+	server *Server
+
+	mu     sync.Mutex
+	queues map[string]*sqsQueue
+}
+
+type sqsQueue struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// RegisterSQS registers fake SQS queue operations with the server.
+func RegisterSQS(s *Server) *SQS {
+	f := &SQS{
+		server: s,
+		queues: make(map[string]*sqsQueue),
+	}
+
+	s.Handle("sqs", "CreateQueue", f.createQueue)
+	s.Handle("sqs", "DeleteQueue", f.deleteQueue)
+	s.Handle("sqs", "GetQueueAttributes", f.getQueueAttributes)
+	s.Handle("sqs", "GetQueueUrl", f.getQueueURL)
+	s.Handle("sqs", "ListQueueTags", f.listQueueTags)
+	s.Handle("sqs", "SetQueueAttributes", f.setQueueAttributes)
+	s.Handle("sqs", "TagQueue", f.tagQueue)
+	s.Handle("sqs", "UntagQueue", f.untagQueue)
+
+	return f
+}
+
+// QueueURLs returns the URLs of all existing queues.
+func (f *SQS) QueueURLs() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	urls := make([]string, 0, len(f.queues))
+	for url := range f.queues {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	return urls
+}
+
+func (f *SQS) queueURL(name string) string {
+	return fmt.Sprintf("%s/%s/%s", f.server.RootURL(), AccountID, name)
+}
+
+func (f *SQS) createQueue(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	name := req.Param("QueueName")
+	url := f.queueURL(name)
+
+	attributes := make(map[string]string)
+	for k, v := range sqsDefaultQueueAttributes {
+		attributes[k] = v
+	}
+	for k, v := range req.ParamMap("Attribute", "Name", "Value") {
+		attributes[k] = v
+	}
+	attributes["QueueArn"] = fmt.Sprintf("arn:aws:sqs:%s:%s:%s", req.Region(), AccountID, name)
+
+	if queue, ok := f.queues[url]; ok {
+		for k, v := range attributes {
+			if queue.attributes[k] != v {
+				return ErrorResponse(http.StatusBadRequest, "QueueAlreadyExists",
+					fmt.Sprintf("A queue already exists with the same name and a different value for attribute %s", k))
+			}
+		}
+	} else {
+		f.queues[url] = &sqsQueue{
+			attributes: attributes,
+			tags:       req.ParamMap("Tag", "Key", "Value"),
+		}
+	}
+
+	return XMLResponse("CreateQueue", sqsNamespace, XMLElement("QueueUrl", url))
+}
+
+func (f *SQS) deleteQueue(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.queues[req.Param("QueueUrl")]; !ok {
+		return sqsNonExistentQueueResponse()
+	}
+
+	delete(f.queues, req.Param("QueueUrl"))
+
+	return XMLResponse("DeleteQueue", sqsNamespace, "")
+}
+
+func (f *SQS) getQueueAttributes(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	queue, ok := f.queues[req.Param("QueueUrl")]
+	if !ok {
+		return sqsNonExistentQueueResponse()
+	}
+
+	attributes := make(map[string]string)
+	names := req.ParamList("AttributeName")
+	for k, v := range queue.attributes {
+		for _, name := range names {
+			if name == "All" || name == k {
+				attributes[k] = v
+			}
+		}
+	}
+
+	return XMLResponse("GetQueueAttributes", sqsNamespace, XMLEntries(attributes, "Attribute", "Name", "Value"))
+}
+
+func (f *SQS) getQueueURL(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	url := f.queueURL(req.Param("QueueName"))
+	if _, ok := f.queues[url]; !ok {
+		return sqsNonExistentQueueResponse()
+	}
+
+	return XMLResponse("GetQueueUrl", sqsNamespace, XMLElement("QueueUrl", url))
+}
+
+func (f *SQS) listQueueTags(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	queue, ok := f.queues[req.Param("QueueUrl")]
+	if !ok {
+		return sqsNonExistentQueueResponse()
+	}
+
+	return XMLResponse("ListQueueTags", sqsNamespace, XMLEntries(queue.tags, "Tag", "Key", "Value"))
+}
+
+func (f *SQS) setQueueAttributes(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	queue, ok := f.queues[req.Param("QueueUrl")]
+	if !ok {
+		return sqsNonExistentQueueResponse()
+	}
+
+	for k, v := range req.ParamMap("Attribute", "Name", "Value") {
+		// Setting an attribute to an empty value resets it, e.g. Policy.
+		if v == "" {
+			if defaultValue, ok := sqsDefaultQueueAttributes[k]; ok {
+				queue.attributes[k] = defaultValue
+			} else {
+				delete(queue.attributes, k)
+			}
+			continue
+		}
+		queue.attributes[k] = v
+	}
+
+	return XMLResponse("SetQueueAttributes", sqsNamespace, "")
+}
+
+func (f *SQS) tagQueue(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	queue, ok := f.queues[req.Param("QueueUrl")]
+	if !ok {
+		return sqsNonExistentQueueResponse()
+	}
+
+	for k, v := range req.ParamMap("Tag", "Key", "Value") {
+		queue.tags[k] = v
+	}
+
+	return XMLResponse("TagQueue", sqsNamespace, "")
+}
+
+func (f *SQS) untagQueue(req *Request) *Response {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	queue, ok := f.queues[req.Param("QueueUrl")]
+	if !ok {
+		return sqsNonExistentQueueResponse()
+	}
+
+	for _, k := range req.ParamList("TagKey") {
+		delete(queue.tags, k)
+	}
+
+	return XMLResponse("UntagQueue", sqsNamespace, "")
+}
+
+func sqsNonExistentQueueResponse() *Response {
+	return ErrorResponse(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+}
@@ -0,0 +1,18 @@
+package fakeaws
+
+import (
+	"fmt"
+)
+
+const stsNamespace = "https://sts.amazonaws.com/doc/2011-06-15/"
+
+// RegisterSTS registers a fake STS GetCallerIdentity operation with the
+// server, which returns AccountID for any credentials.
+func RegisterSTS(s *Server) {
+	s.Handle("sts", "GetCallerIdentity", func(req *Request) *Response {
+		return XMLResponse("GetCallerIdentity", stsNamespace,
+			XMLElement("Arn", fmt.Sprintf("arn:aws:iam::%s:user/fakeaws", AccountID))+
+				XMLElement("UserId", "AIDACKCEVSQ6C2EXAMPLE")+
+				XMLElement("Account", AccountID))
+	})
+}
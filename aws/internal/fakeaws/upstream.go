@@ -0,0 +1,94 @@
+package fakeaws
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+type upstream struct {
+	client   *http.Client
+	resolver endpoints.Resolver
+	signer   *v4.Signer
+}
+
+// Upstream enables forwarding requests which have neither a replayed
+// interaction nor a registered handler to the real AWS API, so that their
+// responses are recorded. Requests are re-signed with the given credentials,
+// using the region and signing name of the original request signature.
+// Service endpoints are resolved with the given resolver, or the AWS Go SDK
+// default resolver if nil, so the server URL service names must be
+// AWS Go SDK endpoint identifiers.
+func (s *Server) Upstream(creds *credentials.Credentials, resolver endpoints.Resolver) {
+	if resolver == nil {
+		resolver = endpoints.DefaultResolver()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.upstream = &upstream{
+		client:   cleanhttp.DefaultClient(),
+		resolver: resolver,
+		signer:   v4.NewSigner(creds),
+	}
+}
+
+func (u *upstream) roundTrip(req *Request) (*Response, error) {
+	matches := credentialScopeRegexp.FindStringSubmatch(req.Header.Get("Authorization"))
+	if matches == nil {
+		return nil, fmt.Errorf("error forwarding %s %s request: missing AWS Signature Version 4 credential scope", req.Service, req.Operation)
+	}
+	region, signingName := matches[1], matches[2]
+
+	endpoint, err := u.resolver.EndpointFor(req.Service, region)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving %s endpoint in region %s: %s", req.Service, region, err)
+	}
+
+	url := endpoint.URL + req.Path
+	if len(req.Query) > 0 {
+		url += "?" + req.Query.Encode()
+	}
+
+	httpReq, err := http.NewRequest(req.Method, url, bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range req.Header {
+		switch http.CanonicalHeaderKey(k) {
+		case "Authorization", "Content-Length", "X-Amz-Content-Sha256", "X-Amz-Date", "X-Amz-Security-Token":
+			continue
+		}
+		httpReq.Header[k] = v
+	}
+
+	if _, err := u.signer.Sign(httpReq, bytes.NewReader(req.Body), signingName, region, time.Now()); err != nil {
+		return nil, fmt.Errorf("error signing %s %s request: %s", req.Service, req.Operation, err)
+	}
+
+	httpResp, err := u.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s %s response: %s", req.Service, req.Operation, err)
+	}
+
+	return &Response{
+		StatusCode:  httpResp.StatusCode,
+		ContentType: httpResp.Header.Get("Content-Type"),
+		Body:        string(body),
+	}, nil
+}
@@ -0,0 +1,200 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const filename = `list_tags_gen.go`
+
+var serviceNames = []string{
+	"acm",
+	"acmpca",
+	"amplify",
+	"apigateway",
+	"apigatewayv2",
+	"appmesh",
+	"appstream",
+	"appsync",
+	"athena",
+	"backup",
+	"cloudfront",
+	"cloudhsmv2",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"cognitoidentity",
+	"cognitoidentityprovider",
+	"configservice",
+	"databasemigrationservice",
+	"datasync",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"directoryservice",
+	"docdb",
+	"dynamodb",
+	"ecr",
+	"ecs",
+	"efs",
+	"elasticache",
+	"elasticbeanstalk",
+	"elasticsearchservice",
+	"elb",
+	"elbv2",
+	"firehose",
+	"fsx",
+	"glue",
+	"guardduty",
+	"inspector",
+	"iot",
+	"iotanalytics",
+	"iotevents",
+	"kafka",
+	"kinesis",
+	"kinesisanalytics",
+	"kinesisanalyticsv2",
+	"kinesisvideo",
+	"kms",
+	"lambda",
+	"licensemanager",
+	"mediaconnect",
+	"mediaconvert",
+	"medialive",
+	"mediapackage",
+	"mediastore",
+	"mq",
+	"neptune",
+	"opsworks",
+	"organizations",
+	"pinpoint",
+	"quicksight",
+	"rds",
+	"route53",
+	"route53resolver",
+	"sagemaker",
+	"securityhub",
+	"sfn",
+	"sns",
+	"ssm",
+	"storagegateway",
+	"swf",
+	"transfer",
+	"waf",
+	"workspaces",
+}
+
+type TemplateData struct {
+	ServiceNames []string
+}
+
+func main() {
+	// Always sort to reduce any potential generation churn
+	sort.Strings(serviceNames)
+
+	templateData := TemplateData{
+		ServiceNames: serviceNames,
+	}
+	templateFuncMap := template.FuncMap{
+		"ClientType":                           keyvaluetags.ServiceClientType,
+		"ListTagsFunction":                     keyvaluetags.ServiceListTagsFunction,
+		"ListTagsInputIdentifierField":         keyvaluetags.ServiceListTagsInputIdentifierField,
+		"ListTagsInputIdentifierRequiresSlice": keyvaluetags.ServiceListTagsInputIdentifierRequiresSlice,
+		"ListTagsInputResourceTypeField":       keyvaluetags.ServiceListTagsInputResourceTypeField,
+		"ListTagsOutputSliceField":             keyvaluetags.ServiceListTagsOutputSliceField,
+		"ListTagsOutputTagsField":              keyvaluetags.ServiceListTagsOutputTagsField,
+		"TagPackage":                           keyvaluetags.ServiceTagPackage,
+		"Title":                                strings.Title,
+	}
+
+	tmpl, err := template.New("listtags").Funcs(templateFuncMap).Parse(templateBody)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+var templateBody = `
+// Code generated by generators/listtags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+{{- range .ServiceNames }}
+	"github.com/aws/aws-sdk-go/service/{{ . }}"
+{{- end }}
+)
+{{- range .ServiceNames }}
+
+// {{ . | Title }}ListTags lists {{ . }} service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func {{ . | Title }}ListTags(conn {{ . | ClientType }}, identifier string{{ if . | ListTagsInputResourceTypeField }}, resourceType string{{ end }}) (KeyValueTags, error) {
+	input := &{{ . }}.{{ . | ListTagsFunction }}Input{
+		{{- if . | ListTagsInputIdentifierRequiresSlice }}
+		{{ . | ListTagsInputIdentifierField }}: aws.StringSlice([]string{identifier}),
+		{{- else }}
+		{{ . | ListTagsInputIdentifierField }}: aws.String(identifier),
+		{{- end }}
+		{{- if . | ListTagsInputResourceTypeField }}
+		{{ . | ListTagsInputResourceTypeField }}: aws.String(resourceType),
+		{{- end }}
+	}
+
+	output, err := conn.{{ . | ListTagsFunction }}(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+	{{- if . | ListTagsOutputSliceField }}
+
+	if len(output.{{ . | ListTagsOutputSliceField }}) == 0 {
+		return New(nil), nil
+	}
+	{{- end }}
+
+	return {{ . | Title }}KeyValueTags(output.{{ . | ListTagsOutputTagsField }}), nil
+}
+{{- end }}
+`
@@ -0,0 +1,246 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const filename = `update_tags_gen.go`
+
+var serviceNames = []string{
+	"acm",
+	"acmpca",
+	"amplify",
+	"apigateway",
+	"apigatewayv2",
+	"appmesh",
+	"appstream",
+	"appsync",
+	"athena",
+	"backup",
+	"cloudfront",
+	"cloudhsmv2",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"cognitoidentity",
+	"cognitoidentityprovider",
+	"configservice",
+	"databasemigrationservice",
+	"datapipeline",
+	"datasync",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"directoryservice",
+	"docdb",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecs",
+	"efs",
+	"elasticache",
+	"elasticbeanstalk",
+	"elasticsearchservice",
+	"elb",
+	"elbv2",
+	"emr",
+	"firehose",
+	"fsx",
+	"glue",
+	"guardduty",
+	"iot",
+	"iotanalytics",
+	"iotevents",
+	"kafka",
+	"kinesisanalytics",
+	"kinesisanalyticsv2",
+	"kinesisvideo",
+	"kms",
+	"lambda",
+	"licensemanager",
+	"lightsail",
+	"mediaconnect",
+	"mediaconvert",
+	"medialive",
+	"mediapackage",
+	"mediastore",
+	"mq",
+	"neptune",
+	"opsworks",
+	"organizations",
+	"pinpoint",
+	"quicksight",
+	"ram",
+	"rds",
+	"redshift",
+	"route53",
+	"route53resolver",
+	"sagemaker",
+	"secretsmanager",
+	"securityhub",
+	"sfn",
+	"sns",
+	"sqs",
+	"ssm",
+	"storagegateway",
+	"swf",
+	"transfer",
+	"waf",
+	"workspaces",
+}
+
+type TemplateData struct {
+	ServiceNames []string
+}
+
+func main() {
+	// Always sort to reduce any potential generation churn
+	sort.Strings(serviceNames)
+
+	templateData := TemplateData{
+		ServiceNames: serviceNames,
+	}
+	templateFuncMap := template.FuncMap{
+		"ClientType":                      keyvaluetags.ServiceClientType,
+		"TagFunction":                     keyvaluetags.ServiceTagFunction,
+		"TagInputCustomValue":             keyvaluetags.ServiceTagInputCustomValue,
+		"TagInputIdentifierField":         keyvaluetags.ServiceTagInputIdentifierField,
+		"TagInputIdentifierRequiresSlice": keyvaluetags.ServiceTagInputIdentifierRequiresSlice,
+		"TagInputResourceTypeField":       keyvaluetags.ServiceTagInputResourceTypeField,
+		"TagInputTagsField":               keyvaluetags.ServiceTagInputTagsField,
+		"TagPackage":                      keyvaluetags.ServiceTagPackage,
+		"Title":                           strings.Title,
+		"UntagFunction":                   keyvaluetags.ServiceUntagFunction,
+		"UntagInputCustomValue":           keyvaluetags.ServiceUntagInputCustomValue,
+		"UntagInputRequiresTagKeyType":    keyvaluetags.ServiceUntagInputRequiresTagKeyType,
+		"UntagInputRequiresTagType":       keyvaluetags.ServiceUntagInputRequiresTagType,
+		"UntagInputTagsField":             keyvaluetags.ServiceUntagInputTagsField,
+	}
+
+	tmpl, err := template.New("updatetags").Funcs(templateFuncMap).Parse(templateBody)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+var templateBody = `
+// Code generated by generators/updatetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+{{- range .ServiceNames }}
+	"github.com/aws/aws-sdk-go/service/{{ . }}"
+{{- end }}
+)
+{{- range .ServiceNames }}
+
+// {{ . | Title }}UpdateTags updates {{ . }} service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func {{ . | Title }}UpdateTags(conn {{ . | ClientType }}, identifier string{{ if . | TagInputResourceTypeField }}, resourceType string{{ end }}, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &{{ . }}.{{ . | UntagFunction }}Input{
+			{{- if . | TagInputIdentifierRequiresSlice }}
+			{{ . | TagInputIdentifierField }}: aws.StringSlice([]string{identifier}),
+			{{- else }}
+			{{ . | TagInputIdentifierField }}: aws.String(identifier),
+			{{- end }}
+			{{- if . | TagInputResourceTypeField }}
+			{{ . | TagInputResourceTypeField }}: aws.String(resourceType),
+			{{- end }}
+			{{- if . | UntagInputCustomValue }}
+			{{ . | UntagInputTagsField }}: {{ . | UntagInputCustomValue }},
+			{{- else if . | UntagInputRequiresTagKeyType }}
+			{{ . | UntagInputTagsField }}: removedTags.{{ . | Title }}TagKeys(),
+			{{- else if . | UntagInputRequiresTagType }}
+			{{ . | UntagInputTagsField }}: removedTags.{{ . | Title }}Tags(),
+			{{- else }}
+			{{ . | UntagInputTagsField }}: aws.StringSlice(removedTags.Keys()),
+			{{- end }}
+		}
+
+		_, err := conn.{{ . | UntagFunction }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &{{ . }}.{{ . | TagFunction }}Input{
+			{{- if . | TagInputIdentifierRequiresSlice }}
+			{{ . | TagInputIdentifierField }}: aws.StringSlice([]string{identifier}),
+			{{- else }}
+			{{ . | TagInputIdentifierField }}: aws.String(identifier),
+			{{- end }}
+			{{- if . | TagInputResourceTypeField }}
+			{{ . | TagInputResourceTypeField }}: aws.String(resourceType),
+			{{- end }}
+			{{- if . | TagInputCustomValue }}
+			{{ . | TagInputTagsField }}: {{ . | TagInputCustomValue }},
+			{{- else }}
+			{{ . | TagInputTagsField }}: updatedTags.{{ . | Title }}Tags(),
+			{{- end }}
+		}
+
+		_, err := conn.{{ . | TagFunction }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+{{- end }}
+`
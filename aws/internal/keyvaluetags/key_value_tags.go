@@ -0,0 +1,270 @@
+package keyvaluetags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+)
+
+const (
+	AwsTagKeyPrefix              = `aws:`
+	ElasticbeanstalkTagKeyPrefix = `elasticbeanstalk:`
+	NameTagKey                   = `Name`
+)
+
+// KeyValueTags is a standard implementation for AWS key-value resource tags.
+// The AWS Go SDK is split into multiple service packages, each service with
+// its own Go struct type representing a resource tag. To standardize logic
+// across all these Go types, we convert them into this Go type.
+type KeyValueTags map[string]*string
+
+// IgnoreAws returns non-AWS tag keys.
+func (tags KeyValueTags) IgnoreAws() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if !strings.HasPrefix(k, AwsTagKeyPrefix) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
+// Keys containing Name are also ignored.
+func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if strings.HasPrefix(k, AwsTagKeyPrefix) {
+			continue
+		}
+
+		if strings.HasPrefix(k, ElasticbeanstalkTagKeyPrefix) {
+			continue
+		}
+
+		// Matches any key containing Name, e.g. ProjectName, to preserve
+		// the historical Elastic Beanstalk tag filtering.
+		if strings.Contains(k, NameTagKey) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnorePrefixes returns tag keys not matching any of the given prefixes.
+func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagPrefix := range ignoreTagPrefixes {
+			if strings.HasPrefix(k, ignoreTagPrefix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// Ignore returns tag keys not present in the given tags.
+func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := ignoreTags[k]; ok {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// Keys returns tag keys.
+func (tags KeyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+// Map returns tag keys mapped to their values.
+func (tags KeyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		if v == nil {
+			result[k] = ""
+			continue
+		}
+
+		result[k] = *v
+	}
+
+	return result
+}
+
+// Merge adds missing and updates existing tags.
+func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k, v := range mergeTags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns tags removed.
+func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns tags added and updated.
+func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok || stringValue(oldV) != stringValue(newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
+
+// Chunks returns tags split into chunks of at most the given size.
+func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
+	var result []KeyValueTags
+
+	chunk := make(KeyValueTags, size)
+
+	for k, v := range tags {
+		if len(chunk) == size {
+			result = append(result, chunk)
+			chunk = make(KeyValueTags, size)
+		}
+
+		chunk[k] = v
+	}
+
+	if len(chunk) > 0 {
+		result = append(result, chunk)
+	}
+
+	return result
+}
+
+// Hash returns a stable hash value.
+// The returned value may be negative (i.e. not suitable for a 'Set' function).
+func (tags KeyValueTags) Hash() int {
+	hash := 0
+
+	for k, v := range tags {
+		hash = hash ^ hashcode.String(fmt.Sprintf("%s-%s", k, stringValue(v)))
+	}
+
+	return hash
+}
+
+// New creates KeyValueTags from common Terraform Provider SDK types.
+// Supports KeyValueTags, map[string]string, map[string]*string, map[string]interface{},
+// []string, and []interface{}. When passed []string or []interface{}, all elements
+// are treated as keys and assigned nil values.
+func New(i interface{}) KeyValueTags {
+	switch value := i.(type) {
+	case KeyValueTags:
+		result := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			result[k] = v
+		}
+
+		return result
+	case map[string]string:
+		result := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v // Prevent referencing issues
+			result[k] = &str
+		}
+
+		return result
+	case map[string]*string:
+		result := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			result[k] = v
+		}
+
+		return result
+	case map[string]interface{}:
+		result := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v.(string)
+			result[k] = &str
+		}
+
+		return result
+	case []string:
+		result := make(KeyValueTags, len(value))
+
+		for _, k := range value {
+			result[k] = nil
+		}
+
+		return result
+	case []interface{}:
+		result := make(KeyValueTags, len(value))
+
+		for _, k := range value {
+			result[k.(string)] = nil
+		}
+
+		return result
+	default:
+		return make(KeyValueTags)
+	}
+}
+
+// stringValue returns the value of the string pointer or "" if nil.
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+
+	return *v
+}
@@ -0,0 +1,597 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"aws:cloudformation:key2": "value2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"key2":                    "value2",
+				"key3":                    "value3",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreAws()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1":         "value1",
+				"elasticbeanstalk:environment-id": "value2",
+				"Name":                            "value3",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1":         "value1",
+				"elasticbeanstalk:environment-id": "value2",
+				"key3":                            "value3",
+			}),
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "name substring",
+			tags: New(map[string]string{
+				"ProjectName": "value1",
+				"NameSuffix":  "value2",
+				"name":        "value3",
+			}),
+			want: map[string]string{
+				"name": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreElasticbeanstalk()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
+	testCases := []struct {
+		name              string
+		tags              KeyValueTags
+		ignoreTagPrefixes KeyValueTags
+		want              map[string]string
+	}{
+		{
+			name:              "empty",
+			tags:              New(map[string]string{}),
+			ignoreTagPrefixes: New([]string{"key1", "key2", "key3"}),
+			want:              map[string]string{},
+		},
+		{
+			name: "all_exact",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagPrefixes: New([]string{"key1", "key2"}),
+			want:              map[string]string{},
+		},
+		{
+			name: "all_prefix",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagPrefixes: New([]string{"key"}),
+			want:              map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key2":                       "value2",
+			}),
+			ignoreTagPrefixes: New([]string{"kubernetes.io/"}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagPrefixes: New([]string{"key4"}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnorePrefixes(testCase.ignoreTagPrefixes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnore(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		ignoreTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name:       "empty",
+			tags:       New(map[string]string{}),
+			ignoreTags: New([]string{"key1"}),
+			want:       map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTags: New([]string{"key1", "key2"}),
+			want:       map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1":   "value1",
+				"key1-a": "value1-a",
+			}),
+			ignoreTags: New([]string{"key1"}),
+			want: map[string]string{
+				"key1-a": "value1-a",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Ignore(testCase.ignoreTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsKeys(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want []string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: []string{},
+		},
+		{
+			name: "keys_only",
+			tags: New([]string{"key1", "key2"}),
+			want: []string{"key1", "key2"},
+		},
+		{
+			name: "key_values",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: []string{"key1", "key2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Keys()
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %#v, want %#v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "keys_only",
+			tags: New([]string{"key1"}),
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+		{
+			name: "key_values",
+			tags: New(map[string]interface{}{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Map()
+
+			testKeyValueTagsVerifyMap(t, got, testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsMerge(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tags      KeyValueTags
+		mergeTags KeyValueTags
+		want      map[string]string
+	}{
+		{
+			name:      "empty",
+			tags:      New(map[string]string{}),
+			mergeTags: New(map[string]string{}),
+			want:      map[string]string{},
+		},
+		{
+			name: "add_only",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			mergeTags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "update_only",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			mergeTags: New(map[string]string{
+				"key1": "value1updated",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Merge(testCase.mergeTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRemoved(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "all_new",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "mixed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "no_changes",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Removed(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsUpdated(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "all_new",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "mixed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "no_changes",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Updated(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		size int
+		want []int
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			size: 10,
+			want: []int{},
+		},
+		{
+			name: "under_size",
+			tags: New([]string{"key1", "key2"}),
+			size: 10,
+			want: []int{2},
+		},
+		{
+			name: "over_size",
+			tags: New([]string{"key1", "key2", "key3", "key4", "key5"}),
+			size: 2,
+			want: []int{1, 2, 2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Chunks(testCase.size)
+
+			gotSizes := make([]int, 0, len(got))
+			seen := make(map[string]bool)
+
+			for _, chunk := range got {
+				gotSizes = append(gotSizes, len(chunk))
+
+				for k := range chunk {
+					seen[k] = true
+				}
+			}
+
+			sort.Ints(gotSizes)
+
+			if !reflect.DeepEqual(gotSizes, testCase.want) {
+				t.Errorf("got chunk sizes %#v, want %#v", gotSizes, testCase.want)
+			}
+
+			if len(seen) != len(testCase.tags) {
+				t.Errorf("got %d keys across chunks, want %d", len(seen), len(testCase.tags))
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsHash(t *testing.T) {
+	testCases := []struct {
+		name  string
+		left  KeyValueTags
+		right KeyValueTags
+		equal bool
+	}{
+		{
+			name:  "empty",
+			left:  New(map[string]string{}),
+			right: New(map[string]string{}),
+			equal: true,
+		},
+		{
+			name: "same_ordering",
+			left: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			right: New(map[string]interface{}{
+				"key2": "value2",
+				"key1": "value1",
+			}),
+			equal: true,
+		},
+		{
+			name: "different",
+			left: New(map[string]string{
+				"key1": "value1",
+			}),
+			right: New(map[string]string{
+				"key1": "value2",
+			}),
+			equal: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			left := testCase.left.Hash()
+			right := testCase.right.Hash()
+
+			if testCase.equal && left != right {
+				t.Errorf("got different hashes %d and %d, want equal", left, right)
+			}
+
+			if !testCase.equal && left == right {
+				t.Errorf("got equal hashes %d, want different", left)
+			}
+		})
+	}
+}
+
+func testKeyValueTagsVerifyMap(t *testing.T, got map[string]string, want map[string]string) {
+	for k, wantV := range want {
+		gotV, ok := got[k]
+
+		if !ok {
+			t.Errorf("want missing key: %s", k)
+			continue
+		}
+
+		if gotV != wantV {
+			t.Errorf("got key (%s) value %s, want value %s", k, gotV, wantV)
+		}
+	}
+
+	for k := range got {
+		if _, ok := want[k]; !ok {
+			t.Errorf("got extra key: %s", k)
+		}
+	}
+}
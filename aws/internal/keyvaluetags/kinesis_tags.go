@@ -0,0 +1,51 @@
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+// Kinesis requires tagging operations be split into 10 tag batches.
+const KinesisTagBatchLimit = 10
+
+// KinesisUpdateTags updates kinesis service tags.
+// The identifier is the stream name. Kinesis does not use the generated
+// implementation as tagging operations must be batched.
+func KinesisUpdateTags(conn *kinesis.Kinesis, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		for _, removedTagKeys := range removedTags.Chunks(KinesisTagBatchLimit) {
+			input := &kinesis.RemoveTagsFromStreamInput{
+				StreamName: aws.String(identifier),
+				TagKeys:    aws.StringSlice(removedTagKeys.Keys()),
+			}
+
+			_, err := conn.RemoveTagsFromStream(input)
+
+			if err != nil {
+				return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+			}
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		for _, updatedTagsChunk := range updatedTags.Chunks(KinesisTagBatchLimit) {
+			input := &kinesis.AddTagsToStreamInput{
+				StreamName: aws.String(identifier),
+				Tags:       aws.StringMap(updatedTagsChunk.Map()),
+			}
+
+			_, err := conn.AddTagsToStream(input)
+
+			if err != nil {
+				return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+			}
+		}
+	}
+
+	return nil
+}
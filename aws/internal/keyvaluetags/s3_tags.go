@@ -0,0 +1,183 @@
+package keyvaluetags
+
+// Custom S3 tagging functions using similar formatting as other service generated code.
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// S3BucketListTags lists S3 bucket tags.
+// The identifier is the bucket name.
+func S3BucketListTags(conn *s3.S3, identifier string) (KeyValueTags, error) {
+	input := &s3.GetBucketTaggingInput{
+		Bucket: aws.String(identifier),
+	}
+
+	output, err := conn.GetBucketTagging(input)
+
+	// S3 API Reference (https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketTagging.html)
+	// lists the special error as NoSuchTagSetError, however the existing logic used NoSuchTagSet
+	// and the AWS Go SDK has neither as a constant.
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchTagSet" {
+		return New(nil), nil
+	}
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return S3KeyValueTags(output.TagSet), nil
+}
+
+// S3BucketUpdateTags updates S3 bucket tags.
+// The identifier is the bucket name. As the S3 API replaces the whole tag
+// set, any AWS system tags already present on the bucket are preserved.
+func S3BucketUpdateTags(conn *s3.S3, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := S3BucketListTags(conn, identifier)
+
+	if err != nil {
+		return fmt.Errorf("error listing resource tags (%s): %s", identifier, err)
+	}
+
+	ignoredTags := allTags.Ignore(allTags.IgnoreAws())
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3.PutBucketTaggingInput{
+			Bucket: aws.String(identifier),
+			Tagging: &s3.Tagging{
+				TagSet: newTags.Merge(ignoredTags).S3Tags(),
+			},
+		}
+
+		err := s3RetryOnBucketCodes(func() error {
+			_, err := conn.PutBucketTagging(input)
+			return err
+		})
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags (%s): %s", identifier, err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3.DeleteBucketTaggingInput{
+			Bucket: aws.String(identifier),
+		}
+
+		err := s3RetryOnBucketCodes(func() error {
+			_, err := conn.DeleteBucketTagging(input)
+			return err
+		})
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// S3ObjectListTags lists S3 object tags.
+func S3ObjectListTags(conn *s3.S3, bucket, key string) (KeyValueTags, error) {
+	input := &s3.GetObjectTaggingInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	var output *s3.GetObjectTaggingOutput
+
+	// Newly created objects may not be immediately visible.
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.GetObjectTagging(input)
+
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return S3KeyValueTags(output.TagSet), nil
+}
+
+// S3ObjectUpdateTags updates S3 object tags.
+func S3ObjectUpdateTags(conn *s3.S3, bucket, key string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := S3ObjectListTags(conn, bucket, key)
+
+	if err != nil {
+		return fmt.Errorf("error listing resource tags (%s/%s): %s", bucket, key, err)
+	}
+
+	ignoredTags := allTags.Ignore(allTags.IgnoreAws())
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3.PutObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			Tagging: &s3.Tagging{
+				TagSet: newTags.Merge(ignoredTags).S3Tags(),
+			},
+		}
+
+		_, err := conn.PutObjectTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags (%s/%s): %s", bucket, key, err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		_, err := conn.DeleteObjectTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags (%s/%s): %s", bucket, key, err)
+		}
+	}
+
+	return nil
+}
+
+// s3RetryOnBucketCodes retries the given S3 bucket operation while the bucket
+// is not yet visible or a conflicting operation is in progress.
+func s3RetryOnBucketCodes(f func() error) error {
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		err := f()
+
+		if awsErr, ok := err.(awserr.Error); ok {
+			switch awsErr.Code() {
+			case s3.ErrCodeNoSuchBucket, "OperationAborted":
+				return resource.RetryableError(err)
+			}
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
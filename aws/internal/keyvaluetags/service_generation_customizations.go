@@ -0,0 +1,830 @@
+// This file contains code generation customizations for each AWS Go SDK service.
+
+package keyvaluetags
+
+import (
+	"fmt"
+)
+
+// ServiceClientType determines the service client Go type.
+// The AWS Go SDK does not provide a constant or reproducible inference methodology
+// to get the correct type name of each service, so we resort to hardcoding them.
+func ServiceClientType(serviceName string) string {
+	var funcType string
+
+	switch serviceName {
+	case "acm":
+		funcType = "ACM"
+	case "acmpca":
+		funcType = "ACMPCA"
+	case "amplify":
+		funcType = "Amplify"
+	case "apigateway":
+		funcType = "APIGateway"
+	case "apigatewayv2":
+		funcType = "ApiGatewayV2"
+	case "appmesh":
+		funcType = "AppMesh"
+	case "appstream":
+		funcType = "AppStream"
+	case "appsync":
+		funcType = "AppSync"
+	case "athena":
+		funcType = "Athena"
+	case "backup":
+		funcType = "Backup"
+	case "cloudfront":
+		funcType = "CloudFront"
+	case "cloudhsmv2":
+		funcType = "CloudHSMV2"
+	case "cloudtrail":
+		funcType = "CloudTrail"
+	case "cloudwatch":
+		funcType = "CloudWatch"
+	case "cloudwatchevents":
+		funcType = "CloudWatchEvents"
+	case "cloudwatchlogs":
+		funcType = "CloudWatchLogs"
+	case "codecommit":
+		funcType = "CodeCommit"
+	case "codedeploy":
+		funcType = "CodeDeploy"
+	case "codepipeline":
+		funcType = "CodePipeline"
+	case "cognitoidentity":
+		funcType = "CognitoIdentity"
+	case "cognitoidentityprovider":
+		funcType = "CognitoIdentityProvider"
+	case "configservice":
+		funcType = "ConfigService"
+	case "databasemigrationservice":
+		funcType = "DatabaseMigrationService"
+	case "datapipeline":
+		funcType = "DataPipeline"
+	case "datasync":
+		funcType = "DataSync"
+	case "dax":
+		funcType = "DAX"
+	case "devicefarm":
+		funcType = "DeviceFarm"
+	case "directconnect":
+		funcType = "DirectConnect"
+	case "directoryservice":
+		funcType = "DirectoryService"
+	case "docdb":
+		funcType = "DocDB"
+	case "dynamodb":
+		funcType = "DynamoDB"
+	case "ec2":
+		funcType = "EC2"
+	case "ecr":
+		funcType = "ECR"
+	case "ecs":
+		funcType = "ECS"
+	case "efs":
+		funcType = "EFS"
+	case "elasticache":
+		funcType = "ElastiCache"
+	case "elasticbeanstalk":
+		funcType = "ElasticBeanstalk"
+	case "elasticsearchservice":
+		funcType = "ElasticsearchService"
+	case "elb":
+		funcType = "ELB"
+	case "elbv2":
+		funcType = "ELBV2"
+	case "emr":
+		funcType = "EMR"
+	case "firehose":
+		funcType = "Firehose"
+	case "fsx":
+		funcType = "FSx"
+	case "glue":
+		funcType = "Glue"
+	case "guardduty":
+		funcType = "GuardDuty"
+	case "inspector":
+		funcType = "Inspector"
+	case "iot":
+		funcType = "IoT"
+	case "iotanalytics":
+		funcType = "IoTAnalytics"
+	case "iotevents":
+		funcType = "IoTEvents"
+	case "kafka":
+		funcType = "Kafka"
+	case "kinesis":
+		funcType = "Kinesis"
+	case "kinesisanalytics":
+		funcType = "KinesisAnalytics"
+	case "kinesisanalyticsv2":
+		funcType = "KinesisAnalyticsV2"
+	case "kinesisvideo":
+		funcType = "KinesisVideo"
+	case "kms":
+		funcType = "KMS"
+	case "lambda":
+		funcType = "Lambda"
+	case "licensemanager":
+		funcType = "LicenseManager"
+	case "lightsail":
+		funcType = "Lightsail"
+	case "mediaconnect":
+		funcType = "MediaConnect"
+	case "mediaconvert":
+		funcType = "MediaConvert"
+	case "medialive":
+		funcType = "MediaLive"
+	case "mediapackage":
+		funcType = "MediaPackage"
+	case "mediastore":
+		funcType = "MediaStore"
+	case "mq":
+		funcType = "MQ"
+	case "neptune":
+		funcType = "Neptune"
+	case "opsworks":
+		funcType = "OpsWorks"
+	case "organizations":
+		funcType = "Organizations"
+	case "pinpoint":
+		funcType = "Pinpoint"
+	case "quicksight":
+		funcType = "QuickSight"
+	case "ram":
+		funcType = "RAM"
+	case "rds":
+		funcType = "RDS"
+	case "redshift":
+		funcType = "Redshift"
+	case "route53":
+		funcType = "Route53"
+	case "route53resolver":
+		funcType = "Route53Resolver"
+	case "sagemaker":
+		funcType = "SageMaker"
+	case "secretsmanager":
+		funcType = "SecretsManager"
+	case "securityhub":
+		funcType = "SecurityHub"
+	case "sfn":
+		funcType = "SFN"
+	case "sns":
+		funcType = "SNS"
+	case "sqs":
+		funcType = "SQS"
+	case "ssm":
+		funcType = "SSM"
+	case "storagegateway":
+		funcType = "StorageGateway"
+	case "swf":
+		funcType = "SWF"
+	case "transfer":
+		funcType = "Transfer"
+	case "waf":
+		funcType = "WAF"
+	case "workspaces":
+		funcType = "WorkSpaces"
+	default:
+		return ""
+	}
+
+	return fmt.Sprintf("*%[1]s.%[2]s", serviceName, funcType)
+}
+
+// ServiceListTagsFunction determines the service list tagging function.
+func ServiceListTagsFunction(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "ListTagsForCertificate"
+	case "acmpca":
+		return "ListTags"
+	case "apigateway":
+		return "GetTags"
+	case "apigatewayv2":
+		return "GetTags"
+	case "backup":
+		return "ListTags"
+	case "cloudhsmv2":
+		return "ListTags"
+	case "cloudwatchlogs":
+		return "ListTagsLogGroup"
+	case "dax":
+		return "ListTags"
+	case "directconnect":
+		return "DescribeTags"
+	case "dynamodb":
+		return "ListTagsOfResource"
+	case "efs":
+		return "DescribeTags"
+	case "elasticsearchservice":
+		return "ListTags"
+	case "elb":
+		return "DescribeTags"
+	case "elbv2":
+		return "DescribeTags"
+	case "firehose":
+		return "ListTagsForDeliveryStream"
+	case "glue":
+		return "GetTags"
+	case "kinesis":
+		return "ListTagsForStream"
+	case "kinesisvideo":
+		return "ListTagsForStream"
+	case "kms":
+		return "ListResourceTags"
+	case "lambda":
+		return "ListTags"
+	case "mq":
+		return "ListTags"
+	case "opsworks":
+		return "ListTags"
+	case "sagemaker":
+		return "ListTags"
+	case "workspaces":
+		return "DescribeTags"
+	default:
+		return "ListTagsForResource"
+	}
+}
+
+// ServiceListTagsInputIdentifierField determines the service list tag identifier field.
+// This causes the implementation to use the Go SDK field as the identifier.
+func ServiceListTagsInputIdentifierField(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "CertificateArn"
+	case "acmpca":
+		return "CertificateAuthorityArn"
+	case "athena":
+		return "ResourceARN"
+	case "cloudfront":
+		return "Resource"
+	case "cloudhsmv2":
+		return "ResourceId"
+	case "cloudwatch":
+		return "ResourceARN"
+	case "cloudwatchevents":
+		return "ResourceARN"
+	case "cloudwatchlogs":
+		return "LogGroupName"
+	case "dax":
+		return "ResourceName"
+	case "devicefarm":
+		return "ResourceARN"
+	case "directconnect":
+		return "ResourceArns"
+	case "directoryservice":
+		return "ResourceId"
+	case "docdb":
+		return "ResourceName"
+	case "efs":
+		return "FileSystemId"
+	case "elasticache":
+		return "ResourceName"
+	case "elasticsearchservice":
+		return "ARN"
+	case "elb":
+		return "LoadBalancerNames"
+	case "elbv2":
+		return "ResourceArns"
+	case "firehose":
+		return "DeliveryStreamName"
+	case "fsx":
+		return "ResourceARN"
+	case "kinesis":
+		return "StreamName"
+	case "kinesisanalytics":
+		return "ResourceARN"
+	case "kinesisanalyticsv2":
+		return "ResourceARN"
+	case "kinesisvideo":
+		return "StreamARN"
+	case "kms":
+		return "KeyId"
+	case "lambda":
+		return "Resource"
+	case "mediaconvert":
+		return "Arn"
+	case "mediastore":
+		return "Resource"
+	case "neptune":
+		return "ResourceName"
+	case "organizations":
+		return "ResourceId"
+	case "rds":
+		return "ResourceName"
+	case "route53":
+		return "ResourceId"
+	case "ssm":
+		return "ResourceId"
+	case "storagegateway":
+		return "ResourceARN"
+	case "transfer":
+		return "Arn"
+	case "waf":
+		return "ResourceARN"
+	case "workspaces":
+		return "ResourceId"
+	default:
+		return "ResourceArn"
+	}
+}
+
+// ServiceListTagsInputIdentifierRequiresSlice determines if the service list tagging resource field requires a slice.
+func ServiceListTagsInputIdentifierRequiresSlice(serviceName string) string {
+	switch serviceName {
+	case "directconnect":
+		return "yes"
+	case "elb":
+		return "yes"
+	case "elbv2":
+		return "yes"
+	default:
+		return ""
+	}
+}
+
+// ServiceListTagsInputResourceTypeField determines the service list tagging resource type field.
+func ServiceListTagsInputResourceTypeField(serviceName string) string {
+	switch serviceName {
+	case "route53":
+		return "ResourceType"
+	case "ssm":
+		return "ResourceType"
+	default:
+		return ""
+	}
+}
+
+// ServiceListTagsOutputSliceField determines the service list tag output field
+// wrapping per-resource tags when the identifier is sent as a slice.
+func ServiceListTagsOutputSliceField(serviceName string) string {
+	switch serviceName {
+	case "directconnect":
+		return "ResourceTags"
+	case "elb":
+		return "TagDescriptions"
+	case "elbv2":
+		return "TagDescriptions"
+	default:
+		return ""
+	}
+}
+
+// ServiceListTagsOutputTagsField determines the service list tag field.
+func ServiceListTagsOutputTagsField(serviceName string) string {
+	switch serviceName {
+	case "cloudfront":
+		return "Tags.Items"
+	case "cloudhsmv2":
+		return "TagList"
+	case "databasemigrationservice":
+		return "TagList"
+	case "directconnect":
+		return "ResourceTags[0].Tags"
+	case "docdb":
+		return "TagList"
+	case "elasticache":
+		return "TagList"
+	case "elasticbeanstalk":
+		return "ResourceTags"
+	case "elasticsearchservice":
+		return "TagList"
+	case "elb":
+		return "TagDescriptions[0].Tags"
+	case "elbv2":
+		return "TagDescriptions[0].Tags"
+	case "mediaconvert":
+		return "ResourceTags.Tags"
+	case "neptune":
+		return "TagList"
+	case "pinpoint":
+		return "TagsModel.Tags"
+	case "rds":
+		return "TagList"
+	case "route53":
+		return "ResourceTagSet.Tags"
+	case "ssm":
+		return "TagList"
+	case "waf":
+		return "TagInfoForResource.TagList"
+	case "workspaces":
+		return "TagList"
+	default:
+		return "Tags"
+	}
+}
+
+// ServiceTagFunction determines the service tagging function.
+func ServiceTagFunction(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "AddTagsToCertificate"
+	case "acmpca":
+		return "TagCertificateAuthority"
+	case "cloudtrail":
+		return "AddTags"
+	case "cloudwatchlogs":
+		return "TagLogGroup"
+	case "databasemigrationservice":
+		return "AddTagsToResource"
+	case "datapipeline":
+		return "AddTags"
+	case "directoryservice":
+		return "AddTagsToResource"
+	case "docdb":
+		return "AddTagsToResource"
+	case "ec2":
+		return "CreateTags"
+	case "efs":
+		return "CreateTags"
+	case "elasticache":
+		return "AddTagsToResource"
+	case "elasticbeanstalk":
+		return "UpdateTagsForResource"
+	case "elasticsearchservice":
+		return "AddTags"
+	case "elb":
+		return "AddTags"
+	case "elbv2":
+		return "AddTags"
+	case "emr":
+		return "AddTags"
+	case "firehose":
+		return "TagDeliveryStream"
+	case "kinesisvideo":
+		return "TagStream"
+	case "medialive":
+		return "CreateTags"
+	case "mq":
+		return "CreateTags"
+	case "neptune":
+		return "AddTagsToResource"
+	case "rds":
+		return "AddTagsToResource"
+	case "redshift":
+		return "CreateTags"
+	case "route53":
+		return "ChangeTagsForResource"
+	case "sagemaker":
+		return "AddTags"
+	case "sqs":
+		return "TagQueue"
+	case "ssm":
+		return "AddTagsToResource"
+	case "storagegateway":
+		return "AddTagsToResource"
+	case "workspaces":
+		return "CreateTags"
+	default:
+		return "TagResource"
+	}
+}
+
+// ServiceTagInputIdentifierField determines the service tag identifier field.
+func ServiceTagInputIdentifierField(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "CertificateArn"
+	case "acmpca":
+		return "CertificateAuthorityArn"
+	case "athena":
+		return "ResourceARN"
+	case "cloudfront":
+		return "Resource"
+	case "cloudhsmv2":
+		return "ResourceId"
+	case "cloudtrail":
+		return "ResourceId"
+	case "cloudwatch":
+		return "ResourceARN"
+	case "cloudwatchevents":
+		return "ResourceARN"
+	case "cloudwatchlogs":
+		return "LogGroupName"
+	case "datapipeline":
+		return "PipelineId"
+	case "dax":
+		return "ResourceName"
+	case "devicefarm":
+		return "ResourceARN"
+	case "directoryservice":
+		return "ResourceId"
+	case "docdb":
+		return "ResourceName"
+	case "ec2":
+		return "Resources"
+	case "efs":
+		return "FileSystemId"
+	case "elasticache":
+		return "ResourceName"
+	case "elasticsearchservice":
+		return "ARN"
+	case "elb":
+		return "LoadBalancerNames"
+	case "elbv2":
+		return "ResourceArns"
+	case "emr":
+		return "ResourceId"
+	case "firehose":
+		return "DeliveryStreamName"
+	case "fsx":
+		return "ResourceARN"
+	case "kinesisanalytics":
+		return "ResourceARN"
+	case "kinesisanalyticsv2":
+		return "ResourceARN"
+	case "kinesisvideo":
+		return "StreamARN"
+	case "kms":
+		return "KeyId"
+	case "lambda":
+		return "Resource"
+	case "lightsail":
+		return "ResourceName"
+	case "mediaconvert":
+		return "Arn"
+	case "mediastore":
+		return "Resource"
+	case "neptune":
+		return "ResourceName"
+	case "organizations":
+		return "ResourceId"
+	case "ram":
+		return "ResourceShareArn"
+	case "rds":
+		return "ResourceName"
+	case "redshift":
+		return "ResourceName"
+	case "route53":
+		return "ResourceId"
+	case "secretsmanager":
+		return "SecretId"
+	case "sqs":
+		return "QueueUrl"
+	case "ssm":
+		return "ResourceId"
+	case "storagegateway":
+		return "ResourceARN"
+	case "transfer":
+		return "Arn"
+	case "waf":
+		return "ResourceARN"
+	case "workspaces":
+		return "ResourceId"
+	default:
+		return "ResourceArn"
+	}
+}
+
+// ServiceTagInputIdentifierRequiresSlice determines if the service tagging resource field requires a slice.
+func ServiceTagInputIdentifierRequiresSlice(serviceName string) string {
+	switch serviceName {
+	case "ec2":
+		return "yes"
+	case "elb":
+		return "yes"
+	case "elbv2":
+		return "yes"
+	default:
+		return ""
+	}
+}
+
+// ServiceTagInputTagsField determines the service tagging tags field.
+func ServiceTagInputTagsField(serviceName string) string {
+	switch serviceName {
+	case "cloudhsmv2":
+		return "TagList"
+	case "cloudtrail":
+		return "TagsList"
+	case "elasticbeanstalk":
+		return "TagsToAdd"
+	case "elasticsearchservice":
+		return "TagList"
+	case "glue":
+		return "TagsToAdd"
+	case "pinpoint":
+		return "TagsModel"
+	case "route53":
+		return "AddTags"
+	default:
+		return "Tags"
+	}
+}
+
+// ServiceTagInputCustomValue determines any combination of service tagging tags field value customizations.
+func ServiceTagInputCustomValue(serviceName string) string {
+	switch serviceName {
+	case "cloudfront":
+		return "&cloudfront.Tags{Items: updatedTags.CloudfrontTags()}"
+	case "pinpoint":
+		return "&pinpoint.TagsModel{Tags: updatedTags.PinpointTags()}"
+	default:
+		return ""
+	}
+}
+
+// ServiceTagInputResourceTypeField determines the service tagging resource type field.
+func ServiceTagInputResourceTypeField(serviceName string) string {
+	switch serviceName {
+	case "route53":
+		return "ResourceType"
+	case "ssm":
+		return "ResourceType"
+	default:
+		return ""
+	}
+}
+
+// ServiceTagPackage determines the service tagging package.
+// Some AWS Go SDK services use a shared package for tag types.
+func ServiceTagPackage(serviceName string) string {
+	switch serviceName {
+	case "wafregional":
+		return "waf"
+	default:
+		return serviceName
+	}
+}
+
+// ServiceTagKeyType determines the service tagging tag key type.
+func ServiceTagKeyType(serviceName string) string {
+	switch serviceName {
+	case "elb":
+		return "TagKeyOnly"
+	default:
+		return ""
+	}
+}
+
+// ServiceTagType determines the service tagging tag type.
+func ServiceTagType(serviceName string) string {
+	switch serviceName {
+	case "appmesh":
+		return "TagRef"
+	case "datasync":
+		return "TagListEntry"
+	case "swf":
+		return "ResourceTag"
+	default:
+		return "Tag"
+	}
+}
+
+// ServiceTagTypeKeyField determines the service tagging tag type key field.
+func ServiceTagTypeKeyField(serviceName string) string {
+	switch serviceName {
+	case "kms":
+		return "TagKey"
+	default:
+		return "Key"
+	}
+}
+
+// ServiceTagTypeValueField determines the service tagging tag type value field.
+func ServiceTagTypeValueField(serviceName string) string {
+	switch serviceName {
+	case "kms":
+		return "TagValue"
+	default:
+		return "Value"
+	}
+}
+
+// ServiceUntagFunction determines the service untagging function.
+func ServiceUntagFunction(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "RemoveTagsFromCertificate"
+	case "acmpca":
+		return "UntagCertificateAuthority"
+	case "cloudtrail":
+		return "RemoveTags"
+	case "cloudwatchlogs":
+		return "UntagLogGroup"
+	case "databasemigrationservice":
+		return "RemoveTagsFromResource"
+	case "datapipeline":
+		return "RemoveTags"
+	case "directoryservice":
+		return "RemoveTagsFromResource"
+	case "docdb":
+		return "RemoveTagsFromResource"
+	case "ec2":
+		return "DeleteTags"
+	case "efs":
+		return "DeleteTags"
+	case "elasticache":
+		return "RemoveTagsFromResource"
+	case "elasticbeanstalk":
+		return "UpdateTagsForResource"
+	case "elasticsearchservice":
+		return "RemoveTags"
+	case "elb":
+		return "RemoveTags"
+	case "elbv2":
+		return "RemoveTags"
+	case "emr":
+		return "RemoveTags"
+	case "firehose":
+		return "UntagDeliveryStream"
+	case "kinesisvideo":
+		return "UntagStream"
+	case "medialive":
+		return "DeleteTags"
+	case "mq":
+		return "DeleteTags"
+	case "neptune":
+		return "RemoveTagsFromResource"
+	case "rds":
+		return "RemoveTagsFromResource"
+	case "redshift":
+		return "DeleteTags"
+	case "route53":
+		return "ChangeTagsForResource"
+	case "sagemaker":
+		return "DeleteTags"
+	case "sqs":
+		return "UntagQueue"
+	case "ssm":
+		return "RemoveTagsFromResource"
+	case "storagegateway":
+		return "RemoveTagsFromResource"
+	case "workspaces":
+		return "DeleteTags"
+	default:
+		return "UntagResource"
+	}
+}
+
+// ServiceUntagInputRequiresTagType determines if the service untagging requires full Tag type.
+func ServiceUntagInputRequiresTagType(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "yes"
+	case "acmpca":
+		return "yes"
+	case "cloudtrail":
+		return "yes"
+	case "ec2":
+		return "yes"
+	default:
+		return ""
+	}
+}
+
+// ServiceUntagInputRequiresTagKeyType determines if a special type for the untagging function tag key field is needed.
+func ServiceUntagInputRequiresTagKeyType(serviceName string) string {
+	switch serviceName {
+	case "elb":
+		return "yes"
+	default:
+		return ""
+	}
+}
+
+// ServiceUntagInputTagsField determines the service untagging tags field.
+func ServiceUntagInputTagsField(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "Tags"
+	case "acmpca":
+		return "Tags"
+	case "backup":
+		return "TagKeyList"
+	case "cloudhsmv2":
+		return "TagKeyList"
+	case "cloudtrail":
+		return "TagsList"
+	case "cloudwatchlogs":
+		return "Tags"
+	case "datasync":
+		return "Keys"
+	case "ec2":
+		return "Tags"
+	case "elasticbeanstalk":
+		return "TagsToRemove"
+	case "elb":
+		return "Tags"
+	case "glue":
+		return "TagsToRemove"
+	case "kinesisvideo":
+		return "TagKeyList"
+	case "route53":
+		return "RemoveTagKeys"
+	default:
+		return "TagKeys"
+	}
+}
+
+// ServiceUntagInputCustomValue determines any combination of service untagging tags field value customizations.
+func ServiceUntagInputCustomValue(serviceName string) string {
+	switch serviceName {
+	case "cloudfront":
+		return "&cloudfront.TagKeys{Items: aws.StringSlice(removedTags.Keys())}"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,336 @@
+// Package ratelimit implements client-side rate limiting of AWS API requests.
+//
+// A Limiter keeps a token bucket for each AWS service and region. Requests
+// wait for a token before they are sent. With a fixed rate configured, the
+// bucket is always in effect. In adaptive mode, the bucket comes into effect
+// when the service first throttles a request: its rate is then lowered to a
+// fraction of the rate at which requests were being sent, and raised again
+// gradually while requests succeed, until the bucket is no longer needed.
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// throttledRateFactor is the fraction of the sending rate to which the
+	// rate is lowered when a request is throttled.
+	throttledRateFactor = 0.7
+
+	// minRate is the lowest rate in requests per second to which the rate is
+	// lowered when requests are throttled.
+	minRate = 0.5
+
+	// recoveryPeriod is the time after a throttled request in which the rate
+	// is raised back to the sending rate at which the request was throttled.
+	recoveryPeriod = 10 * time.Second
+
+	// throttleInterval is the minimum time between rate reductions, so that
+	// the requests in flight when throttling starts lower the rate only once.
+	throttleInterval = 1 * time.Second
+
+	// measureInterval is the interval over which the sending rate is measured.
+	measureInterval = 1 * time.Second
+)
+
+// Config configures a Limiter.
+type Config struct {
+	// Adaptive enables lowering the rate of a service when it throttles
+	// requests.
+	Adaptive bool
+
+	// Burst is the maximum number of requests sent at once to a service when
+	// its rate is limited. Defaults to 1.
+	Burst int
+
+	// RequestsPerSecond is the maximum rate of requests to each service.
+	// Zero means no fixed limit.
+	RequestsPerSecond float64
+}
+
+// Limiter limits the rate of AWS API requests to each service and region.
+type Limiter struct {
+	config Config
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+
+	// now returns the current time, and is replaced in tests.
+	now func() time.Time
+}
+
+// New returns a new Limiter with the given configuration.
+func New(config Config) *Limiter {
+	if config.Burst < 1 {
+		config.Burst = 1
+	}
+
+	return &Limiter{
+		config:  config,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// ConfigureHandlers adds the request handlers of the Limiter to the handlers
+// of an AWS session or service client. Throttled requests are always retried,
+// even if their rate is not limited.
+func (l *Limiter) ConfigureHandlers(handlers *request.Handlers) {
+	handlers.Retry.PushBackNamed(request.NamedHandler{Name: "ratelimit.RetryThrottled", Fn: retryThrottled})
+
+	if !l.config.Adaptive && l.config.RequestsPerSecond <= 0 {
+		return
+	}
+
+	handlers.Send.PushFrontNamed(request.NamedHandler{Name: "ratelimit.Wait", Fn: l.wait})
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{Name: "ratelimit.Update", Fn: l.update})
+}
+
+// Rate returns the current rate limit of requests to the service in the
+// region, or zero if requests are not limited.
+func (l *Limiter) Rate(service, region string) float64 {
+	b := l.bucket(service, region)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.limited {
+		return 0
+	}
+
+	return b.rate
+}
+
+func (l *Limiter) bucket(service, region string) *bucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := service + "/" + region
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = newBucket(l.config, l.now())
+		l.buckets[key] = b
+	}
+
+	return b
+}
+
+func (l *Limiter) wait(r *request.Request) {
+	b := l.bucket(r.ClientInfo.ServiceName, aws.StringValue(r.Config.Region))
+
+	for {
+		delay := b.take(l.now())
+		if delay <= 0 {
+			return
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-r.Context().Done():
+			timer.Stop()
+			r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting for rate limit", r.Context().Err())
+			return
+		}
+	}
+}
+
+func (l *Limiter) update(r *request.Request) {
+	b := l.bucket(r.ClientInfo.ServiceName, aws.StringValue(r.Config.Region))
+
+	switch {
+	case IsThrottlingError(r.Error):
+		b.throttled(l.now())
+	case r.Error == nil:
+		b.succeeded(l.now())
+	}
+}
+
+// retryThrottled marks throttled requests as retryable, including those
+// with error codes which the AWS SDK does not recognize as throttling.
+func retryThrottled(r *request.Request) {
+	if IsThrottlingError(r.Error) {
+		r.Retryable = aws.Bool(true)
+	}
+}
+
+// IsThrottlingError returns whether the error is an AWS API error indicating
+// that the request was throttled.
+func IsThrottlingError(err error) bool {
+	if request.IsErrorThrottle(err) {
+		return true
+	}
+
+	if err, ok := err.(awserr.RequestFailure); ok && err.StatusCode() == http.StatusTooManyRequests {
+		return true
+	}
+
+	// Several services throttle requests with service specific error codes,
+	// e.g. Kinesis: LimitExceededException: Rate exceeded for stream ...
+	if err, ok := err.(awserr.Error); ok && strings.Contains(err.Message(), "Rate exceeded") {
+		return true
+	}
+
+	return false
+}
+
+// bucket is the token bucket of a single service and region.
+type bucket struct {
+	adaptive bool
+	burst    float64
+	maxRate  float64
+
+	mu sync.Mutex
+
+	// limited is whether requests wait for tokens.
+	limited    bool
+	rate       float64
+	tokens     float64
+	lastRefill time.Time
+
+	// throttledRate is the sending rate when a request was last throttled.
+	throttledRate float64
+	lastThrottle  time.Time
+
+	// sendingRate is the measured rate at which requests are sent.
+	sendingRate  float64
+	measureStart time.Time
+	measureCount int
+}
+
+func newBucket(config Config, now time.Time) *bucket {
+	b := &bucket{
+		adaptive:     config.Adaptive,
+		burst:        float64(config.Burst),
+		maxRate:      config.RequestsPerSecond,
+		lastRefill:   now,
+		measureStart: now,
+	}
+
+	if b.maxRate > 0 {
+		b.limited = true
+		b.rate = b.maxRate
+		b.tokens = b.burst
+	}
+
+	return b
+}
+
+// take takes a token from the bucket and returns zero, or returns how long to
+// wait for a token to become available.
+func (b *bucket) take(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+
+	if !b.limited || b.tokens >= 1 {
+		if b.limited {
+			b.tokens--
+		}
+		b.measure(now)
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.lastRefill).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate)
+	}
+
+	b.lastRefill = now
+}
+
+// measure counts a sent request towards the measured sending rate.
+func (b *bucket) measure(now time.Time) {
+	b.measureCount++
+
+	if elapsed := now.Sub(b.measureStart); elapsed >= measureInterval {
+		rate := float64(b.measureCount) / elapsed.Seconds()
+
+		if b.sendingRate == 0 {
+			b.sendingRate = rate
+		} else {
+			b.sendingRate = 0.8*rate + 0.2*b.sendingRate
+		}
+
+		b.measureStart = now
+		b.measureCount = 0
+	}
+}
+
+// throttled lowers the rate after a throttled request.
+func (b *bucket) throttled(now time.Time) {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.lastThrottle.IsZero() && now.Sub(b.lastThrottle) < throttleInterval {
+		return
+	}
+
+	rate := b.sendingRate
+	if elapsed := now.Sub(b.measureStart).Seconds(); rate == 0 && elapsed > 0 {
+		rate = float64(b.measureCount) / elapsed
+	}
+	if b.limited && (rate == 0 || b.rate < rate) {
+		rate = b.rate
+	}
+
+	b.refill(now)
+
+	b.throttledRate = math.Max(rate, minRate)
+	b.lastThrottle = now
+	b.rate = math.Max(b.throttledRate*throttledRateFactor, minRate)
+	b.tokens = math.Min(b.tokens, 0)
+	b.limited = true
+}
+
+// succeeded raises the rate after a successful request, depending on the
+// time since the last throttled request.
+func (b *bucket) succeeded(now time.Time) {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.lastThrottle.IsZero() {
+		return
+	}
+
+	b.refill(now)
+
+	recovered := now.Sub(b.lastThrottle).Seconds() / recoveryPeriod.Seconds()
+	rate := b.throttledRate * (throttledRateFactor + (1-throttledRateFactor)*recovered)
+
+	if b.maxRate > 0 {
+		b.rate = math.Min(rate, b.maxRate)
+		return
+	}
+
+	// Without a fixed limit, stop limiting once the rate has doubled since
+	// the last throttled request.
+	if rate >= 2*b.throttledRate {
+		b.limited = false
+		b.lastThrottle = time.Time{}
+		return
+	}
+
+	b.rate = rate
+}
@@ -0,0 +1,243 @@
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestIsThrottlingError(t *testing.T) {
+	testCases := []struct {
+		err      error
+		expected bool
+	}{
+		{
+			err:      nil,
+			expected: false,
+		},
+		{
+			err:      errors.New("Throttling"),
+			expected: false,
+		},
+		{
+			err:      awserr.New("ValidationError", "invalid parameter", nil),
+			expected: false,
+		},
+		{
+			err:      awserr.New("Throttling", "Rate exceeded", nil),
+			expected: true,
+		},
+		{
+			err:      awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			expected: true,
+		},
+		{
+			err:      awserr.New("LimitExceededException", "Rate exceeded for stream test under account 123456789012.", nil),
+			expected: true,
+		},
+		{
+			err:      awserr.NewRequestFailure(awserr.New("TooManyRequests", "", nil), http.StatusTooManyRequests, "id"),
+			expected: true,
+		},
+		{
+			err:      awserr.NewRequestFailure(awserr.New("ServiceUnavailable", "", nil), http.StatusServiceUnavailable, "id"),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := IsThrottlingError(tc.err); got != tc.expected {
+			t.Errorf("IsThrottlingError(%v): expected %t, got %t", tc.err, tc.expected, got)
+		}
+	}
+}
+
+func TestBucket_Fixed(t *testing.T) {
+	now := time.Now()
+	b := newBucket(Config{Burst: 2, RequestsPerSecond: 4}, now)
+
+	for i := 0; i < 2; i++ {
+		if delay := b.take(now); delay != 0 {
+			t.Fatalf("request %d: expected no delay within burst, got %s", i, delay)
+		}
+	}
+
+	if delay, expected := b.take(now), 250*time.Millisecond; delay != expected {
+		t.Fatalf("expected delay %s after burst, got %s", expected, delay)
+	}
+
+	now = now.Add(250 * time.Millisecond)
+
+	if delay := b.take(now); delay != 0 {
+		t.Fatalf("expected no delay after refill, got %s", delay)
+	}
+
+	// A fixed rate is not lowered by throttled requests.
+	b.throttled(now)
+
+	if b.rate != 4 {
+		t.Errorf("expected rate 4, got %f", b.rate)
+	}
+}
+
+func TestBucket_Adaptive(t *testing.T) {
+	now := time.Now()
+	b := newBucket(Config{Adaptive: true, Burst: 1}, now)
+
+	// Send 20 requests per second for two seconds without limit.
+	for i := 0; i < 40; i++ {
+		now = now.Add(50 * time.Millisecond)
+		if delay := b.take(now); delay != 0 {
+			t.Fatalf("request %d: expected no delay before throttling, got %s", i, delay)
+		}
+		b.succeeded(now)
+	}
+
+	b.throttled(now)
+
+	if !b.limited {
+		t.Fatal("expected requests to be limited after throttling")
+	}
+
+	if expected := 20 * throttledRateFactor; math.Abs(b.rate-expected) > 0.01 {
+		t.Fatalf("expected rate %f after throttling, got %f", expected, b.rate)
+	}
+
+	// Requests in flight when throttling starts lower the rate only once.
+	b.throttled(now.Add(100 * time.Millisecond))
+
+	if expected := 20 * throttledRateFactor; math.Abs(b.rate-expected) > 0.01 {
+		t.Fatalf("expected rate %f after repeated throttling, got %f", expected, b.rate)
+	}
+
+	if delay := b.take(now); delay <= 0 {
+		t.Fatalf("expected delay after throttling, got %s", delay)
+	}
+
+	b.succeeded(now.Add(recoveryPeriod))
+
+	if expected := 20.0; math.Abs(b.rate-expected) > 0.01 {
+		t.Fatalf("expected rate %f after recovery period, got %f", expected, b.rate)
+	}
+
+	b.succeeded(now.Add(5 * recoveryPeriod))
+
+	if b.limited {
+		t.Fatalf("expected requests not to be limited after recovery, got rate %f", b.rate)
+	}
+}
+
+func TestBucket_AdaptiveMaxRate(t *testing.T) {
+	now := time.Now()
+	b := newBucket(Config{Adaptive: true, Burst: 1, RequestsPerSecond: 10}, now)
+
+	b.throttled(now)
+
+	if expected := 10 * throttledRateFactor; math.Abs(b.rate-expected) > 0.01 {
+		t.Fatalf("expected rate %f after throttling, got %f", expected, b.rate)
+	}
+
+	b.succeeded(now.Add(5 * recoveryPeriod))
+
+	if !b.limited || b.rate != 10 {
+		t.Fatalf("expected requests to be limited to rate 10 after recovery, got %f", b.rate)
+	}
+}
+
+func TestLimiter_Throttled(t *testing.T) {
+	s := fakeaws.NewServer()
+	defer s.Close()
+
+	throttle := true
+
+	s.Handle("sqs", "ListQueues", func(req *fakeaws.Request) *fakeaws.Response {
+		if throttle {
+			throttle = false
+			return fakeaws.ErrorResponse(http.StatusBadRequest, "Throttling", "Rate exceeded")
+		}
+
+		return fakeaws.XMLResponse("ListQueues", "http://queue.amazonaws.com/doc/2012-11-05/", "")
+	})
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:    aws.String(s.URL("sqs")),
+		MaxRetries:  aws.Int(1),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	l := New(Config{Adaptive: true})
+	l.ConfigureHandlers(&sess.Handlers)
+
+	conn := sqs.New(sess)
+
+	if rate := l.Rate("sqs", "us-west-2"); rate != 0 {
+		t.Fatalf("expected no rate limit before throttling, got %f", rate)
+	}
+
+	if _, err := conn.ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("error listing queues: %s", err)
+	}
+
+	if rate := l.Rate("sqs", "us-west-2"); rate < minRate {
+		t.Errorf("expected rate limit after throttling, got %f", rate)
+	}
+
+	if rate := l.Rate("sqs", "us-east-1"); rate != 0 {
+		t.Errorf("expected no rate limit in other region, got %f", rate)
+	}
+}
+
+func TestLimiter_RetryThrottled(t *testing.T) {
+	s := fakeaws.NewServer()
+	defer s.Close()
+
+	attempts := 0
+
+	s.Handle("sqs", "ListQueues", func(req *fakeaws.Request) *fakeaws.Response {
+		attempts++
+		if attempts == 1 {
+			return fakeaws.ErrorResponse(http.StatusBadRequest, "LimitExceededException", "Rate exceeded for queue test")
+		}
+
+		return fakeaws.XMLResponse("ListQueues", "http://queue.amazonaws.com/doc/2012-11-05/", "")
+	})
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:    aws.String(s.URL("sqs")),
+		MaxRetries:  aws.Int(1),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	// Throttled requests are retried even if their rate is not limited.
+	l := New(Config{})
+	l.ConfigureHandlers(&sess.Handlers)
+
+	if _, err := sqs.New(sess).ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("error listing queues: %s", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+
+	if rate := l.Rate("sqs", "us-west-2"); rate != 0 {
+		t.Errorf("expected no rate limit, got %f", rate)
+	}
+}
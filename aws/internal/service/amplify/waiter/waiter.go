@@ -0,0 +1,78 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for a Domain Association to be created
+	DomainAssociationCreatedTimeout = 5 * time.Minute
+
+	// Default maximum amount of time to wait for a Domain Association to be verified
+	DomainAssociationVerifiedTimeout = 15 * time.Minute
+)
+
+// DomainAssociationCreated waits for a Domain Association to reach a state in
+// which its certificate verification DNS record is available.
+func DomainAssociationCreated(conn *amplify.Amplify, appID, domainName string, timeout time.Duration) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			amplify.DomainStatusCreating,
+			amplify.DomainStatusInProgress,
+			amplify.DomainStatusRequestingCertificate,
+		},
+		Target: []string{
+			amplify.DomainStatusAvailable,
+			amplify.DomainStatusPendingDeployment,
+			amplify.DomainStatusPendingVerification,
+		},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	return domainAssociationWaitResult(outputRaw, err)
+}
+
+// DomainAssociationVerified waits for a Domain Association's DNS records to be verified.
+func DomainAssociationVerified(conn *amplify.Amplify, appID, domainName string, timeout time.Duration) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			amplify.DomainStatusUpdating,
+			amplify.DomainStatusInProgress,
+			amplify.DomainStatusPendingVerification,
+		},
+		Target: []string{
+			amplify.DomainStatusAvailable,
+			amplify.DomainStatusPendingDeployment,
+		},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	return domainAssociationWaitResult(outputRaw, err)
+}
+
+// domainAssociationWaitResult converts the raw wait output into a Domain Association,
+// returning the status reason of a failed domain association as an error.
+func domainAssociationWaitResult(outputRaw interface{}, err error) (*amplify.DomainAssociation, error) {
+	output, ok := outputRaw.(*amplify.DomainAssociation)
+
+	if !ok {
+		return nil, err
+	}
+
+	if aws.StringValue(output.DomainStatus) == amplify.DomainStatusFailed {
+		return output, fmt.Errorf("domain association failed: %s", aws.StringValue(output.StatusReason))
+	}
+
+	return output, err
+}
@@ -0,0 +1,66 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DeploymentByID returns the API Gateway v2 Deployment corresponding to the
+// specified API and deployment identifiers.
+// Returns a NotFoundError if no deployment is found.
+func DeploymentByID(conn *apigatewayv2.ApiGatewayV2, apiID, id string) (*apigatewayv2.GetDeploymentOutput, error) {
+	input := &apigatewayv2.GetDeploymentInput{
+		ApiId:        aws.String(apiID),
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeployment(input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// DomainNameByName returns the API Gateway v2 Domain Name corresponding to the
+// specified name.
+// Returns a NotFoundError if no domain name is found.
+func DomainNameByName(conn *apigatewayv2.ApiGatewayV2, name string) (*apigatewayv2.GetDomainNameOutput, error) {
+	input := &apigatewayv2.GetDomainNameInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.GetDomainName(input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
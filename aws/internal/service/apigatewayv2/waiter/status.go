@@ -0,0 +1,48 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apigatewayv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DeploymentStatus fetches the API Gateway v2 Deployment and its status.
+func DeploymentStatus(conn *apigatewayv2.ApiGatewayV2, apiID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DeploymentByID(conn, apiID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DeploymentStatus), nil
+	}
+}
+
+// DomainNameStatus fetches the API Gateway v2 Domain Name and the status of
+// its configuration.
+func DomainNameStatus(conn *apigatewayv2.ApiGatewayV2, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DomainNameByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output.DomainNameConfigurations) == 0 || output.DomainNameConfigurations[0] == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.DomainNameConfigurations[0].DomainNameStatus), nil
+	}
+}
@@ -0,0 +1,64 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for a Deployment to be deployed
+	DeploymentDeployedTimeout = 5 * time.Minute
+
+	// Default maximum amount of time to wait for a Domain Name to be created
+	DomainNameCreateTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a Domain Name update
+	DomainNameUpdateTimeout = 60 * time.Minute
+)
+
+// DeploymentDeployed waits for a Deployment to return Deployed.
+// A deployment which fails returns an error with the deployment status message.
+func DeploymentDeployed(conn *apigatewayv2.ApiGatewayV2, apiID, id string, timeout time.Duration) (*apigatewayv2.GetDeploymentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apigatewayv2.DeploymentStatusPending},
+		Target:  []string{apigatewayv2.DeploymentStatusDeployed},
+		Refresh: DeploymentStatus(conn, apiID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	output, ok := outputRaw.(*apigatewayv2.GetDeploymentOutput)
+
+	if !ok {
+		return nil, err
+	}
+
+	if aws.StringValue(output.DeploymentStatus) == apigatewayv2.DeploymentStatusFailed {
+		return output, fmt.Errorf("API Gateway v2 Deployment (%s) failed: %s", id, aws.StringValue(output.DeploymentStatusMessage))
+	}
+
+	return output, err
+}
+
+// DomainNameAvailable waits for a Domain Name to return Available.
+func DomainNameAvailable(conn *apigatewayv2.ApiGatewayV2, name string, timeout time.Duration) (*apigatewayv2.GetDomainNameOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apigatewayv2.DomainNameStatusUpdating},
+		Target:  []string{apigatewayv2.DomainNameStatusAvailable},
+		Refresh: DomainNameStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*apigatewayv2.GetDomainNameOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,216 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+)
+
+// FleetByName returns the fleet corresponding to the specified name.
+// Returns a NotFoundError if no fleet is found.
+func FleetByName(conn *appstream.AppStream, name string) (*appstream.Fleet, error) {
+	input := &appstream.DescribeFleetsInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeFleets(input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fleet := range output.Fleets {
+		if fleet != nil && aws.StringValue(fleet.Name) == name {
+			return fleet, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// FleetStackAssociation returns nil if the specified fleet is associated with the specified stack.
+// Returns a NotFoundError if the fleet or the association is not found.
+func FleetStackAssociation(conn *appstream.AppStream, fleetName, stackName string) error {
+	input := &appstream.ListAssociatedStacksInput{
+		FleetName: aws.String(fleetName),
+	}
+
+	for {
+		output, err := conn.ListAssociatedStacks(input)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			return &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, name := range output.Names {
+			if aws.StringValue(name) == stackName {
+				return nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// ImageBuilderByName returns the image builder corresponding to the specified name.
+// Returns a NotFoundError if no image builder is found.
+func ImageBuilderByName(conn *appstream.AppStream, name string) (*appstream.ImageBuilder, error) {
+	input := &appstream.DescribeImageBuildersInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeImageBuilders(input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, imageBuilder := range output.ImageBuilders {
+		if imageBuilder != nil && aws.StringValue(imageBuilder.Name) == name {
+			return imageBuilder, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// StackByName returns the stack corresponding to the specified name.
+// Returns a NotFoundError if no stack is found.
+func StackByName(conn *appstream.AppStream, name string) (*appstream.Stack, error) {
+	input := &appstream.DescribeStacksInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeStacks(input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stack := range output.Stacks {
+		if stack != nil && aws.StringValue(stack.Name) == name {
+			return stack, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// UserByNameAndAuthType returns the user corresponding to the specified user name and authentication type.
+// Returns a NotFoundError if no user is found.
+func UserByNameAndAuthType(conn *appstream.AppStream, userName, authType string) (*appstream.User, error) {
+	input := &appstream.DescribeUsersInput{
+		AuthenticationType: aws.String(authType),
+	}
+
+	for {
+		output, err := conn.DescribeUsers(input)
+
+		if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range output.Users {
+			if user != nil && aws.StringValue(user.UserName) == userName {
+				return user, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// UserStackAssociation returns the association between the specified user and stack.
+// Returns a NotFoundError if no association is found.
+func UserStackAssociation(conn *appstream.AppStream, userName, authType, stackName string) (*appstream.UserStackAssociation, error) {
+	input := &appstream.DescribeUserStackAssociationsInput{
+		AuthenticationType: aws.String(authType),
+		StackName:          aws.String(stackName),
+		UserName:           aws.String(userName),
+	}
+
+	output, err := conn.DescribeUserStackAssociations(input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, association := range output.UserStackAssociations {
+		if association == nil {
+			continue
+		}
+
+		if aws.StringValue(association.UserName) == userName && aws.StringValue(association.StackName) == stackName {
+			return association, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,110 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// Default maximum amount of time to wait for a fleet to start
+	FleetRunningTimeout = 60 * time.Minute
+
+	// Default maximum amount of time to wait for a fleet to stop
+	FleetStoppedTimeout = 30 * time.Minute
+
+	// Default maximum amount of time to wait for an image builder to start
+	ImageBuilderRunningTimeout = 60 * time.Minute
+
+	// Default maximum amount of time to wait for an image builder to be deleted
+	ImageBuilderDeletedTimeout = 30 * time.Minute
+)
+
+// FleetRunning waits for a fleet to start.
+func FleetRunning(conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.FleetStateStarting},
+		Target:  []string{appstream.FleetStateRunning},
+		Refresh: FleetState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appstream.Fleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// FleetStopped waits for a fleet to stop.
+func FleetStopped(conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.FleetStateStopping},
+		Target:  []string{appstream.FleetStateStopped},
+		Refresh: FleetState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appstream.Fleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ImageBuilderRunning waits for an image builder to start.
+func ImageBuilderRunning(conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.ImageBuilder, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			appstream.ImageBuilderStatePending,
+			appstream.ImageBuilderStateUpdatingAgent,
+			appstream.ImageBuilderStateRebooting,
+		},
+		Target:  []string{appstream.ImageBuilderStateRunning},
+		Refresh: ImageBuilderState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appstream.ImageBuilder); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ImageBuilderDeleted waits for an image builder to be deleted.
+// An image builder that is no longer returned by the API is considered deleted.
+func ImageBuilderDeleted(conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.ImageBuilder, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			appstream.ImageBuilderStatePending,
+			appstream.ImageBuilderStateRunning,
+			appstream.ImageBuilderStateStopping,
+			appstream.ImageBuilderStateStopped,
+			appstream.ImageBuilderStateDeleting,
+		},
+		Target:  []string{},
+		Refresh: ImageBuilderState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if output, ok := outputRaw.(*appstream.ImageBuilder); ok {
+		return output, err
+	}
+
+	return nil, err
+}
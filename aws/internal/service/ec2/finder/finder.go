@@ -0,0 +1,343 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	ErrCodeClientVpnAuthorizationRuleNotFound    = "InvalidClientVpnEndpointAuthorizationRuleNotFound"
+	ErrCodeClientVpnEndpointIdNotFound           = "InvalidClientVpnEndpointId.NotFound"
+	ErrCodeClientVpnRouteNotFound                = "InvalidClientVpnRouteNotFound"
+	ErrCodeInvalidSubnetIDNotFound               = "InvalidSubnetID.NotFound"
+	ErrCodeInvalidTrafficMirrorFilterIdNotFound  = "InvalidTrafficMirrorFilterId.NotFound"
+	ErrCodeInvalidTrafficMirrorSessionIdNotFound = "InvalidTrafficMirrorSessionId.NotFound"
+	ErrCodeInvalidTrafficMirrorTargetIdNotFound  = "InvalidTrafficMirrorTargetId.NotFound"
+	ErrCodeInvalidVpcIDNotFound                  = "InvalidVpcID.NotFound"
+	ErrCodeNatGatewayNotFound                    = "NatGatewayNotFound"
+)
+
+// ClientVpnAuthorizationRule returns the Client VPN authorization rule
+// corresponding to the specified endpoint, target network CIDR and access
+// group. An empty access group identifies a rule authorizing all groups.
+// Returns a NotFoundError if no authorization rule is found.
+func ClientVpnAuthorizationRule(conn *ec2.EC2, endpointID, targetNetworkCidr, accessGroupID string) (*ec2.AuthorizationRule, error) {
+	input := &ec2.DescribeClientVpnAuthorizationRulesInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("destination-cidr"),
+				Values: aws.StringSlice([]string{targetNetworkCidr}),
+			},
+		},
+	}
+
+	var rule *ec2.AuthorizationRule
+
+	err := conn.DescribeClientVpnAuthorizationRulesPages(input, func(page *ec2.DescribeClientVpnAuthorizationRulesOutput, lastPage bool) bool {
+		for _, r := range page.AuthorizationRules {
+			if aws.StringValue(r.DestinationCidr) != targetNetworkCidr {
+				continue
+			}
+
+			if accessGroupID == "" && aws.BoolValue(r.AccessAll) || accessGroupID != "" && aws.StringValue(r.GroupId) == accessGroupID {
+				rule = r
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeClientVpnEndpointIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if rule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return rule, nil
+}
+
+// ClientVpnRoute returns the Client VPN route corresponding to the specified
+// endpoint, destination CIDR and target subnet.
+// Returns a NotFoundError if no route is found.
+func ClientVpnRoute(conn *ec2.EC2, endpointID, destinationCidr, targetSubnetID string) (*ec2.ClientVpnRoute, error) {
+	input := &ec2.DescribeClientVpnRoutesInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("destination-cidr"),
+				Values: aws.StringSlice([]string{destinationCidr}),
+			},
+			{
+				Name:   aws.String("target-subnet"),
+				Values: aws.StringSlice([]string{targetSubnetID}),
+			},
+		},
+	}
+
+	var route *ec2.ClientVpnRoute
+
+	err := conn.DescribeClientVpnRoutesPages(input, func(page *ec2.DescribeClientVpnRoutesOutput, lastPage bool) bool {
+		for _, r := range page.Routes {
+			if aws.StringValue(r.DestinationCidr) == destinationCidr && aws.StringValue(r.TargetSubnet) == targetSubnetID {
+				route = r
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeClientVpnEndpointIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if route == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return route, nil
+}
+
+// NatGatewayByID returns the NAT Gateway corresponding to the specified
+// identifier.
+// Returns a NotFoundError if no NAT Gateway is found.
+func NatGatewayByID(conn *ec2.EC2, id string) (*ec2.NatGateway, error) {
+	input := &ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeNatGateways(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNatGatewayNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, natGateway := range output.NatGateways {
+		if aws.StringValue(natGateway.NatGatewayId) == id {
+			return natGateway, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// SubnetByID returns the Subnet corresponding to the specified identifier.
+// Returns a NotFoundError if no Subnet is found.
+func SubnetByID(conn *ec2.EC2, id string) (*ec2.Subnet, error) {
+	input := &ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeSubnets(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidSubnetIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, subnet := range output.Subnets {
+		if aws.StringValue(subnet.SubnetId) == id {
+			return subnet, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// TrafficMirrorFilterByID returns the Traffic Mirror Filter corresponding to
+// the specified identifier.
+// Returns a NotFoundError if no Traffic Mirror Filter is found.
+func TrafficMirrorFilterByID(conn *ec2.EC2, id string) (*ec2.TrafficMirrorFilter, error) {
+	input := &ec2.DescribeTrafficMirrorFiltersInput{
+		TrafficMirrorFilterIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTrafficMirrorFilters(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidTrafficMirrorFilterIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, filter := range output.TrafficMirrorFilters {
+		if aws.StringValue(filter.TrafficMirrorFilterId) == id {
+			return filter, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// TrafficMirrorFilterRuleByID returns the Traffic Mirror Filter Rule
+// corresponding to the specified Traffic Mirror Filter and rule identifiers.
+// Returns a NotFoundError if no Traffic Mirror Filter Rule is found.
+func TrafficMirrorFilterRuleByID(conn *ec2.EC2, filterID, ruleID string) (*ec2.TrafficMirrorFilterRule, error) {
+	filter, err := TrafficMirrorFilterByID(conn, filterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rules := range [][]*ec2.TrafficMirrorFilterRule{filter.IngressFilterRules, filter.EgressFilterRules} {
+		for _, rule := range rules {
+			if aws.StringValue(rule.TrafficMirrorFilterRuleId) == ruleID {
+				return rule, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(nil)
+}
+
+// TrafficMirrorSessionByID returns the Traffic Mirror Session corresponding to
+// the specified identifier.
+// Returns a NotFoundError if no Traffic Mirror Session is found.
+func TrafficMirrorSessionByID(conn *ec2.EC2, id string) (*ec2.TrafficMirrorSession, error) {
+	input := &ec2.DescribeTrafficMirrorSessionsInput{
+		TrafficMirrorSessionIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTrafficMirrorSessions(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidTrafficMirrorSessionIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, session := range output.TrafficMirrorSessions {
+		if aws.StringValue(session.TrafficMirrorSessionId) == id {
+			return session, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// TrafficMirrorTargetByID returns the Traffic Mirror Target corresponding to
+// the specified identifier.
+// Returns a NotFoundError if no Traffic Mirror Target is found.
+func TrafficMirrorTargetByID(conn *ec2.EC2, id string) (*ec2.TrafficMirrorTarget, error) {
+	input := &ec2.DescribeTrafficMirrorTargetsInput{
+		TrafficMirrorTargetIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTrafficMirrorTargets(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidTrafficMirrorTargetIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, target := range output.TrafficMirrorTargets {
+		if aws.StringValue(target.TrafficMirrorTargetId) == id {
+			return target, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// VpcByID returns the VPC corresponding to the specified identifier.
+// Returns a NotFoundError if no VPC is found.
+func VpcByID(conn *ec2.EC2, id string) (*ec2.Vpc, error) {
+	input := &ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeVpcs(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidVpcIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, vpc := range output.Vpcs {
+		if aws.StringValue(vpc.VpcId) == id {
+			return vpc, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
@@ -0,0 +1,102 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ClientVpnAuthorizationRuleStatus fetches the Client VPN authorization rule and its status.
+func ClientVpnAuthorizationRuleStatus(conn *ec2.EC2, endpointID, targetNetworkCidr, accessGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ClientVpnAuthorizationRule(conn, endpointID, targetNetworkCidr, accessGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.Code), nil
+	}
+}
+
+// ClientVpnRouteStatus fetches the Client VPN route and its status.
+func ClientVpnRouteStatus(conn *ec2.EC2, endpointID, destinationCidr, targetSubnetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ClientVpnRoute(conn, endpointID, destinationCidr, targetSubnetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.Code), nil
+	}
+}
+
+// NatGatewayStatus fetches the NAT Gateway and its status.
+func NatGatewayStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.NatGatewayByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// SubnetStatus fetches the Subnet and its status.
+func SubnetStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.SubnetByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// VpcStatus fetches the VPC and its status.
+func VpcStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.VpcByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
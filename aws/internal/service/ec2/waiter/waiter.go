@@ -0,0 +1,199 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// Default maximum amount of time to wait for a Client VPN authorization rule to be authorized
+	ClientVpnAuthorizationRuleActiveTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a Client VPN authorization rule to be revoked
+	ClientVpnAuthorizationRuleRevokedTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a Client VPN route to be created
+	ClientVpnRouteActiveTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a Client VPN route to be deleted
+	ClientVpnRouteDeletedTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a NAT Gateway to be created
+	NatGatewayCreateTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a NAT Gateway to be deleted
+	NatGatewayDeleteTimeout = 30 * time.Minute
+
+	// Default maximum amount of time to wait for a Subnet to be created
+	SubnetCreateTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a Subnet to be deleted
+	SubnetDeleteTimeout = 20 * time.Minute
+
+	// Default maximum amount of time to wait for a VPC to be created
+	VpcCreateTimeout = 10 * time.Minute
+)
+
+// ClientVpnAuthorizationRuleActive waits for a Client VPN authorization rule to return active.
+func ClientVpnAuthorizationRuleActive(conn *ec2.EC2, endpointID, targetNetworkCidr, accessGroupID string, timeout time.Duration) (*ec2.AuthorizationRule, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnAuthorizationRuleStatusCodeAuthorizing},
+		Target:  []string{ec2.ClientVpnAuthorizationRuleStatusCodeActive},
+		Refresh: ClientVpnAuthorizationRuleStatus(conn, endpointID, targetNetworkCidr, accessGroupID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
+		if output.Status != nil && aws.StringValue(output.Status.Code) == ec2.ClientVpnAuthorizationRuleStatusCodeFailed {
+			return output, fmt.Errorf("authorization rule failed: %s", aws.StringValue(output.Status.Message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ClientVpnAuthorizationRuleRevoked waits for a Client VPN authorization rule to be revoked.
+func ClientVpnAuthorizationRuleRevoked(conn *ec2.EC2, endpointID, targetNetworkCidr, accessGroupID string, timeout time.Duration) (*ec2.AuthorizationRule, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnAuthorizationRuleStatusCodeRevoking},
+		Target:  []string{},
+		Refresh: ClientVpnAuthorizationRuleStatus(conn, endpointID, targetNetworkCidr, accessGroupID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ClientVpnRouteActive waits for a Client VPN route to return active.
+func ClientVpnRouteActive(conn *ec2.EC2, endpointID, destinationCidr, targetSubnetID string, timeout time.Duration) (*ec2.ClientVpnRoute, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnRouteStatusCodeCreating},
+		Target:  []string{ec2.ClientVpnRouteStatusCodeActive},
+		Refresh: ClientVpnRouteStatus(conn, endpointID, destinationCidr, targetSubnetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.ClientVpnRoute); ok {
+		if output.Status != nil && aws.StringValue(output.Status.Code) == ec2.ClientVpnRouteStatusCodeFailed {
+			return output, fmt.Errorf("route failed: %s", aws.StringValue(output.Status.Message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ClientVpnRouteDeleted waits for a Client VPN route to be deleted.
+func ClientVpnRouteDeleted(conn *ec2.EC2, endpointID, destinationCidr, targetSubnetID string, timeout time.Duration) (*ec2.ClientVpnRoute, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnRouteStatusCodeActive, ec2.ClientVpnRouteStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: ClientVpnRouteStatus(conn, endpointID, destinationCidr, targetSubnetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.ClientVpnRoute); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// NatGatewayAvailable waits for a NAT Gateway to return available.
+func NatGatewayAvailable(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NatGateway, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.NatGatewayStatePending},
+		Target:  []string{ec2.NatGatewayStateAvailable},
+		Refresh: NatGatewayStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NatGateway); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// NatGatewayDeleted waits for a NAT Gateway to return deleted.
+// A NAT Gateway which is no longer found is also considered deleted.
+func NatGatewayDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NatGateway, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.NatGatewayStateDeleting},
+		Target:     []string{ec2.NatGatewayStateDeleted},
+		Refresh:    NatGatewayStatus(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if output, ok := outputRaw.(*ec2.NatGateway); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SubnetAvailable waits for a Subnet to return available.
+func SubnetAvailable(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Subnet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.SubnetStatePending},
+		Target:  []string{ec2.SubnetStateAvailable},
+		Refresh: SubnetStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Subnet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// VpcAvailable waits for a VPC to return available.
+func VpcAvailable(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Vpc, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VpcStatePending},
+		Target:  []string{ec2.VpcStateAvailable},
+		Refresh: VpcStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Vpc); ok {
+		return output, err
+	}
+
+	return nil, err
+}
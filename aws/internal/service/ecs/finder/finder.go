@@ -0,0 +1,76 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ClusterByNameOrARN returns the ECS Cluster, including its tags,
+// corresponding to the specified name or ARN. Deleted clusters remain
+// visible for some time with an INACTIVE status.
+// Returns a NotFoundError if no cluster is found.
+func ClusterByNameOrARN(conn *ecs.ECS, id string) (*ecs.Cluster, error) {
+	input := &ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{id}),
+		Include:  aws.StringSlice([]string{ecs.ClusterFieldTags}),
+	}
+
+	output, err := conn.DescribeClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, cluster := range output.Clusters {
+		if aws.StringValue(cluster.ClusterArn) == id || aws.StringValue(cluster.ClusterName) == id {
+			return cluster, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// ServiceByARNAndCluster returns the ECS Service, including its tags,
+// corresponding to the specified name or ARN in the specified cluster.
+// Deleted services remain visible for some time with an INACTIVE status.
+// Returns a NotFoundError if no service is found.
+func ServiceByARNAndCluster(conn *ecs.ECS, id, cluster string) (*ecs.Service, error) {
+	input := &ecs.DescribeServicesInput{
+		Cluster:  aws.String(cluster),
+		Include:  aws.StringSlice([]string{ecs.ServiceFieldTags}),
+		Services: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeServices(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) || tfawserr.ErrCodeEquals(err, ecs.ErrCodeServiceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Services) == 0 || output.Services[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Services[0], nil
+}
@@ -0,0 +1,84 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// Maximum amount of time to wait for a new ECS Cluster to be returned by DescribeClusters
+	ClusterDescribeTimeout = 2 * time.Minute
+
+	// Maximum amount of time to retry deleting an ECS Cluster with container instances or services
+	ClusterDeleteTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a deleted ECS Cluster to become INACTIVE
+	ClusterInactiveTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a new ECS Service to be returned by DescribeServices
+	ServiceDescribeTimeout = 2 * time.Minute
+
+	// Maximum amount of time to retry deleting an ECS Service with active deployments
+	ServiceDeleteTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a deleted ECS Service to become INACTIVE
+	ServiceInactiveTimeout = 10 * time.Minute
+
+	// ECS Cluster and Service status values not defined by the AWS Go SDK
+	ClusterStatusActive         = "ACTIVE"
+	ClusterStatusDeprovisioning = "DEPROVISIONING"
+	ClusterStatusInactive       = "INACTIVE"
+	ServiceStatusActive         = "ACTIVE"
+	ServiceStatusDraining       = "DRAINING"
+	ServiceStatusInactive       = "INACTIVE"
+)
+
+// ClusterInactive waits for a deleted ECS Cluster to return INACTIVE.
+// A cluster which is no longer found is also deleted.
+func ClusterInactive(conn *ecs.ECS, id string, timeout time.Duration) (*ecs.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterStatusActive, ClusterStatusDeprovisioning},
+		Target:  []string{ClusterStatusInactive},
+		Refresh: ClusterStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if output, ok := outputRaw.(*ecs.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ServiceInactive waits for a deleted ECS Service to return INACTIVE.
+// A service which is no longer found is also deleted.
+func ServiceInactive(conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ServiceStatusActive, ServiceStatusDraining},
+		Target:     []string{ServiceStatusInactive},
+		Refresh:    ServiceStatus(conn, id, cluster),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if output, ok := outputRaw.(*ecs.Service); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,93 @@
+package waiter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for an EKS Cluster to be created
+	ClusterCreateTimeout = 30 * time.Minute
+
+	// Default maximum amount of time to wait for an EKS Cluster update
+	ClusterUpdateTimeout = 60 * time.Minute
+
+	// Default maximum amount of time to wait for an EKS Cluster to be deleted
+	ClusterDeleteTimeout = 15 * time.Minute
+)
+
+// ClusterCreated waits for an EKS Cluster to return Active.
+func ClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.ClusterStatusCreating},
+		Target:  []string{eks.ClusterStatusActive},
+		Refresh: ClusterStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ClusterDeleted waits for an EKS Cluster to be deleted.
+func ClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: ClusterStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// UpdateSuccessful waits for an EKS Cluster update to return Successful.
+// An update which is cancelled or fails returns an error with the details
+// of the update errors.
+func UpdateSuccessful(conn *eks.EKS, name, id string, timeout time.Duration) (*eks.Update, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.UpdateStatusInProgress},
+		Target: []string{
+			eks.UpdateStatusCancelled,
+			eks.UpdateStatusFailed,
+			eks.UpdateStatusSuccessful,
+		},
+		Refresh: UpdateStatus(conn, name, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	output, ok := outputRaw.(*eks.Update)
+
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(output.Status); status != eks.UpdateStatusSuccessful {
+		var detailedErrors []string
+		for i, updateError := range output.Errors {
+			detailedErrors = append(detailedErrors, fmt.Sprintf("Error %d: Code: %s / Message: %s", i+1, aws.StringValue(updateError.ErrorCode), aws.StringValue(updateError.ErrorMessage)))
+		}
+
+		return output, fmt.Errorf("EKS Cluster (%s) update (%s) status (%s) not successful: Errors:\n%s", name, id, status, strings.Join(detailedErrors, "\n"))
+	}
+
+	return output, nil
+}
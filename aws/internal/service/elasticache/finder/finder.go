@@ -0,0 +1,78 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// CacheClusterByID returns the ElastiCache Cache Cluster, including its
+// cache nodes, corresponding to the specified identifier.
+// Returns a NotFoundError if no cache cluster is found.
+func CacheClusterByID(conn *elasticache.ElastiCache, id string) (*elasticache.CacheCluster, error) {
+	input := &elasticache.DescribeCacheClustersInput{
+		CacheClusterId:    aws.String(id),
+		ShowCacheNodeInfo: aws.Bool(true),
+	}
+
+	output, err := conn.DescribeCacheClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeCacheClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, cacheCluster := range output.CacheClusters {
+		if aws.StringValue(cacheCluster.CacheClusterId) == id {
+			return cacheCluster, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// ReplicationGroupByID returns the ElastiCache Replication Group corresponding
+// to the specified identifier.
+// Returns a NotFoundError if no replication group is found.
+func ReplicationGroupByID(conn *elasticache.ElastiCache, id string) (*elasticache.ReplicationGroup, error) {
+	input := &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(id),
+	}
+
+	output, err := conn.DescribeReplicationGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeReplicationGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, replicationGroup := range output.ReplicationGroups {
+		if aws.StringValue(replicationGroup.ReplicationGroupId) == id {
+			return replicationGroup, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
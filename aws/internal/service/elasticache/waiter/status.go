@@ -0,0 +1,68 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	CacheClusterStatusAvailable = "available"
+	CacheClusterStatusCreating  = "creating"
+
+	ReplicationGroupStatusAvailable = "available"
+)
+
+// CacheClusterStatus fetches the ElastiCache Cache Cluster and its status.
+// An available cache cluster is reported as creating until all of its cache
+// nodes have been created and are available.
+func CacheClusterStatus(conn *elasticache.ElastiCache, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.CacheClusterByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.CacheClusterStatus)
+
+		if status != CacheClusterStatusAvailable {
+			return output, status, nil
+		}
+
+		if int64(len(output.CacheNodes)) != aws.Int64Value(output.NumCacheNodes) {
+			return output, CacheClusterStatusCreating, nil
+		}
+
+		for _, cacheNode := range output.CacheNodes {
+			if cacheNode.CacheNodeStatus != nil && aws.StringValue(cacheNode.CacheNodeStatus) != CacheClusterStatusAvailable {
+				return output, CacheClusterStatusCreating, nil
+			}
+		}
+
+		return output, status, nil
+	}
+}
+
+// ReplicationGroupStatus fetches the ElastiCache Replication Group and its status.
+func ReplicationGroupStatus(conn *elasticache.ElastiCache, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ReplicationGroupByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,155 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for a Cache Cluster to be created
+	CacheClusterCreateTimeout = 40 * time.Minute
+
+	// Default maximum amount of time to wait for a Cache Cluster update
+	CacheClusterUpdateTimeout = 80 * time.Minute
+
+	// Default maximum amount of time to wait for a Cache Cluster to be deleted
+	CacheClusterDeleteTimeout = 40 * time.Minute
+
+	// Default maximum amount of time to wait for a Replication Group to be created
+	ReplicationGroupCreateTimeout = 60 * time.Minute
+
+	// Default maximum amount of time to wait for a Replication Group update
+	ReplicationGroupUpdateTimeout = 40 * time.Minute
+
+	// Default maximum amount of time to wait for a Replication Group to be deleted
+	ReplicationGroupDeleteTimeout = 40 * time.Minute
+
+	statusDelay      = 30 * time.Second
+	statusMinTimeout = 10 * time.Second
+)
+
+// CacheClusterAvailable waits for a Cache Cluster and all of its cache nodes
+// to return available after creation.
+func CacheClusterAvailable(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.CacheCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "modifying", "restoring", "snapshotting"},
+		Target:     []string{CacheClusterStatusAvailable},
+		Refresh:    CacheClusterStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticache.CacheCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// CacheClusterUpdated waits for a Cache Cluster and all of its cache nodes
+// to return available after modification.
+func CacheClusterUpdated(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.CacheCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{CacheClusterStatusCreating, "modifying", "rebooting cache cluster nodes", "snapshotting"},
+		Target:     []string{CacheClusterStatusAvailable},
+		Refresh:    CacheClusterStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticache.CacheCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// CacheClusterDeleted waits for a Cache Cluster to be deleted.
+func CacheClusterDeleted(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.CacheCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "available", "deleting", "incompatible-parameters", "incompatible-network", "restore-failed", "snapshotting"},
+		Target:     []string{},
+		Refresh:    CacheClusterStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticache.CacheCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ReplicationGroupAvailable waits for a Replication Group to return available
+// after creation.
+func ReplicationGroupAvailable(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "modifying", "restoring", "snapshotting"},
+		Target:     []string{ReplicationGroupStatusAvailable},
+		Refresh:    ReplicationGroupStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ReplicationGroupUpdated waits for a Replication Group to return available
+// after modification.
+func ReplicationGroupUpdated(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "modifying", "snapshotting"},
+		Target:     []string{ReplicationGroupStatusAvailable},
+		Refresh:    ReplicationGroupStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ReplicationGroupDeleted waits for a Replication Group to be deleted.
+func ReplicationGroupDeleted(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "available", "deleting"},
+		Target:     []string{},
+		Refresh:    ReplicationGroupStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
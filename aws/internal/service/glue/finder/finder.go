@@ -0,0 +1,193 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DataCatalogEncryptionSettingsByID returns the Data Catalog encryption settings corresponding to the specified catalog identifier.
+func DataCatalogEncryptionSettingsByID(conn *glue.Glue, catalogID string) (*glue.DataCatalogEncryptionSettings, error) {
+	input := &glue.GetDataCatalogEncryptionSettingsInput{
+		CatalogId: aws.String(catalogID),
+	}
+
+	output, err := conn.GetDataCatalogEncryptionSettings(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataCatalogEncryptionSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataCatalogEncryptionSettings, nil
+}
+
+// DevEndpointByName returns the development endpoint corresponding to the specified name.
+// Returns a NotFoundError if no development endpoint is found.
+func DevEndpointByName(conn *glue.Glue, name string) (*glue.DevEndpoint, error) {
+	input := &glue.GetDevEndpointInput{
+		EndpointName: aws.String(name),
+	}
+
+	output, err := conn.GetDevEndpoint(input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DevEndpoint == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DevEndpoint, nil
+}
+
+// MLTransformByID returns the machine learning transform corresponding to the specified identifier.
+// Returns a NotFoundError if no transform is found.
+func MLTransformByID(conn *glue.Glue, id string) (*glue.GetMLTransformOutput, error) {
+	input := &glue.GetMLTransformInput{
+		TransformId: aws.String(id),
+	}
+
+	output, err := conn.GetMLTransform(input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// PartitionByValues returns the partition corresponding to the specified catalog, database, table and partition values.
+// Returns a NotFoundError if no partition is found.
+func PartitionByValues(conn *glue.Glue, catalogID, dbName, tableName string, values []string) (*glue.Partition, error) {
+	input := &glue.GetPartitionInput{
+		CatalogId:       aws.String(catalogID),
+		DatabaseName:    aws.String(dbName),
+		PartitionValues: aws.StringSlice(values),
+		TableName:       aws.String(tableName),
+	}
+
+	output, err := conn.GetPartition(input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Partition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Partition, nil
+}
+
+// ResourcePolicy returns the Data Catalog resource policy for the current account and region.
+// Returns a NotFoundError if no resource policy is set.
+func ResourcePolicy(conn *glue.Glue) (*glue.GetResourcePolicyOutput, error) {
+	input := &glue.GetResourcePolicyInput{}
+
+	output, err := conn.GetResourcePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PolicyInJson == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// UserDefinedFunctionByName returns the user-defined function corresponding to the specified catalog, database and name.
+// Returns a NotFoundError if no function is found.
+func UserDefinedFunctionByName(conn *glue.Glue, catalogID, dbName, name string) (*glue.UserDefinedFunction, error) {
+	input := &glue.GetUserDefinedFunctionInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		FunctionName: aws.String(name),
+	}
+
+	output, err := conn.GetUserDefinedFunction(input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserDefinedFunction == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserDefinedFunction, nil
+}
+
+// WorkflowByName returns the workflow corresponding to the specified name.
+// Returns a NotFoundError if no workflow is found.
+func WorkflowByName(conn *glue.Glue, name string) (*glue.Workflow, error) {
+	input := &glue.GetWorkflowInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetWorkflow(input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workflow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workflow, nil
+}
@@ -0,0 +1,85 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Development endpoint statuses are not enumerated by the API model.
+	DevEndpointStatusFailed       = "FAILED"
+	DevEndpointStatusProvisioning = "PROVISIONING"
+	DevEndpointStatusReady        = "READY"
+	DevEndpointStatusTerminating  = "TERMINATING"
+)
+
+const (
+	// Default maximum amount of time to wait for a development endpoint to become ready
+	DevEndpointReadyTimeout = 20 * time.Minute
+
+	// Default maximum amount of time to wait for a development endpoint to be deleted
+	DevEndpointDeletedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a machine learning transform to be deleted
+	MLTransformDeletedTimeout = 2 * time.Minute
+)
+
+// DevEndpointReady waits for a development endpoint to become ready.
+func DevEndpointReady(conn *glue.Glue, name string, timeout time.Duration) (*glue.DevEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{DevEndpointStatusProvisioning},
+		Target:  []string{DevEndpointStatusReady},
+		Refresh: DevEndpointStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DevEndpointDeleted waits for a development endpoint to be deleted.
+func DevEndpointDeleted(conn *glue.Glue, name string, timeout time.Duration) (*glue.DevEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{DevEndpointStatusTerminating},
+		Target:  []string{},
+		Refresh: DevEndpointStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// MLTransformDeleted waits for a machine learning transform to be deleted.
+func MLTransformDeleted(conn *glue.Glue, id string) (*glue.GetMLTransformOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			glue.TransformStatusTypeDeleting,
+			glue.TransformStatusTypeNotReady,
+			glue.TransformStatusTypeReady,
+		},
+		Target:  []string{},
+		Refresh: MLTransformStatus(conn, id),
+		Timeout: MLTransformDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*glue.GetMLTransformOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
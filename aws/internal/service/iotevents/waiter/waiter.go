@@ -0,0 +1,108 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a detector model version to become active
+	DetectorModelActiveTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a detector model to be deleted
+	DetectorModelDeletedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an input to become active
+	InputActiveTimeout = 2 * time.Minute
+
+	// Maximum amount of time to wait for an input to be deleted
+	InputDeletedTimeout = 2 * time.Minute
+)
+
+// DetectorModelActive waits for the latest version of a detector model to become active.
+func DetectorModelActive(conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: DetectorModelStatus(conn, name),
+		Timeout: DetectorModelActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DetectorModelDeleted waits for a detector model to be deleted.
+func DetectorModelDeleted(conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			iotevents.DetectorModelVersionStatusActivating,
+			iotevents.DetectorModelVersionStatusActive,
+			iotevents.DetectorModelVersionStatusDeprecated,
+			iotevents.DetectorModelVersionStatusDraft,
+			iotevents.DetectorModelVersionStatusFailed,
+			iotevents.DetectorModelVersionStatusInactive,
+			iotevents.DetectorModelVersionStatusPaused,
+		},
+		Target:  []string{},
+		Refresh: DetectorModelStatus(conn, name),
+		Timeout: DetectorModelDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InputActive waits for an input to become active.
+func InputActive(conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			iotevents.InputStatusCreating,
+			iotevents.InputStatusUpdating,
+		},
+		Target:  []string{iotevents.InputStatusActive},
+		Refresh: InputStatus(conn, name),
+		Timeout: InputActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*iotevents.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InputDeleted waits for an input to be deleted.
+func InputDeleted(conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			iotevents.InputStatusActive,
+			iotevents.InputStatusDeleting,
+		},
+		Target:  []string{},
+		Refresh: InputStatus(conn, name),
+		Timeout: InputDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*iotevents.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,65 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ApplicationDetailByName returns the application corresponding to the specified name.
+// Returns a NotFoundError if no application is found.
+func ApplicationDetailByName(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string) (*kinesisanalyticsv2.ApplicationDetail, error) {
+	input := &kinesisanalyticsv2.DescribeApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+
+	output, err := conn.DescribeApplication(input)
+
+	if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ApplicationDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ApplicationDetail, nil
+}
+
+// SnapshotDetailsByApplicationAndSnapshotNames returns the application snapshot
+// corresponding to the specified application and snapshot names.
+// Returns a NotFoundError if no snapshot is found.
+func SnapshotDetailsByApplicationAndSnapshotNames(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName, snapshotName string) (*kinesisanalyticsv2.SnapshotDetails, error) {
+	input := &kinesisanalyticsv2.DescribeApplicationSnapshotInput{
+		ApplicationName: aws.String(applicationName),
+		SnapshotName:    aws.String(snapshotName),
+	}
+
+	output, err := conn.DescribeApplicationSnapshot(input)
+
+	if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SnapshotDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SnapshotDetails, nil
+}
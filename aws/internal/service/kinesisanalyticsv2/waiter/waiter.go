@@ -0,0 +1,137 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for an application to be deleted
+	ApplicationDeletedTimeout = 5 * time.Minute
+
+	// Default maximum amount of time to wait for an application to be started
+	ApplicationStartedTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for an application to be stopped
+	ApplicationStoppedTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for an application to be updated
+	ApplicationUpdatedTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for an application snapshot to be created
+	SnapshotCreatedTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for an application snapshot to be deleted
+	SnapshotDeletedTimeout = 5 * time.Minute
+)
+
+// ApplicationDeleted waits for an application to be deleted.
+func ApplicationDeleted(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) (*kinesisanalyticsv2.ApplicationDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.ApplicationStatusDeleting},
+		Target:  []string{},
+		Refresh: ApplicationStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ApplicationStarted waits for an application to return running.
+func ApplicationStarted(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) (*kinesisanalyticsv2.ApplicationDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.ApplicationStatusStarting},
+		Target:  []string{kinesisanalyticsv2.ApplicationStatusRunning},
+		Refresh: ApplicationStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ApplicationStopped waits for an application to return ready.
+func ApplicationStopped(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) (*kinesisanalyticsv2.ApplicationDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.ApplicationStatusStopping},
+		Target:  []string{kinesisanalyticsv2.ApplicationStatusReady},
+		Refresh: ApplicationStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ApplicationUpdated waits for an application to finish updating.
+// Depending on whether it was running beforehand the application returns either ready or running.
+func ApplicationUpdated(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) (*kinesisanalyticsv2.ApplicationDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.ApplicationStatusUpdating},
+		Target:  []string{kinesisanalyticsv2.ApplicationStatusReady, kinesisanalyticsv2.ApplicationStatusRunning},
+		Refresh: ApplicationStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SnapshotCreated waits for an application snapshot to return ready.
+func SnapshotCreated(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName, snapshotName string, timeout time.Duration) (*kinesisanalyticsv2.SnapshotDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.SnapshotStatusCreating},
+		Target:  []string{kinesisanalyticsv2.SnapshotStatusReady},
+		Refresh: SnapshotStatus(conn, applicationName, snapshotName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisanalyticsv2.SnapshotDetails); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SnapshotDeleted waits for an application snapshot to be deleted.
+func SnapshotDeleted(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName, snapshotName string, timeout time.Duration) (*kinesisanalyticsv2.SnapshotDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.SnapshotStatusDeleting},
+		Target:  []string{},
+		Refresh: SnapshotStatus(conn, applicationName, snapshotName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisanalyticsv2.SnapshotDetails); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,50 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// StreamByARN returns the stream corresponding to the specified ARN.
+// Returns a NotFoundError if no stream is found.
+func StreamByARN(conn *kinesisvideo.KinesisVideo, arn string) (*kinesisvideo.StreamInfo, error) {
+	input := &kinesisvideo.DescribeStreamInput{
+		StreamARN: aws.String(arn),
+	}
+
+	return stream(conn, input)
+}
+
+// StreamByName returns the stream corresponding to the specified name.
+// Returns a NotFoundError if no stream is found.
+func StreamByName(conn *kinesisvideo.KinesisVideo, name string) (*kinesisvideo.StreamInfo, error) {
+	input := &kinesisvideo.DescribeStreamInput{
+		StreamName: aws.String(name),
+	}
+
+	return stream(conn, input)
+}
+
+func stream(conn *kinesisvideo.KinesisVideo, input *kinesisvideo.DescribeStreamInput) (*kinesisvideo.StreamInfo, error) {
+	output, err := conn.DescribeStream(input)
+
+	if tfawserr.ErrCodeEquals(err, kinesisvideo.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StreamInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StreamInfo, nil
+}
@@ -0,0 +1,26 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisvideo/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// StreamStatus fetches the stream and its status.
+func StreamStatus(conn *kinesisvideo.KinesisVideo, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		stream, err := finder.StreamByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return stream, aws.StringValue(stream.Status), nil
+	}
+}
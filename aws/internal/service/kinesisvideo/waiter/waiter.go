@@ -0,0 +1,73 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a stream to be created
+	StreamCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a stream to be updated
+	StreamUpdatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a stream to be deleted
+	StreamDeletedTimeout = 5 * time.Minute
+)
+
+// StreamCreated waits for a stream to become active.
+func StreamCreated(conn *kinesisvideo.KinesisVideo, arn string) (*kinesisvideo.StreamInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisvideo.StatusCreating},
+		Target:  []string{kinesisvideo.StatusActive},
+		Refresh: StreamStatus(conn, arn),
+		Timeout: StreamCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisvideo.StreamInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// StreamUpdated waits for a stream update to complete.
+func StreamUpdated(conn *kinesisvideo.KinesisVideo, arn string) (*kinesisvideo.StreamInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisvideo.StatusUpdating},
+		Target:  []string{kinesisvideo.StatusActive},
+		Refresh: StreamStatus(conn, arn),
+		Timeout: StreamUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisvideo.StreamInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// StreamDeleted waits for a stream to be deleted.
+func StreamDeleted(conn *kinesisvideo.KinesisVideo, arn string) (*kinesisvideo.StreamInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisvideo.StatusDeleting},
+		Target:  []string{},
+		Refresh: StreamStatus(conn, arn),
+		Timeout: StreamDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kinesisvideo.StreamInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,116 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DataLakeSettingsByCatalogID returns the data lake settings corresponding to the specified catalog identifier.
+// An empty catalog identifier selects the catalog of the caller's account.
+func DataLakeSettingsByCatalogID(conn *lakeformation.LakeFormation, catalogID string) (*lakeformation.DataLakeSettings, error) {
+	input := &lakeformation.GetDataLakeSettingsInput{}
+
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	output, err := conn.GetDataLakeSettings(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataLakeSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataLakeSettings, nil
+}
+
+// PrincipalResourcePermissions returns the permissions matching the specified list input
+// that are granted to the specified principal.
+// Returns a NotFoundError if the principal holds no matching permissions.
+func PrincipalResourcePermissions(conn *lakeformation.LakeFormation, input *lakeformation.ListPermissionsInput, principal string, filter func(*lakeformation.Resource) bool) ([]*lakeformation.PrincipalResourcePermissions, error) {
+	var permissions []*lakeformation.PrincipalResourcePermissions
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, permission := range page.PrincipalResourcePermissions {
+			if permission == nil || permission.Principal == nil {
+				continue
+			}
+
+			if aws.StringValue(permission.Principal.DataLakePrincipalIdentifier) != principal {
+				continue
+			}
+
+			if filter != nil && !filter(permission.Resource) {
+				continue
+			}
+
+			permissions = append(permissions, permission)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(permissions) == 0 {
+		return nil, &resource.NotFoundError{
+			Message:     "no matching permissions found",
+			LastRequest: input,
+		}
+	}
+
+	return permissions, nil
+}
+
+// ResourceInfoByARN returns the registered resource corresponding to the specified ARN.
+// Returns a NotFoundError if no resource is registered.
+func ResourceInfoByARN(conn *lakeformation.LakeFormation, arn string) (*lakeformation.ResourceInfo, error) {
+	input := &lakeformation.DescribeResourceInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeResource(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ResourceInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ResourceInfo, nil
+}
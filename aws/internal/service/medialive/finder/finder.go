@@ -0,0 +1,111 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ChannelByID returns the channel corresponding to the specified identifier.
+// Returns a NotFoundError if no channel is found or the channel has been deleted.
+func ChannelByID(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	input := &medialive.DescribeChannelInput{
+		ChannelId: aws.String(id),
+	}
+
+	output, err := conn.DescribeChannel(input)
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == medialive.ChannelStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// InputByID returns the input corresponding to the specified identifier.
+// Returns a NotFoundError if no input is found or the input has been deleted.
+func InputByID(conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	input := &medialive.DescribeInputInput{
+		InputId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInput(input)
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == medialive.InputStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// InputSecurityGroupByID returns the input security group corresponding to the specified identifier.
+// Returns a NotFoundError if no input security group is found or the input security group has been deleted.
+func InputSecurityGroupByID(conn *medialive.MediaLive, id string) (*medialive.DescribeInputSecurityGroupOutput, error) {
+	input := &medialive.DescribeInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInputSecurityGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == medialive.InputSecurityGroupStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,215 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a channel to be created
+	ChannelCreatedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a channel to be updated
+	ChannelUpdatedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a channel to start
+	ChannelStartedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a channel to stop
+	ChannelStoppedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a channel to be deleted
+	ChannelDeletedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for an input to be created
+	InputCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an input to be deleted
+	InputDeletedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an input security group to be updated
+	InputSecurityGroupUpdatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an input security group to be deleted
+	InputSecurityGroupDeletedTimeout = 5 * time.Minute
+)
+
+// ChannelCreated waits for a channel to be created.
+func ChannelCreated(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateCreating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ChannelUpdated waits for a channel to be updated.
+func ChannelUpdated(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateUpdating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ChannelStarted waits for a channel to start running.
+func ChannelStarted(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateStarting},
+		Target:  []string{medialive.ChannelStateRunning},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelStartedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ChannelStopped waits for a channel to stop running.
+func ChannelStopped(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateStopping},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ChannelDeleted waits for a channel to be deleted.
+func ChannelDeleted(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			medialive.ChannelStateIdle,
+			medialive.ChannelStateStopping,
+			medialive.ChannelStateDeleting,
+		},
+		Target:  []string{},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InputCreated waits for an input to be created.
+func InputCreated(conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputStateCreating},
+		Target: []string{
+			medialive.InputStateAttached,
+			medialive.InputStateDetached,
+		},
+		Refresh: InputState(conn, id),
+		Timeout: InputCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InputDeleted waits for an input to be deleted.
+func InputDeleted(conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			medialive.InputStateDetached,
+			medialive.InputStateDeleting,
+		},
+		Target:  []string{},
+		Refresh: InputState(conn, id),
+		Timeout: InputDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InputSecurityGroupUpdated waits for an input security group to be updated.
+func InputSecurityGroupUpdated(conn *medialive.MediaLive, id string) (*medialive.DescribeInputSecurityGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputSecurityGroupStateUpdating},
+		Target: []string{
+			medialive.InputSecurityGroupStateIdle,
+			medialive.InputSecurityGroupStateInUse,
+		},
+		Refresh: InputSecurityGroupState(conn, id),
+		Timeout: InputSecurityGroupUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InputSecurityGroupDeleted waits for an input security group to be deleted.
+func InputSecurityGroupDeleted(conn *medialive.MediaLive, id string) (*medialive.DescribeInputSecurityGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			medialive.InputSecurityGroupStateIdle,
+			medialive.InputSecurityGroupStateUpdating,
+		},
+		Target:  []string{},
+		Refresh: InputSecurityGroupState(conn, id),
+		Timeout: InputSecurityGroupDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,36 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// OriginEndpointByID returns the origin endpoint corresponding to the specified identifier.
+// Returns a NotFoundError if no origin endpoint is found.
+func OriginEndpointByID(conn *mediapackage.MediaPackage, id string) (*mediapackage.DescribeOriginEndpointOutput, error) {
+	input := &mediapackage.DescribeOriginEndpointInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeOriginEndpoint(input)
+
+	if tfawserr.ErrCodeEquals(err, mediapackage.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
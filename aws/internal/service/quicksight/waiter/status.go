@@ -0,0 +1,26 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DataSourceStatus fetches the data source and its status.
+func DataSourceStatus(conn *quicksight.QuickSight, awsAccountID, dataSourceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		dataSource, err := finder.DataSourceByID(conn, awsAccountID, dataSourceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return dataSource, aws.StringValue(dataSource.Status), nil
+	}
+}
@@ -0,0 +1,63 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for a data source to be created
+	DataSourceCreatedTimeout = 5 * time.Minute
+
+	// Default maximum amount of time to wait for a data source to be updated
+	DataSourceUpdatedTimeout = 5 * time.Minute
+)
+
+// DataSourceCreated waits for a data source to finish creating.
+func DataSourceCreated(conn *quicksight.QuickSight, awsAccountID, dataSourceID string) (*quicksight.DataSource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: DataSourceStatus(conn, awsAccountID, dataSourceID),
+		Timeout: DataSourceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*quicksight.DataSource); ok {
+		return output, dataSourceError(output, err)
+	}
+
+	return nil, err
+}
+
+// DataSourceUpdated waits for a data source to finish updating.
+func DataSourceUpdated(conn *quicksight.QuickSight, awsAccountID, dataSourceID string) (*quicksight.DataSource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusUpdateInProgress},
+		Target:  []string{quicksight.ResourceStatusUpdateSuccessful},
+		Refresh: DataSourceStatus(conn, awsAccountID, dataSourceID),
+		Timeout: DataSourceUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*quicksight.DataSource); ok {
+		return output, dataSourceError(output, err)
+	}
+
+	return nil, err
+}
+
+// dataSourceError adds any error information reported by a failed data source to err.
+func dataSourceError(dataSource *quicksight.DataSource, err error) error {
+	if err == nil || dataSource.ErrorInfo == nil {
+		return err
+	}
+
+	return fmt.Errorf("%s: %s: %s", err, aws.StringValue(dataSource.ErrorInfo.Type), aws.StringValue(dataSource.ErrorInfo.Message))
+}
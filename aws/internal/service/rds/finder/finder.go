@@ -0,0 +1,77 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DBClusterByID returns the RDS DB Cluster corresponding to the specified
+// identifier.
+// Returns a NotFoundError if no cluster is found.
+func DBClusterByID(conn *rds.RDS, id string) (*rds.DBCluster, error) {
+	input := &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeDBClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, dbCluster := range output.DBClusters {
+		if aws.StringValue(dbCluster.DBClusterIdentifier) == id {
+			return dbCluster, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// DBInstanceByID returns the RDS DB Instance corresponding to the specified
+// identifier.
+// Returns a NotFoundError if no instance is found.
+func DBInstanceByID(conn *rds.RDS, id string) (*rds.DBInstance, error) {
+	input := &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeDBInstances(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, dbInstance := range output.DBInstances {
+		if aws.StringValue(dbInstance.DBInstanceIdentifier) == id {
+			return dbInstance, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
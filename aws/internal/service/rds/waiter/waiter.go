@@ -0,0 +1,288 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for an RDS DB Cluster to be created
+	DBClusterCreateTimeout = 120 * time.Minute
+
+	// Default maximum amount of time to wait for an RDS DB Cluster update
+	DBClusterUpdateTimeout = 120 * time.Minute
+
+	// Default maximum amount of time to wait for an RDS DB Cluster to be deleted
+	DBClusterDeleteTimeout = 120 * time.Minute
+
+	// Default maximum amount of time to wait for an RDS DB Cluster Instance to be created
+	DBClusterInstanceCreateTimeout = 90 * time.Minute
+
+	// Default maximum amount of time to wait for an RDS DB Cluster Instance update
+	DBClusterInstanceUpdateTimeout = 90 * time.Minute
+
+	// Default maximum amount of time to wait for an RDS DB Cluster Instance to be deleted
+	DBClusterInstanceDeleteTimeout = 90 * time.Minute
+
+	// Default maximum amount of time to wait for an RDS DB Instance to be created
+	DBInstanceCreateTimeout = 40 * time.Minute
+
+	// Default maximum amount of time to wait for an RDS DB Instance update
+	DBInstanceUpdateTimeout = 80 * time.Minute
+
+	// Default maximum amount of time to wait for an RDS DB Instance to be deleted
+	DBInstanceDeleteTimeout = 40 * time.Minute
+
+	// Amount of time to wait before polling the status of a changed DB Cluster or DB Instance
+	statusDelay = 30 * time.Second
+
+	// Minimum amount of time between polls of the status of a DB Cluster or DB Instance
+	statusMinTimeout = 10 * time.Second
+)
+
+// DBClusterCreated waits for an RDS DB Cluster to return available after
+// creation.
+func DBClusterCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"backing-up",
+			"creating",
+			"migrating",
+			"modifying",
+			"preparing-data-migration",
+			"resetting-master-credentials",
+		},
+		Target:     []string{"available"},
+		Refresh:    DBClusterStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DBClusterUpdated waits for an RDS DB Cluster to return available after
+// modification.
+func DBClusterUpdated(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"backing-up",
+			"modifying",
+			"resetting-master-credentials",
+			"upgrading",
+		},
+		Target:     []string{"available"},
+		Refresh:    DBClusterStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DBClusterDeleted waits for an RDS DB Cluster to be deleted.
+func DBClusterDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"available",
+			"backing-up",
+			"deleting",
+			"modifying",
+		},
+		Target:     []string{},
+		Refresh:    DBClusterStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DBClusterInstanceAvailable waits for an RDS DB Cluster Instance to return
+// available after creation or modification.
+func DBClusterInstanceAvailable(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"backing-up",
+			"configuring-enhanced-monitoring",
+			"configuring-log-exports",
+			"creating",
+			"maintenance",
+			"modifying",
+			"rebooting",
+			"renaming",
+			"resetting-master-credentials",
+			"starting",
+			"upgrading",
+		},
+		Target:     []string{"available"},
+		Refresh:    DBInstanceStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DBClusterInstanceDeleted waits for an RDS DB Cluster Instance to be
+// deleted.
+func DBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"configuring-log-exports",
+			"deleting",
+			"modifying",
+		},
+		Target:     []string{},
+		Refresh:    DBInstanceStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DB Instance statuses are documented at
+// http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Overview.DBInstance.Status.html
+
+// DBInstanceCreated waits for an RDS DB Instance to return available after
+// creation.
+func DBInstanceCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"backing-up",
+			"configuring-enhanced-monitoring",
+			"configuring-iam-database-auth",
+			"configuring-log-exports",
+			"creating",
+			"maintenance",
+			"modifying",
+			"rebooting",
+			"renaming",
+			"resetting-master-credentials",
+			"starting",
+			"stopping",
+			"upgrading",
+		},
+		Target:     []string{"available", "storage-optimization"},
+		Refresh:    DBInstanceStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DBInstanceUpdated waits for an RDS DB Instance to return available after
+// modification.
+func DBInstanceUpdated(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"backing-up",
+			"configuring-enhanced-monitoring",
+			"configuring-iam-database-auth",
+			"configuring-log-exports",
+			"creating",
+			"maintenance",
+			"modifying",
+			"moving-to-vpc",
+			"rebooting",
+			"renaming",
+			"resetting-master-credentials",
+			"starting",
+			"stopping",
+			"storage-full",
+			"upgrading",
+		},
+		Target:     []string{"available", "storage-optimization"},
+		Refresh:    DBInstanceStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DBInstanceDeleted waits for an RDS DB Instance to be deleted.
+func DBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"available",
+			"backing-up",
+			"configuring-enhanced-monitoring",
+			"configuring-log-exports",
+			"creating",
+			"deleting",
+			"incompatible-parameters",
+			"modifying",
+			"starting",
+			"stopping",
+			"storage-full",
+			"storage-optimization",
+		},
+		Target:     []string{},
+		Refresh:    DBInstanceStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: statusMinTimeout,
+		Delay:      statusDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
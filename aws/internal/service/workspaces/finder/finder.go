@@ -0,0 +1,110 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawserr"
+)
+
+// DirectoryByID returns the registered directory corresponding to the specified identifier.
+// Returns a NotFoundError if the directory is not registered with WorkSpaces.
+func DirectoryByID(conn *workspaces.WorkSpaces, id string) (*workspaces.WorkspaceDirectory, error) {
+	input := &workspaces.DescribeWorkspaceDirectoriesInput{
+		DirectoryIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeWorkspaceDirectories(input)
+
+	if tfawserr.ErrCodeEquals(err, workspaces.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, directory := range output.Directories {
+		if directory == nil || aws.StringValue(directory.DirectoryId) != id {
+			continue
+		}
+
+		if state := aws.StringValue(directory.State); state == workspaces.WorkspaceDirectoryStateDeregistered {
+			return nil, &resource.NotFoundError{
+				Message:     state,
+				LastRequest: input,
+			}
+		}
+
+		return directory, nil
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// IpGroupByID returns the IP access control group corresponding to the specified identifier.
+// Returns a NotFoundError if no IP group is found.
+func IpGroupByID(conn *workspaces.WorkSpaces, id string) (*workspaces.IpGroup, error) {
+	input := &workspaces.DescribeIpGroupsInput{
+		GroupIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeIpGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, workspaces.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ipGroup := range output.Result {
+		if ipGroup != nil && aws.StringValue(ipGroup.GroupId) == id {
+			return ipGroup, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// WorkspaceByID returns the WorkSpace corresponding to the specified identifier.
+// Returns a NotFoundError if no WorkSpace is found.
+func WorkspaceByID(conn *workspaces.WorkSpaces, id string) (*workspaces.Workspace, error) {
+	input := &workspaces.DescribeWorkspacesInput{
+		WorkspaceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeWorkspaces(input)
+
+	if tfawserr.ErrCodeEquals(err, workspaces.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, workspace := range output.Workspaces {
+		if workspace != nil && aws.StringValue(workspace.WorkspaceId) == id {
+			return workspace, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
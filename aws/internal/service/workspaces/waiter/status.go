@@ -0,0 +1,43 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DirectoryState fetches the registered directory and its state.
+func DirectoryState(conn *workspaces.WorkSpaces, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		directory, err := finder.DirectoryByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return directory, aws.StringValue(directory.State), nil
+	}
+}
+
+// WorkspaceState fetches the WorkSpace and its state.
+func WorkspaceState(conn *workspaces.WorkSpaces, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		workspace, err := finder.WorkspaceByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return workspace, aws.StringValue(workspace.State), nil
+	}
+}
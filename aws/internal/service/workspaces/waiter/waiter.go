@@ -0,0 +1,149 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// Maximum amount of time to wait for a directory to be registered
+	DirectoryRegisteredTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a directory to be deregistered
+	DirectoryDeregisteredTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a WorkSpace to become available
+	WorkspaceAvailableTimeout = 30 * time.Minute
+
+	// Default maximum amount of time to wait for a WorkSpace to be terminated
+	WorkspaceTerminatedTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a WorkSpace's properties to be updated
+	WorkspaceUpdatedTimeout = 10 * time.Minute
+)
+
+// DirectoryRegistered waits for a directory to be registered with WorkSpaces.
+func DirectoryRegistered(conn *workspaces.WorkSpaces, id string) (*workspaces.WorkspaceDirectory, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workspaces.WorkspaceDirectoryStateRegistering},
+		Target:  []string{workspaces.WorkspaceDirectoryStateRegistered},
+		Refresh: DirectoryState(conn, id),
+		Timeout: DirectoryRegisteredTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*workspaces.WorkspaceDirectory); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DirectoryDeregistered waits for a directory to be deregistered from WorkSpaces.
+func DirectoryDeregistered(conn *workspaces.WorkSpaces, id string) (*workspaces.WorkspaceDirectory, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			workspaces.WorkspaceDirectoryStateRegistering,
+			workspaces.WorkspaceDirectoryStateRegistered,
+			workspaces.WorkspaceDirectoryStateDeregistering,
+		},
+		Target:  []string{},
+		Refresh: DirectoryState(conn, id),
+		Timeout: DirectoryDeregisteredTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*workspaces.WorkspaceDirectory); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WorkspaceAvailable waits for a WorkSpace to become available.
+func WorkspaceAvailable(conn *workspaces.WorkSpaces, id string, timeout time.Duration) (*workspaces.Workspace, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			workspaces.WorkspaceStatePending,
+			workspaces.WorkspaceStateStarting,
+		},
+		Target:  []string{workspaces.WorkspaceStateAvailable},
+		Refresh: WorkspaceState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*workspaces.Workspace); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WorkspaceTerminated waits for a WorkSpace to be terminated.
+// A WorkSpace that is no longer returned by the API is considered terminated.
+func WorkspaceTerminated(conn *workspaces.WorkSpaces, id string, timeout time.Duration) (*workspaces.Workspace, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			workspaces.WorkspaceStatePending,
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateImpaired,
+			workspaces.WorkspaceStateUnhealthy,
+			workspaces.WorkspaceStateRebooting,
+			workspaces.WorkspaceStateStarting,
+			workspaces.WorkspaceStateRebuilding,
+			workspaces.WorkspaceStateMaintenance,
+			workspaces.WorkspaceStateAdminMaintenance,
+			workspaces.WorkspaceStateSuspended,
+			workspaces.WorkspaceStateUpdating,
+			workspaces.WorkspaceStateStopping,
+			workspaces.WorkspaceStateStopped,
+			workspaces.WorkspaceStateTerminating,
+			workspaces.WorkspaceStateError,
+		},
+		Target:  []string{workspaces.WorkspaceStateTerminated},
+		Refresh: WorkspaceState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if output, ok := outputRaw.(*workspaces.Workspace); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WorkspaceUpdated waits for a WorkSpace's properties to finish updating.
+func WorkspaceUpdated(conn *workspaces.WorkSpaces, id string, timeout time.Duration) (*workspaces.Workspace, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workspaces.WorkspaceStateUpdating},
+		Target: []string{
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateStopped,
+		},
+		Refresh: WorkspaceState(conn, id),
+		Timeout: timeout,
+		// The WorkSpace can report its previous state before the update begins.
+		Delay: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*workspaces.Workspace); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,228 @@
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// readOnlyOperationPrefixes are the prefixes of the names of API operations
+// which do not modify resources. A dry run sends only these operations.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// Runner runs the registered sweepers.
+type Runner struct {
+	// DryRun prevents the sweepers from modifying resources. API requests
+	// which are not read-only are recorded instead of sent, and succeed with
+	// an empty response.
+	DryRun bool
+
+	// Parallelism is the maximum number of sweepers run concurrently.
+	// Defaults to 1.
+	Parallelism int
+
+	// ServiceConcurrency is the maximum number of concurrent API requests
+	// sent to each AWS service by all sweepers. Zero means no limit.
+	ServiceConcurrency int
+
+	mu             sync.Mutex
+	dryRunRequests []string
+	throttles      map[string]chan struct{}
+}
+
+// sweeperResult is the outcome of running a single sweeper.
+type sweeperResult struct {
+	name     string
+	duration time.Duration
+	err      error
+}
+
+// Run runs the sweepers matching the filter, see newGraph, in the region.
+//
+// A sweeper starts once all of its dependencies have completed, whether or
+// not they succeeded, so a failing sweeper does not prevent the sweeping of
+// unrelated resources. The errors of all sweepers are returned together.
+func (r *Runner) Run(region, filter string) error {
+	g, err := newGraph(sweepers, filter)
+	if err != nil {
+		return err
+	}
+
+	return r.run(g, region)
+}
+
+func (r *Runner) run(g *graph, region string) error {
+	parallelism := r.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	r.mu.Lock()
+	r.dryRunRequests = nil
+	r.mu.Unlock()
+
+	log.Printf("[DEBUG] Running %d Sweepers for region (%s) with parallelism %d", len(g.sweepers), region, parallelism)
+
+	remaining := make(map[string]int)
+	var ready []string
+	for _, name := range g.names() {
+		remaining[name] = len(g.dependencies[name])
+		if remaining[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	results := make(chan sweeperResult)
+	var completed []sweeperResult
+	running := 0
+
+	for len(completed) < len(g.sweepers) {
+		for len(ready) > 0 && running < parallelism {
+			s := g.sweepers[ready[0]]
+			ready = ready[1:]
+			running++
+
+			go func() {
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", s.Name, region)
+				start := time.Now()
+				err := s.F(region)
+				results <- sweeperResult{name: s.Name, duration: time.Since(start), err: err}
+			}()
+		}
+
+		result := <-results
+		running--
+		completed = append(completed, result)
+
+		for _, name := range g.dependents[result.name] {
+			remaining[name]--
+			if remaining[name] == 0 {
+				ready = append(ready, name)
+			}
+		}
+		sort.Strings(ready)
+	}
+
+	var errs *multierror.Error
+
+	fmt.Printf("Sweepers ran in region (%s):\n", region)
+	for _, result := range completed {
+		status := "ok"
+		if result.err != nil {
+			status = "failed"
+			errs = multierror.Append(errs, fmt.Errorf("error running Sweeper (%s) in region (%s): %s", result.name, region, result.err))
+		}
+		fmt.Printf("\t- %s: %s (%s)\n", result.name, status, result.duration.Round(time.Second))
+	}
+
+	if r.DryRun {
+		r.mu.Lock()
+		requests := r.dryRunRequests
+		r.mu.Unlock()
+
+		fmt.Printf("Sweepers dry run in region (%s) did not send %d requests:\n", region, len(requests))
+		for _, dryRunRequest := range requests {
+			fmt.Printf("\t- %s\n", dryRunRequest)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// ConfigureHandlers adds the request handlers which implement DryRun and
+// ServiceConcurrency to the handlers of an AWS session or service client.
+func (r *Runner) ConfigureHandlers(handlers *request.Handlers) {
+	if r.ServiceConcurrency > 0 {
+		handlers.Send.PushFrontNamed(request.NamedHandler{Name: "sweep.ThrottleAcquire", Fn: r.acquireThrottle})
+		handlers.Send.PushBackNamed(request.NamedHandler{Name: "sweep.ThrottleRelease", Fn: r.releaseThrottle})
+	}
+
+	if r.DryRun {
+		handlers.Build.PushBackNamed(request.NamedHandler{Name: "sweep.DryRun", Fn: r.dryRun})
+	}
+}
+
+// DryRunRequests returns the requests which were not sent during the current
+// or last run, formatted as "<service> <operation> <input>".
+func (r *Runner) DryRunRequests() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string{}, r.dryRunRequests...)
+}
+
+// RecordDryRunRequest records a request which was not sent during a dry run.
+// Sweepers use it for requests made by functions which also wait for the
+// deletion of a resource, as such functions must not be called during a dry
+// run.
+func (r *Runner) RecordDryRunRequest(service, operation string, input interface{}) {
+	// Input structures are formatted over multiple lines.
+	formattedInput := strings.Join(strings.Fields(awsutil.Prettify(input)), " ")
+
+	r.mu.Lock()
+	r.dryRunRequests = append(r.dryRunRequests, fmt.Sprintf("%s %s %s", service, operation, formattedInput))
+	r.mu.Unlock()
+
+	log.Printf("[INFO] Sweeper dry run, not sending %s %s request: %s", service, operation, formattedInput)
+}
+
+func (r *Runner) dryRun(req *request.Request) {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(req.Operation.Name, prefix) {
+			return
+		}
+	}
+
+	r.RecordDryRunRequest(req.ClientInfo.ServiceName, req.Operation.Name, req.Params)
+
+	// Leave the request output empty and successful.
+	req.Handlers.Send.Clear()
+	req.Handlers.UnmarshalMeta.Clear()
+	req.Handlers.ValidateResponse.Clear()
+	req.Handlers.UnmarshalError.Clear()
+	req.Handlers.Unmarshal.Clear()
+	req.Handlers.Retry.Clear()
+	req.Handlers.AfterRetry.Clear()
+}
+
+func (r *Runner) throttle(service string) chan struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.throttles == nil {
+		r.throttles = make(map[string]chan struct{})
+	}
+
+	throttle, ok := r.throttles[service]
+	if !ok {
+		throttle = make(chan struct{}, r.ServiceConcurrency)
+		r.throttles[service] = throttle
+	}
+
+	return throttle
+}
+
+func (r *Runner) acquireThrottle(req *request.Request) {
+	r.throttle(req.ClientInfo.ServiceName) <- struct{}{}
+}
+
+func (r *Runner) releaseThrottle(req *request.Request) {
+	<-r.throttle(req.ClientInfo.ServiceName)
+}
@@ -0,0 +1,193 @@
+package sweep
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func testSession(t *testing.T, s *fakeaws.Server, r *Runner) *session.Session {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:    aws.String(s.URL("sqs")),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	r.ConfigureHandlers(&sess.Handlers)
+
+	return sess
+}
+
+func TestRunnerRun(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	running, maxRunning := 0, 0
+
+	sweeperFunc := func(name string, err error) SweeperFunc {
+		return func(region string) error {
+			mu.Lock()
+			order = append(order, name)
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+
+			return err
+		}
+	}
+
+	source := map[string]*Sweeper{
+		"aws_instance":       {Name: "aws_instance", F: sweeperFunc("aws_instance", errors.New("instance error"))},
+		"aws_security_group": {Name: "aws_security_group", Dependencies: []string{"aws_instance"}, F: sweeperFunc("aws_security_group", nil)},
+		"aws_subnet":         {Name: "aws_subnet", Dependencies: []string{"aws_instance"}, F: sweeperFunc("aws_subnet", errors.New("subnet error"))},
+		"aws_vpc":            {Name: "aws_vpc", Dependencies: []string{"aws_security_group", "aws_subnet"}, F: sweeperFunc("aws_vpc", nil)},
+		"aws_sqs_queue":      {Name: "aws_sqs_queue", F: sweeperFunc("aws_sqs_queue", nil)},
+		"aws_sns_topic":      {Name: "aws_sns_topic", F: sweeperFunc("aws_sns_topic", nil)},
+	}
+
+	g, err := newGraph(source, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &Runner{Parallelism: 2}
+	err = r.run(g, "us-west-2")
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if merr, ok := err.(*multierror.Error); !ok || len(merr.Errors) != 2 {
+		t.Fatalf("expected 2 aggregated errors, got: %#v", err)
+	}
+
+	if len(order) != len(source) {
+		t.Fatalf("expected all %d sweepers to run, got: %v", len(source), order)
+	}
+
+	index := make(map[string]int)
+	for i, name := range order {
+		index[name] = i
+	}
+
+	for name, s := range source {
+		for _, dep := range s.Dependencies {
+			if index[dep] > index[name] {
+				t.Errorf("expected dependency (%s) to run before sweeper (%s), got: %v", dep, name, order)
+			}
+		}
+	}
+
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent sweepers, got %d", maxRunning)
+	}
+}
+
+func TestRunnerConfigureHandlers_DryRun(t *testing.T) {
+	s := fakeaws.NewServer()
+	defer s.Close()
+	fake := fakeaws.RegisterSQS(s)
+
+	conn := sqs.New(testSession(t, s, &Runner{}))
+
+	if _, err := conn.CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String("test")}); err != nil {
+		t.Fatalf("error creating queue: %s", err)
+	}
+
+	r := &Runner{DryRun: true}
+	dryRunConn := sqs.New(testSession(t, s, r))
+
+	output, err := dryRunConn.GetQueueUrl(&sqs.GetQueueUrlInput{QueueName: aws.String("test")})
+	if err != nil {
+		t.Fatalf("error getting queue URL: %s", err)
+	}
+
+	if _, err := dryRunConn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: output.QueueUrl}); err != nil {
+		t.Fatalf("error deleting queue: %s", err)
+	}
+
+	if urls := fake.QueueURLs(); len(urls) != 1 {
+		t.Errorf("expected queue not to be deleted, got: %v", urls)
+	}
+
+	requests := r.DryRunRequests()
+
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 dry run request, got: %v", requests)
+	}
+
+	if expected := "sqs DeleteQueue { QueueUrl: \"" + aws.StringValue(output.QueueUrl) + "\" }"; requests[0] != expected {
+		t.Errorf("expected dry run request %q, got %q", expected, requests[0])
+	}
+}
+
+func TestRunnerConfigureHandlers_ServiceConcurrency(t *testing.T) {
+	s := fakeaws.NewServer()
+	defer s.Close()
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+
+	s.Handle("sqs", "ListQueues", func(req *fakeaws.Request) *fakeaws.Response {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		return fakeaws.XMLResponse("ListQueues", "http://queue.amazonaws.com/doc/2012-11-05/", "")
+	})
+
+	conn := sqs.New(testSession(t, s, &Runner{ServiceConcurrency: 2}))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := conn.ListQueues(&sqs.ListQueuesInput{})
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("error listing queues: %s", err)
+		}
+	}
+
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", maxRunning)
+	}
+}
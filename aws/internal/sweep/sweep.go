@@ -0,0 +1,192 @@
+// Package sweep implements a runner for the acceptance test sweepers, which
+// delete resources left behind by failed or interrupted acceptance tests.
+//
+// Sweepers are registered with AddTestSweepers, usually in the init function
+// of the acceptance test file of the resource they sweep. The Runner orders
+// the registered sweepers by their dependencies, runs independent sweepers
+// concurrently and aggregates their errors instead of stopping at the first
+// failure.
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// SweeperFunc is a function which deletes all sweepable resources of one
+// resource type in the given region.
+type SweeperFunc func(region string) error
+
+// Sweeper is a named SweeperFunc along with the sweepers it depends on.
+type Sweeper struct {
+	// Name of the sweeper, which must be unique.
+	Name string
+
+	// Dependencies are the names of the sweepers which must run before this
+	// sweeper, e.g. the sweepers of the resources which prevent deletion of
+	// the resources swept by this sweeper.
+	Dependencies []string
+
+	// F is the function which sweeps the resources.
+	F SweeperFunc
+}
+
+var sweepers = make(map[string]*Sweeper)
+
+// AddTestSweepers registers a sweeper under the given name.
+func AddTestSweepers(name string, s *Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	sweepers[name] = s
+}
+
+// graph is the dependency graph of a set of sweepers.
+type graph struct {
+	// sweepers by name.
+	sweepers map[string]*Sweeper
+
+	// dependencies by sweeper name, limited to the sweepers in the graph.
+	dependencies map[string][]string
+
+	// dependents by sweeper name, i.e. the reverse of dependencies.
+	dependents map[string][]string
+}
+
+// newGraph returns the dependency graph of the sweepers in source whose name
+// contains one of the comma separated, case insensitive names in filter, and
+// of all the sweepers they transitively depend on. An empty filter matches
+// all sweepers.
+//
+// Dependencies on sweepers which are not registered are ignored, but an error
+// is returned if the dependencies contain a cycle.
+func newGraph(source map[string]*Sweeper, filter string) (*graph, error) {
+	g := &graph{
+		sweepers:     make(map[string]*Sweeper),
+		dependencies: make(map[string][]string),
+		dependents:   make(map[string][]string),
+	}
+
+	var add func(name string)
+	add = func(name string) {
+		if _, ok := g.sweepers[name]; ok {
+			return
+		}
+
+		s := source[name]
+		g.sweepers[name] = s
+
+		for _, dep := range s.Dependencies {
+			if _, ok := source[dep]; !ok {
+				log.Printf("[DEBUG] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dep)
+				continue
+			}
+
+			g.dependencies[name] = append(g.dependencies[name], dep)
+			g.dependents[dep] = append(g.dependents[dep], name)
+			add(dep)
+		}
+	}
+
+	for _, name := range filterNames(source, filter) {
+		add(name)
+	}
+
+	if cycle := g.cycle(); len(cycle) > 0 {
+		return nil, fmt.Errorf("sweeper dependency cycle: %s", strings.Join(cycle, " -> "))
+	}
+
+	return g, nil
+}
+
+// filterNames returns the sorted names of the sweepers in source matching
+// the filter.
+func filterNames(source map[string]*Sweeper, filter string) []string {
+	var filters []string
+	for _, f := range strings.Split(strings.ToLower(filter), ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			filters = append(filters, f)
+		}
+	}
+
+	var names []string
+	for name := range source {
+		if len(filters) == 0 {
+			names = append(names, name)
+			continue
+		}
+
+		for _, f := range filters {
+			if strings.Contains(strings.ToLower(name), f) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// names returns the sorted names of the sweepers in the graph.
+func (g *graph) names() []string {
+	names := make([]string, 0, len(g.sweepers))
+	for name := range g.sweepers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// cycle returns the names of the sweepers along a dependency cycle, starting
+// and ending with the same sweeper, or nil if the graph is acyclic.
+func (g *graph) cycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int)
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visiting:
+			for i, n := range path {
+				if n == name {
+					return append(append([]string{}, path[i:]...), name)
+				}
+			}
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+
+		for _, dep := range g.dependencies[name] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, name := range g.names() {
+		if cycle := visit(name); cycle != nil {
+			return cycle
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package sweep
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testSweepers(dependencies map[string][]string) map[string]*Sweeper {
+	source := make(map[string]*Sweeper)
+	for name, deps := range dependencies {
+		source[name] = &Sweeper{
+			Name:         name,
+			Dependencies: deps,
+			F:            func(string) error { return nil },
+		}
+	}
+
+	return source
+}
+
+func TestNewGraph(t *testing.T) {
+	source := testSweepers(map[string][]string{
+		"aws_instance":       nil,
+		"aws_security_group": {"aws_instance"},
+		"aws_subnet":         {"aws_instance", "aws_not_registered"},
+		"aws_vpc":            {"aws_security_group", "aws_subnet"},
+		"aws_sqs_queue":      nil,
+	})
+
+	testCases := []struct {
+		filter       string
+		expected     []string
+		dependencies map[string][]string
+	}{
+		{
+			filter:   "",
+			expected: []string{"aws_instance", "aws_security_group", "aws_sqs_queue", "aws_subnet", "aws_vpc"},
+		},
+		{
+			filter:   "aws_sqs_queue",
+			expected: []string{"aws_sqs_queue"},
+		},
+		{
+			filter:   "AWS_VPC",
+			expected: []string{"aws_instance", "aws_security_group", "aws_subnet", "aws_vpc"},
+		},
+		{
+			filter:   "subnet, sqs",
+			expected: []string{"aws_instance", "aws_sqs_queue", "aws_subnet"},
+		},
+		{
+			filter:   "aws_nonexistent",
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		g, err := newGraph(source, tc.filter)
+		if err != nil {
+			t.Fatalf("filter %q: unexpected error: %s", tc.filter, err)
+		}
+
+		if got := g.names(); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("filter %q: expected sweepers %v, got %v", tc.filter, tc.expected, got)
+		}
+
+		if deps := g.dependencies["aws_subnet"]; len(deps) > 1 {
+			t.Errorf("filter %q: expected unregistered dependency to be ignored, got %v", tc.filter, deps)
+		}
+	}
+}
+
+func TestNewGraph_Cycle(t *testing.T) {
+	source := testSweepers(map[string][]string{
+		"aws_a": {"aws_b"},
+		"aws_b": {"aws_c"},
+		"aws_c": {"aws_a"},
+		"aws_d": nil,
+	})
+
+	_, err := newGraph(source, "aws_d,aws_a")
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if !strings.Contains(err.Error(), "aws_a -> aws_b -> aws_c -> aws_a") {
+		t.Errorf("expected error to contain the dependency cycle, got: %s", err)
+	}
+}
@@ -0,0 +1,31 @@
+// Package tfawserr provides helpers for matching AWS Go SDK errors, for use by
+// the internal service packages which cannot use the helpers of the provider
+// package.
+package tfawserr
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrCodeEquals returns true if the error is an AWS Go SDK error with the
+// given code.
+func ErrCodeEquals(err error, code string) bool {
+	if err, ok := err.(awserr.Error); ok {
+		return err.Code() == code
+	}
+
+	return false
+}
+
+// ErrMessageContains returns true if the error is an AWS Go SDK error with the
+// given code and a message containing the given message. It is equivalent to
+// isAWSErr in the provider package.
+func ErrMessageContains(err error, code string, message string) bool {
+	if err, ok := err.(awserr.Error); ok {
+		return err.Code() == code && strings.Contains(err.Message(), message)
+	}
+
+	return false
+}
@@ -0,0 +1,89 @@
+package tfawserr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestErrCodeEquals(t *testing.T) {
+	testCases := []struct {
+		err      error
+		code     string
+		expected bool
+	}{
+		{
+			err:      nil,
+			code:     "NotFound",
+			expected: false,
+		},
+		{
+			err:      errors.New("NotFound"),
+			code:     "NotFound",
+			expected: false,
+		},
+		{
+			err:      awserr.New("NotFound", "not found", nil),
+			code:     "NotFound",
+			expected: true,
+		},
+		{
+			err:      awserr.New("NotFound", "not found", nil),
+			code:     "ValidationError",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := ErrCodeEquals(tc.err, tc.code); got != tc.expected {
+			t.Errorf("ErrCodeEquals(%v, %q): expected %t, got %t", tc.err, tc.code, tc.expected, got)
+		}
+	}
+}
+
+func TestErrMessageContains(t *testing.T) {
+	testCases := []struct {
+		err      error
+		code     string
+		message  string
+		expected bool
+	}{
+		{
+			err:      nil,
+			code:     "ClientException",
+			message:  "",
+			expected: false,
+		},
+		{
+			err:      awserr.New("ClientException", "No cluster found for name: test", nil),
+			code:     "ClientException",
+			message:  "",
+			expected: true,
+		},
+		{
+			err:      awserr.New("ClientException", "No cluster found for name: test", nil),
+			code:     "ClientException",
+			message:  "No cluster found",
+			expected: true,
+		},
+		{
+			err:      awserr.New("ClientException", "Cluster is not active", nil),
+			code:     "ClientException",
+			message:  "No cluster found",
+			expected: false,
+		},
+		{
+			err:      awserr.New("ResourceNotFoundException", "No cluster found for name: test", nil),
+			code:     "ClientException",
+			message:  "No cluster found",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := ErrMessageContains(tc.err, tc.code, tc.message); got != tc.expected {
+			t.Errorf("ErrMessageContains(%v, %q, %q): expected %t, got %t", tc.err, tc.code, tc.message, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// Package tfresource provides helpers for the Terraform resource lifecycle
+// shared by the internal service packages.
+package tfresource
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// NotFound returns true if the error represents a "resource not found"
+// condition, as returned by the finder functions of the service packages.
+func NotFound(err error) bool {
+	_, ok := err.(*resource.NotFoundError)
+
+	return ok
+}
+
+// NewEmptyResultError returns a "resource not found" error for an API
+// response without the requested resource.
+func NewEmptyResultError(lastRequest interface{}) error {
+	return &resource.NotFoundError{
+		Message:     "empty result",
+		LastRequest: lastRequest,
+	}
+}
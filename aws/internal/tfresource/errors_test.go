@@ -0,0 +1,42 @@
+package tfresource
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestNotFound(t *testing.T) {
+	testCases := []struct {
+		err      error
+		expected bool
+	}{
+		{
+			err:      nil,
+			expected: false,
+		},
+		{
+			err:      errors.New("not found"),
+			expected: false,
+		},
+		{
+			err:      &resource.NotFoundError{},
+			expected: true,
+		},
+		{
+			err:      NewEmptyResultError(nil),
+			expected: true,
+		},
+		{
+			err:      &resource.TimeoutError{},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := NotFound(tc.err); got != tc.expected {
+			t.Errorf("NotFound(%v): expected %t, got %t", tc.err, tc.expected, got)
+		}
+	}
+}
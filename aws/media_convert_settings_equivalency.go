@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+)
+
+// mediaConvertJobTemplateSettingsAreEquivalent determines whether the job template settings returned by the API
+// are equivalent to the configured job template settings JSON string
+func mediaConvertJobTemplateSettingsAreEquivalent(apiSettings, configuredSettings string) (bool, error) {
+	return apiObjectJsonAreEquivalent(apiSettings, configuredSettings, &mediaconvert.JobTemplateSettings{}, &mediaconvert.JobTemplateSettings{})
+}
+
+// mediaConvertPresetSettingsAreEquivalent determines whether the preset settings returned by the API
+// are equivalent to the configured preset settings JSON string
+func mediaConvertPresetSettingsAreEquivalent(apiSettings, configuredSettings string) (bool, error) {
+	return apiObjectJsonAreEquivalent(apiSettings, configuredSettings, &mediaconvert.PresetSettings{}, &mediaconvert.PresetSettings{})
+}
+
+func expandMediaConvertJobTemplateSettings(settings string) (*mediaconvert.JobTemplateSettings, error) {
+	apiObject := &mediaconvert.JobTemplateSettings{}
+
+	if err := expandApiObjectJson(settings, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+func expandMediaConvertPresetSettings(settings string) (*mediaconvert.PresetSettings, error) {
+	apiObject := &mediaconvert.PresetSettings{}
+
+	if err := expandApiObjectJson(settings, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestMediaConvertPresetSettingsAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		APISettings        string
+		ConfiguredSettings string
+		ExpectEqual        bool
+		ExpectedError      bool
+	}{
+		{
+			Name: "formatting and key order",
+			APISettings: `{
+  "containerSettings": {"container": "MP4"},
+  "videoDescription": {"width": 1280, "height": 720}
+}`,
+			ConfiguredSettings: `{"videoDescription":{"height":720,"width":1280},"containerSettings":{"container":"MP4"}}`,
+			ExpectEqual:        true,
+		},
+		{
+			Name:               "unknown keys",
+			APISettings:        `{"containerSettings": {"container": "MP4"}}`,
+			ConfiguredSettings: `{"containerSettings": {"container": "MP4"}, "unknown": true}`,
+			ExpectEqual:        true,
+		},
+		{
+			Name:               "defaults populated by the API",
+			APISettings:        `{"containerSettings": {"container": "MP4", "mp4Settings": {"cslgAtom": "INCLUDE", "freeSpaceBox": "EXCLUDE"}}}`,
+			ConfiguredSettings: `{"containerSettings": {"container": "MP4"}}`,
+			ExpectEqual:        true,
+		},
+		{
+			Name:               "value missing from the API",
+			APISettings:        `{"containerSettings": {"container": "MP4"}}`,
+			ConfiguredSettings: `{"containerSettings": {"container": "MP4", "mp4Settings": {"cslgAtom": "INCLUDE"}}}`,
+			ExpectEqual:        false,
+		},
+		{
+			Name:               "different values",
+			APISettings:        `{"containerSettings": {"container": "MP4"}}`,
+			ConfiguredSettings: `{"containerSettings": {"container": "M2TS"}}`,
+			ExpectEqual:        false,
+		},
+		{
+			Name:               "invalid JSON",
+			APISettings:        `{"containerSettings": {"container": "MP4"}}`,
+			ConfiguredSettings: `{"containerSettings":`,
+			ExpectedError:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			equal, err := mediaConvertPresetSettingsAreEquivalent(testCase.APISettings, testCase.ConfiguredSettings)
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatal("expected error")
+			}
+
+			if equal != testCase.ExpectEqual {
+				t.Errorf("got %t, expected %t", equal, testCase.ExpectEqual)
+			}
+		})
+	}
+}
+
+func TestMediaConvertJobTemplateSettingsAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		APISettings        string
+		ConfiguredSettings string
+		ExpectEqual        bool
+		ExpectedError      bool
+	}{
+		{
+			Name: "formatting and key order",
+			APISettings: `{
+  "outputGroups": [
+    {
+      "name": "File Group",
+      "outputGroupSettings": {"type": "FILE_GROUP_SETTINGS"}
+    }
+  ],
+  "timecodeConfig": {"source": "ZEROBASED"}
+}`,
+			ConfiguredSettings: `{"timecodeConfig":{"source":"ZEROBASED"},"outputGroups":[{"outputGroupSettings":{"type":"FILE_GROUP_SETTINGS"},"name":"File Group"}]}`,
+			ExpectEqual:        true,
+		},
+		{
+			Name:               "additional output group",
+			APISettings:        `{"outputGroups": [{"name": "Group 1"}, {"name": "Group 2"}]}`,
+			ConfiguredSettings: `{"outputGroups": [{"name": "Group 1"}]}`,
+			ExpectEqual:        false,
+		},
+		{
+			Name:               "different output group order",
+			APISettings:        `{"outputGroups": [{"name": "Group 1"}, {"name": "Group 2"}]}`,
+			ConfiguredSettings: `{"outputGroups": [{"name": "Group 2"}, {"name": "Group 1"}]}`,
+			ExpectEqual:        false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			equal, err := mediaConvertJobTemplateSettingsAreEquivalent(testCase.APISettings, testCase.ConfiguredSettings)
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatal("expected error")
+			}
+
+			if equal != testCase.ExpectEqual {
+				t.Errorf("got %t, expected %t", equal, testCase.ExpectEqual)
+			}
+		})
+	}
+}
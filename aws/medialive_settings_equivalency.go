@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/medialive"
+)
+
+// mediaLiveEncoderSettingsAreEquivalent determines whether the channel encoder settings returned by the API
+// are equivalent to the configured encoder settings JSON string
+func mediaLiveEncoderSettingsAreEquivalent(apiSettings, configuredSettings string) (bool, error) {
+	return apiObjectJsonAreEquivalent(apiSettings, configuredSettings, &medialive.EncoderSettings{}, &medialive.EncoderSettings{})
+}
+
+// mediaLiveInputSettingsAreEquivalent determines whether the input attachment settings returned by the API
+// are equivalent to the configured input settings JSON string
+func mediaLiveInputSettingsAreEquivalent(apiSettings, configuredSettings string) (bool, error) {
+	return apiObjectJsonAreEquivalent(apiSettings, configuredSettings, &medialive.InputSettings{}, &medialive.InputSettings{})
+}
+
+func expandMediaLiveEncoderSettings(settings string) (*medialive.EncoderSettings, error) {
+	apiObject := &medialive.EncoderSettings{}
+
+	if err := expandApiObjectJson(settings, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+func expandMediaLiveInputSettings(settings string) (*medialive.InputSettings, error) {
+	apiObject := &medialive.InputSettings{}
+
+	if err := expandApiObjectJson(settings, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
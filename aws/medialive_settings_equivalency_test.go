@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestMediaLiveEncoderSettingsAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		APISettings        string
+		ConfiguredSettings string
+		ExpectEqual        bool
+		ExpectedError      bool
+	}{
+		{
+			Name: "formatting and key order",
+			APISettings: `{
+  "timecodeConfig": {"source": "EMBEDDED"},
+  "audioDescriptions": [{"audioSelectorName": "default", "name": "audio_1"}]
+}`,
+			ConfiguredSettings: `{"audioDescriptions":[{"name":"audio_1","audioSelectorName":"default"}],"timecodeConfig":{"source":"EMBEDDED"}}`,
+			ExpectEqual:        true,
+		},
+		{
+			Name:               "defaults populated by the API",
+			APISettings:        `{"videoDescriptions": [{"name": "video_1", "respondToAfd": "NONE", "scalingBehavior": "DEFAULT", "sharpness": 50}]}`,
+			ConfiguredSettings: `{"videoDescriptions": [{"name": "video_1"}]}`,
+			ExpectEqual:        true,
+		},
+		{
+			Name:               "different values",
+			APISettings:        `{"timecodeConfig": {"source": "EMBEDDED"}}`,
+			ConfiguredSettings: `{"timecodeConfig": {"source": "SYSTEMCLOCK"}}`,
+			ExpectEqual:        false,
+		},
+		{
+			Name:               "additional video description",
+			APISettings:        `{"videoDescriptions": [{"name": "video_1"}]}`,
+			ConfiguredSettings: `{"videoDescriptions": [{"name": "video_1"}, {"name": "video_2"}]}`,
+			ExpectEqual:        false,
+		},
+		{
+			Name:               "invalid JSON",
+			APISettings:        `{"timecodeConfig": {"source": "EMBEDDED"}}`,
+			ConfiguredSettings: `{"timecodeConfig":`,
+			ExpectedError:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			equal, err := mediaLiveEncoderSettingsAreEquivalent(testCase.APISettings, testCase.ConfiguredSettings)
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatal("expected error")
+			}
+
+			if equal != testCase.ExpectEqual {
+				t.Errorf("got %t, expected %t", equal, testCase.ExpectEqual)
+			}
+		})
+	}
+}
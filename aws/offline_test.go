@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+// Offline tests run resource.UnitTest cases against the in-process fake AWS
+// API endpoint in aws/internal/fakeaws instead of a real AWS account. They
+// are named TestOffline* and run as part of the normal unit test suite.
+//
+// Each test starts its own fakeaws.Server, registers the in-memory service
+// fakes it needs and configures the provider with testOfflineProviderConfig,
+// which points the endpoints of every service at the server. Requests for
+// services or operations without a fake fail with an InvalidAction error.
+
+// testOfflineServer returns a new fake AWS API endpoint server with the STS
+// fake registered, as used by provider credentials validation.
+func testOfflineServer() *fakeaws.Server {
+	s := fakeaws.NewServer()
+	fakeaws.RegisterSTS(s)
+
+	return s
+}
+
+// testOfflineProviderFactories returns provider factories which create a new
+// provider for each test, so that offline tests do not share the configured
+// testAccProvider with acceptance tests.
+func testOfflineProviderFactories() map[string]terraform.ResourceProviderFactory {
+	return map[string]terraform.ResourceProviderFactory{
+		"aws": func() (terraform.ResourceProvider, error) {
+			return Provider(), nil
+		},
+	}
+}
+
+// testOfflineProviderConfig returns a provider configuration with fake
+// credentials which sends the requests of all services to the given server.
+func testOfflineProviderConfig(s *fakeaws.Server) string {
+	var endpoints strings.Builder
+
+	for _, endpointServiceName := range endpointServiceNames {
+		// Skip deprecated endpoint configurations
+		if endpointServiceName == "kinesis_analytics" || endpointServiceName == "r53" {
+			continue
+		}
+
+		fmt.Fprintf(&endpoints, "    %s = %q\n", endpointServiceName, s.URL(endpointServiceName))
+	}
+
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key                  = "mock_access_key"
+  region                      = "us-east-1"
+  secret_key                  = "mock_secret_key"
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+
+  endpoints {
+%s  }
+}
+`, endpoints.String())
+}
+
+// testOfflineCheckDestroyed returns a TestCheckFunc which verifies that the
+// given fake no longer holds any resources.
+func testOfflineCheckDestroyed(resourceType string, remaining func() []string) func(*terraform.State) error {
+	return func(*terraform.State) error {
+		if ids := remaining(); len(ids) > 0 {
+			return fmt.Errorf("%s still exist: %s", resourceType, strings.Join(ids, ", "))
+		}
+
+		return nil
+	}
+}
+
+func TestOfflineRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-offline-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cassette := filepath.Join(dir, "sqs_queue.json")
+
+	// Record the interactions with the in-memory fakes.
+	recordServer := testOfflineServer()
+	defer recordServer.Close()
+	fake := fakeaws.RegisterSQS(recordServer)
+
+	resource.UnitTest(t, testOfflineAWSSQSQueueTestCase(recordServer, testOfflineCheckDestroyed("SQS Queues", fake.QueueURLs)))
+
+	if err := recordServer.SaveCassette(cassette); err != nil {
+		t.Fatal(err)
+	}
+
+	// Replay them without any fakes.
+	replayServer := fakeaws.NewServer()
+	defer replayServer.Close()
+
+	if err := replayServer.LoadCassette(cassette); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.UnitTest(t, testOfflineAWSSQSQueueTestCase(replayServer, nil))
+
+	if unused := replayServer.UnusedReplays(); len(unused) > 0 {
+		t.Errorf("expected all %d recorded interactions to be replayed, %d were not", len(recordServer.Interactions()), len(unused))
+	}
+}
@@ -2,9 +2,11 @@ package aws
 
 import (
 	"log"
+	"math"
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -40,6 +42,12 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"default_tags": defaultTagsSchema(),
+
+			"ignore_tags": ignoreTagsSchema(),
+
+			"rate_limit": rateLimitSchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -97,6 +105,20 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["insecure"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"http_proxy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateHTTPProxyURL,
+				Description:  descriptions["http_proxy"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -220,6 +242,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_instances":                                 dataSourceAwsInstances(),
 			"aws_ip_ranges":                                 dataSourceAwsIPRanges(),
 			"aws_kinesis_stream":                            dataSourceAwsKinesisStream(),
+			"aws_kinesis_video_stream":                      dataSourceAwsKinesisVideoStream(),
 			"aws_kms_alias":                                 dataSourceAwsKmsAlias(),
 			"aws_kms_ciphertext":                            dataSourceAwsKmsCiphertext(),
 			"aws_kms_key":                                   dataSourceAwsKmsKey(),
@@ -300,6 +323,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_ami_copy":                                            resourceAwsAmiCopy(),
 			"aws_ami_from_instance":                                   resourceAwsAmiFromInstance(),
 			"aws_ami_launch_permission":                               resourceAwsAmiLaunchPermission(),
+			"aws_amplify_app":                                         resourceAwsAmplifyApp(),
+			"aws_amplify_branch":                                      resourceAwsAmplifyBranch(),
+			"aws_amplify_domain_association":                          resourceAwsAmplifyDomainAssociation(),
+			"aws_amplify_webhook":                                     resourceAwsAmplifyWebhook(),
 			"aws_api_gateway_account":                                 resourceAwsApiGatewayAccount(),
 			"aws_api_gateway_api_key":                                 resourceAwsApiGatewayApiKey(),
 			"aws_api_gateway_authorizer":                              resourceAwsApiGatewayAuthorizer(),
@@ -323,6 +350,17 @@ func Provider() terraform.ResourceProvider {
 			"aws_api_gateway_usage_plan":                              resourceAwsApiGatewayUsagePlan(),
 			"aws_api_gateway_usage_plan_key":                          resourceAwsApiGatewayUsagePlanKey(),
 			"aws_api_gateway_vpc_link":                                resourceAwsApiGatewayVpcLink(),
+			"aws_apigatewayv2_api":                                    resourceAwsApiGatewayV2Api(),
+			"aws_apigatewayv2_api_mapping":                            resourceAwsApiGatewayV2ApiMapping(),
+			"aws_apigatewayv2_authorizer":                             resourceAwsApiGatewayV2Authorizer(),
+			"aws_apigatewayv2_deployment":                             resourceAwsApiGatewayV2Deployment(),
+			"aws_apigatewayv2_domain_name":                            resourceAwsApiGatewayV2DomainName(),
+			"aws_apigatewayv2_integration":                            resourceAwsApiGatewayV2Integration(),
+			"aws_apigatewayv2_integration_response":                   resourceAwsApiGatewayV2IntegrationResponse(),
+			"aws_apigatewayv2_model":                                  resourceAwsApiGatewayV2Model(),
+			"aws_apigatewayv2_route":                                  resourceAwsApiGatewayV2Route(),
+			"aws_apigatewayv2_route_response":                         resourceAwsApiGatewayV2RouteResponse(),
+			"aws_apigatewayv2_stage":                                  resourceAwsApiGatewayV2Stage(),
 			"aws_app_cookie_stickiness_policy":                        resourceAwsAppCookieStickinessPolicy(),
 			"aws_appautoscaling_target":                               resourceAwsAppautoscalingTarget(),
 			"aws_appautoscaling_policy":                               resourceAwsAppautoscalingPolicy(),
@@ -332,6 +370,12 @@ func Provider() terraform.ResourceProvider {
 			"aws_appmesh_virtual_node":                                resourceAwsAppmeshVirtualNode(),
 			"aws_appmesh_virtual_router":                              resourceAwsAppmeshVirtualRouter(),
 			"aws_appmesh_virtual_service":                             resourceAwsAppmeshVirtualService(),
+			"aws_appstream_fleet":                                     resourceAwsAppStreamFleet(),
+			"aws_appstream_fleet_stack_association":                   resourceAwsAppStreamFleetStackAssociation(),
+			"aws_appstream_image_builder":                             resourceAwsAppStreamImageBuilder(),
+			"aws_appstream_stack":                                     resourceAwsAppStreamStack(),
+			"aws_appstream_user":                                      resourceAwsAppStreamUser(),
+			"aws_appstream_user_stack_association":                    resourceAwsAppStreamUserStackAssociation(),
 			"aws_appsync_api_key":                                     resourceAwsAppsyncApiKey(),
 			"aws_appsync_datasource":                                  resourceAwsAppsyncDatasource(),
 			"aws_appsync_function":                                    resourceAwsAppsyncFunction(),
@@ -456,9 +500,15 @@ func Provider() terraform.ResourceProvider {
 			"aws_ebs_snapshot_copy":                                   resourceAwsEbsSnapshotCopy(),
 			"aws_ebs_volume":                                          resourceAwsEbsVolume(),
 			"aws_ec2_capacity_reservation":                            resourceAwsEc2CapacityReservation(),
+			"aws_ec2_client_vpn_authorization_rule":                   resourceAwsEc2ClientVpnAuthorizationRule(),
 			"aws_ec2_client_vpn_endpoint":                             resourceAwsEc2ClientVpnEndpoint(),
 			"aws_ec2_client_vpn_network_association":                  resourceAwsEc2ClientVpnNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                                resourceAwsEc2ClientVpnRoute(),
 			"aws_ec2_fleet":                                           resourceAwsEc2Fleet(),
+			"aws_ec2_traffic_mirror_filter":                           resourceAwsEc2TrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                      resourceAwsEc2TrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                          resourceAwsEc2TrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                           resourceAwsEc2TrafficMirrorTarget(),
 			"aws_ec2_transit_gateway":                                 resourceAwsEc2TransitGateway(),
 			"aws_ec2_transit_gateway_route":                           resourceAwsEc2TransitGatewayRoute(),
 			"aws_ec2_transit_gateway_route_table":                     resourceAwsEc2TransitGatewayRouteTable(),
@@ -514,9 +564,16 @@ func Provider() terraform.ResourceProvider {
 			"aws_glue_classifier":                                     resourceAwsGlueClassifier(),
 			"aws_glue_connection":                                     resourceAwsGlueConnection(),
 			"aws_glue_crawler":                                        resourceAwsGlueCrawler(),
+			"aws_glue_data_catalog_encryption_settings":               resourceAwsGlueDataCatalogEncryptionSettings(),
+			"aws_glue_dev_endpoint":                                   resourceAwsGlueDevEndpoint(),
 			"aws_glue_job":                                            resourceAwsGlueJob(),
+			"aws_glue_ml_transform":                                   resourceAwsGlueMLTransform(),
+			"aws_glue_partition":                                      resourceAwsGluePartition(),
+			"aws_glue_resource_policy":                                resourceAwsGlueResourcePolicy(),
 			"aws_glue_security_configuration":                         resourceAwsGlueSecurityConfiguration(),
 			"aws_glue_trigger":                                        resourceAwsGlueTrigger(),
+			"aws_glue_user_defined_function":                          resourceAwsGlueUserDefinedFunction(),
+			"aws_glue_workflow":                                       resourceAwsGlueWorkflow(),
 			"aws_guardduty_detector":                                  resourceAwsGuardDutyDetector(),
 			"aws_guardduty_invite_accepter":                           resourceAwsGuardDutyInviteAccepter(),
 			"aws_guardduty_ipset":                                     resourceAwsGuardDutyIpset(),
@@ -557,16 +614,28 @@ func Provider() terraform.ResourceProvider {
 			"aws_iot_thing_principal_attachment":                      resourceAwsIotThingPrincipalAttachment(),
 			"aws_iot_thing_type":                                      resourceAwsIotThingType(),
 			"aws_iot_topic_rule":                                      resourceAwsIotTopicRule(),
+			"aws_iotanalytics_channel":                                resourceAwsIotAnalyticsChannel(),
+			"aws_iotanalytics_dataset":                                resourceAwsIotAnalyticsDataset(),
+			"aws_iotanalytics_datastore":                              resourceAwsIotAnalyticsDatastore(),
+			"aws_iotanalytics_pipeline":                               resourceAwsIotAnalyticsPipeline(),
+			"aws_iotevents_detector_model":                            resourceAwsIotEventsDetectorModel(),
+			"aws_iotevents_input":                                     resourceAwsIotEventsInput(),
 			"aws_iot_role_alias":                                      resourceAwsIotRoleAlias(),
 			"aws_key_pair":                                            resourceAwsKeyPair(),
 			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
 			"aws_kinesis_analytics_application":                       resourceAwsKinesisAnalyticsApplication(),
+			"aws_kinesis_video_stream":                                resourceAwsKinesisVideoStream(),
+			"aws_kinesisanalyticsv2_application":                      resourceAwsKinesisAnalyticsV2Application(),
+			"aws_kinesisanalyticsv2_application_snapshot":             resourceAwsKinesisAnalyticsV2ApplicationSnapshot(),
 			"aws_kms_alias":                                           resourceAwsKmsAlias(),
 			"aws_kms_external_key":                                    resourceAwsKmsExternalKey(),
 			"aws_kms_grant":                                           resourceAwsKmsGrant(),
 			"aws_kms_key":                                             resourceAwsKmsKey(),
 			"aws_kms_ciphertext":                                      resourceAwsKmsCiphertext(),
+			"aws_lakeformation_data_lake_settings":                    resourceAwsLakeFormationDataLakeSettings(),
+			"aws_lakeformation_permissions":                           resourceAwsLakeFormationPermissions(),
+			"aws_lakeformation_resource":                              resourceAwsLakeFormationResource(),
 			"aws_lambda_function":                                     resourceAwsLambdaFunction(),
 			"aws_lambda_event_source_mapping":                         resourceAwsLambdaEventSourceMapping(),
 			"aws_lambda_alias":                                        resourceAwsLambdaAlias(),
@@ -591,9 +660,16 @@ func Provider() terraform.ResourceProvider {
 			"aws_main_route_table_association":                        resourceAwsMainRouteTableAssociation(),
 			"aws_mq_broker":                                           resourceAwsMqBroker(),
 			"aws_mq_configuration":                                    resourceAwsMqConfiguration(),
+			"aws_media_convert_job_template":                          resourceAwsMediaConvertJobTemplate(),
+			"aws_media_convert_preset":                                resourceAwsMediaConvertPreset(),
+			"aws_media_convert_queue":                                 resourceAwsMediaConvertQueue(),
 			"aws_media_package_channel":                               resourceAwsMediaPackageChannel(),
+			"aws_media_package_origin_endpoint":                       resourceAwsMediaPackageOriginEndpoint(),
 			"aws_media_store_container":                               resourceAwsMediaStoreContainer(),
 			"aws_media_store_container_policy":                        resourceAwsMediaStoreContainerPolicy(),
+			"aws_medialive_channel":                                   resourceAwsMediaLiveChannel(),
+			"aws_medialive_input":                                     resourceAwsMediaLiveInput(),
+			"aws_medialive_input_security_group":                      resourceAwsMediaLiveInputSecurityGroup(),
 			"aws_msk_cluster":                                         resourceAwsMskCluster(),
 			"aws_msk_configuration":                                   resourceAwsMskConfiguration(),
 			"aws_nat_gateway":                                         resourceAwsNatGateway(),
@@ -632,7 +708,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_organizations_organizational_unit":                   resourceAwsOrganizationsOrganizationalUnit(),
 			"aws_placement_group":                                     resourceAwsPlacementGroup(),
 			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
+			"aws_quicksight_data_source":                              resourceAwsQuickSightDataSource(),
 			"aws_quicksight_group":                                    resourceAwsQuickSightGroup(),
+			"aws_quicksight_group_membership":                         resourceAwsQuickSightGroupMembership(),
+			"aws_quicksight_user":                                     resourceAwsQuickSightUser(),
 			"aws_ram_principal_association":                           resourceAwsRamPrincipalAssociation(),
 			"aws_ram_resource_association":                            resourceAwsRamResourceAssociation(),
 			"aws_ram_resource_share":                                  resourceAwsRamResourceShare(),
@@ -792,6 +871,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_wafregional_web_acl_association":                     resourceAwsWafRegionalWebAclAssociation(),
 			"aws_worklink_fleet":                                      resourceAwsWorkLinkFleet(),
 			"aws_worklink_website_certificate_authority_association":  resourceAwsWorkLinkWebsiteCertificateAuthorityAssociation(),
+			"aws_workspaces_directory":                                resourceAwsWorkspacesDirectory(),
+			"aws_workspaces_ip_group":                                 resourceAwsWorkspacesIpGroup(),
+			"aws_workspaces_workspace":                                resourceAwsWorkspacesWorkspace(),
 			"aws_batch_compute_environment":                           resourceAwsBatchComputeEnvironment(),
 			"aws_batch_job_definition":                                resourceAwsBatchJobDefinition(),
 			"aws_batch_job_queue":                                     resourceAwsBatchJobQueue(),
@@ -861,6 +943,13 @@ func init() {
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
+		"custom_ca_bundle": "File path or PEM contents of a custom bundle of certificate authorities" +
+			" to trust when verifying AWS API TLS connections, such as those of a TLS-intercepting proxy." +
+			" Can also be set with the AWS_CA_BUNDLE environment variable.",
+
+		"http_proxy": "URL of an HTTP or HTTPS proxy to send all AWS API requests through." +
+			" If omitted, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.",
+
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
 
@@ -892,6 +981,38 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_policy_arns": "Amazon Resource Names (ARNs) of IAM managed policies to use as" +
+			" session policies when assuming the role.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session. Valid values" +
+			" are between 900 and 43200. If omitted, the session lasts 15 minutes and is renewed as needed.",
+
+		"assume_role_tags": "Session tags to pass when assuming the role.",
+
+		"assume_role_transitive_tag_keys": "Keys of the session tags to pass to subsequent sessions in a" +
+			" role chain.",
+
+		"default_tags": "Configuration block with settings to default resource tags across all resources.",
+
+		"default_tags_tags": "Resource tags to default across all resources. Tags configured on a" +
+			" resource take precedence over default tags with the same key.",
+
+		"ignore_tags": "Configuration block with settings to ignore resource tags across all resources.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
+
+		"rate_limit": "Configuration block with settings to limit the rate of AWS API requests to each service.",
+
+		"rate_limit_adaptive": "Lower the rate of requests to a service when it throttles requests," +
+			" and raise it again gradually while requests succeed.",
+
+		"rate_limit_burst": "Maximum number of requests sent at once to a service when its rate is limited.",
+
+		"rate_limit_requests_per_second": "Maximum rate of requests per second to each service in a region." +
+			" If omitted, the rate is only limited when a service throttles requests.",
 	}
 
 	endpointServiceNames = []string{
@@ -1033,6 +1154,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
 		Insecure:                d.Get("insecure").(bool),
+		HTTPProxy:               d.Get("http_proxy").(string),
+		RateLimitAdaptive:       true,
+		RateLimitBurst:          rateLimitBurstDefault,
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -1048,6 +1172,17 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.CredsFilename = credsPath
 
+	// Set CustomCABundle, expanding home directory when given a file path
+	if v := d.Get("custom_ca_bundle").(string); v != "" && !isPEM(v) {
+		caBundlePath, err := homedir.Expand(v)
+		if err != nil {
+			return nil, err
+		}
+		config.CustomCABundle = caBundlePath
+	} else {
+		config.CustomCABundle = v
+	}
+
 	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
 	if len(assumeRoleList) == 1 {
 		assumeRole := assumeRoleList[0].(map[string]interface{})
@@ -1059,12 +1194,53 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			config.AssumeRolePolicy = v
 		}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
+		for _, policyARNRaw := range assumeRole["policy_arns"].(*schema.Set).List() {
+			config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARNRaw.(string))
+		}
+
+		config.AssumeRoleDurationSeconds = assumeRole["duration_seconds"].(int)
+
+		if v := assumeRole["tags"].(map[string]interface{}); len(v) > 0 {
+			config.AssumeRoleTags = make(map[string]string, len(v))
+			for k, vRaw := range v {
+				config.AssumeRoleTags[k] = vRaw.(string)
+			}
+		}
+
+		for _, keyRaw := range assumeRole["transitive_tag_keys"].(*schema.Set).List() {
+			config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, keyRaw.(string))
+		}
+
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, PolicyARNs: %v, DurationSeconds: %d)",
+			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy, config.AssumeRolePolicyARNs, config.AssumeRoleDurationSeconds)
 	} else {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		defaultTags := v.([]interface{})[0].(map[string]interface{})
+		config.DefaultTags = defaultTags["tags"].(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		ignoreTags := v.([]interface{})[0].(map[string]interface{})
+
+		for _, keyRaw := range ignoreTags["keys"].(*schema.Set).List() {
+			config.IgnoreTagKeys = append(config.IgnoreTagKeys, keyRaw.(string))
+		}
+
+		for _, prefixRaw := range ignoreTags["key_prefixes"].(*schema.Set).List() {
+			config.IgnoreTagKeyPrefixes = append(config.IgnoreTagKeyPrefixes, prefixRaw.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		rateLimit := v.([]interface{})[0].(map[string]interface{})
+		config.RateLimitAdaptive = rateLimit["adaptive"].(bool)
+		config.RateLimitBurst = rateLimit["burst"].(int)
+		config.RateLimitRequestsPerSecond = rateLimit["requests_per_second"].(float64)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1122,6 +1298,118 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateArn},
+					Set:         schema.HashString,
+					Description: descriptions["assume_role_policy_arns"],
+				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_duration_seconds"],
+				},
+
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["assume_role_tags"],
+				},
+
+				"transitive_tag_keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["assume_role_transitive_tag_keys"],
+				},
+			},
+		},
+	}
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+	}
+}
+
+// rateLimitBurstDefault is the default maximum number of requests sent at
+// once to a service when its rate is limited.
+const rateLimitBurstDefault = 10
+
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["rate_limit"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"adaptive": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: descriptions["rate_limit_adaptive"],
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      rateLimitBurstDefault,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["rate_limit_burst"],
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatBetween(0, math.MaxFloat64),
+					Description:  descriptions["rate_limit_requests_per_second"],
+				},
 			},
 		},
 	}
@@ -11,10 +11,12 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-template/template"
 	"github.com/terraform-providers/terraform-provider-tls/tls"
 )
@@ -448,6 +450,88 @@ func TestAccAWSProvider_Endpoints_Deprecated(t *testing.T) {
 	})
 }
 
+func TestAccAWSProvider_DefaultTags(t *testing.T) {
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTags("providervalue1", "resourcevalue1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey", "resourcevalue1"),
+				),
+			},
+			{
+				Config: testAccAWSProviderConfigDefaultTags("providervalue2", "resourcevalue2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey", "resourcevalue2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey", "providervalue2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey", "resourcevalue2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_IgnoreTags(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigIgnoreTags(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckAWSProviderAddVpcTags(&vpc, map[string]interface{}{
+						"ignorekey1":         "value",
+						"ignoreprefix1/test": "value",
+						"notignoredkey":      "value",
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSProviderConfigIgnoreTags(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "terraform-testacc-provider-ignore-tags"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_RateLimit(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigRateLimit(5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderRateLimit(&providers, "ec2", 5),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSProvider_Region_AwsChina(t *testing.T) {
 	var providers []*schema.Provider
 
@@ -685,6 +769,100 @@ func testAccCheckAWSProviderPartition(providers *[]*schema.Provider, expectedPar
 	}
 }
 
+func testAccCheckAWSProviderRateLimit(providers *[]*schema.Provider, service string, expectedRate float64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		for _, provider := range *providers {
+			if provider == nil || provider.Meta() == nil || provider.Meta().(*AWSClient) == nil {
+				continue
+			}
+
+			providerClient := provider.Meta().(*AWSClient)
+
+			if rate := providerClient.rateLimiter.Rate(service, providerClient.region); rate != expectedRate {
+				return fmt.Errorf("expected %s rate limit (%f), got: %f", service, expectedRate, rate)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSProviderAddVpcTags(vpc *ec2.Vpc, tags map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: []*string{vpc.VpcId},
+			Tags:      keyvaluetags.New(tags).IgnoreAws().Ec2Tags(),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSProviderConfigDefaultTags(providerValue, resourceValue string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      providerkey = %[1]q
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    resourcekey = %[2]q
+  }
+}
+`, providerValue, resourceValue)
+}
+
+func testAccAWSProviderConfigIgnoreTags() string {
+	return `
+provider "aws" {
+  ignore_tags {
+    keys         = ["ignorekey1"]
+    key_prefixes = ["ignoreprefix1/"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-provider-ignore-tags"
+  }
+}
+`
+}
+
+func testAccAWSProviderConfigRateLimit(requestsPerSecond float64) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+
+  rate_limit {
+    requests_per_second = %[1]f
+  }
+}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:aws:s3:::test"
+}
+`, requestsPerSecond)
+}
+
 func testAccAWSProviderConfigEndpoints(endpoints string) string {
 	return fmt.Sprintf(`
 provider "aws" {
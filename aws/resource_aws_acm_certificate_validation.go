@@ -18,6 +18,13 @@ func resourceAwsAcmCertificateValidation() *schema.Resource {
 		Create: resourceAwsAcmCertificateValidationCreate,
 		Read:   resourceAwsAcmCertificateValidationRead,
 		Delete: resourceAwsAcmCertificateValidationDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The ID is set to the issuance time of the certificate during read
+				d.Set("certificate_arn", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"certificate_arn": {
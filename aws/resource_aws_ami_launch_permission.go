@@ -17,6 +17,20 @@ func resourceAwsAmiLaunchPermission() *schema.Resource {
 		Create: resourceAwsAmiLaunchPermissionCreate,
 		Read:   resourceAwsAmiLaunchPermissionRead,
 		Delete: resourceAwsAmiLaunchPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected ACCOUNT-ID/IMAGE-ID", d.Id())
+				}
+				accountID := idParts[0]
+				imageID := idParts[1]
+				d.Set("account_id", accountID)
+				d.Set("image_id", imageID)
+				d.SetId(fmt.Sprintf("%s-%s", imageID, accountID))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"image_id": {
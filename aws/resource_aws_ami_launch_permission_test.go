@@ -26,6 +26,12 @@ func TestAccAWSAMILaunchPermission_Basic(t *testing.T) {
 					testAccCheckAWSAMILaunchPermissionExists(resourceName),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAMILaunchPermissionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -147,6 +153,17 @@ func testAccCheckAWSAMILaunchPermissionExists(resourceName string) resource.Test
 	}
 }
 
+func testAccAWSAMILaunchPermissionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["account_id"], rs.Primary.Attributes["image_id"]), nil
+	}
+}
+
 func testAccCheckAWSAMILaunchPermissionDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ami_launch_permission" {
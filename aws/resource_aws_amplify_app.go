@@ -0,0 +1,578 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsAmplifyApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAmplifyAppCreate,
+		Read:   resourceAwsAmplifyAppRead,
+		Update: resourceAwsAmplifyAppUpdate,
+		Delete: resourceAwsAmplifyAppDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"access_token": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_branch_creation_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"basic_auth_credentials": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(0, 2000),
+						},
+						"build_spec": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 25000),
+						},
+						"enable_auto_build": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"enable_basic_auth": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"environment_variables": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"framework": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"stage": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								amplify.StageBeta,
+								amplify.StageDevelopment,
+								amplify.StageExperimental,
+								amplify.StageProduction,
+							}, false),
+						},
+					},
+				},
+			},
+			"auto_branch_creation_patterns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+				Set: schema.HashString,
+			},
+			"basic_auth_credentials": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 2000),
+			},
+			"build_spec": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 25000),
+			},
+			"custom_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"200",
+								"301",
+								"302",
+								"404",
+								"404-200",
+							}, false),
+						},
+						"target": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+			"default_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"enable_auto_branch_creation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_basic_auth": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_branch_auto_build": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"environment_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"iam_service_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"oauth_token": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  amplify.PlatformWeb,
+				ValidateFunc: validation.StringInSlice([]string{
+					amplify.PlatformWeb,
+				}, false),
+			},
+			"production_branch": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_deploy_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thumbnail_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"repository": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsAmplifyAppCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	input := &amplify.CreateAppInput{
+		EnableAutoBranchCreation: aws.Bool(d.Get("enable_auto_branch_creation").(bool)),
+		EnableBasicAuth:          aws.Bool(d.Get("enable_basic_auth").(bool)),
+		EnableBranchAutoBuild:    aws.Bool(d.Get("enable_branch_auto_build").(bool)),
+		Name:                     aws.String(d.Get("name").(string)),
+		Platform:                 aws.String(d.Get("platform").(string)),
+	}
+
+	if v, ok := d.GetOk("access_token"); ok {
+		input.AccessToken = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_branch_creation_config"); ok {
+		input.AutoBranchCreationConfig = expandAmplifyAutoBranchCreationConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("auto_branch_creation_patterns"); ok {
+		input.AutoBranchCreationPatterns = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("basic_auth_credentials"); ok {
+		input.BasicAuthCredentials = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("build_spec"); ok {
+		input.BuildSpec = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_rule"); ok {
+		input.CustomRules = expandAmplifyCustomRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("environment_variables"); ok {
+		input.EnvironmentVariables = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("iam_service_role_arn"); ok {
+		input.IamServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("oauth_token"); ok {
+		input.OauthToken = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("repository"); ok {
+		input.Repository = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().AmplifyTags()
+	}
+
+	log.Printf("[DEBUG] Creating Amplify App: %s", input)
+	output, err := conn.CreateApp(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Amplify App (%s): %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.App.AppId))
+
+	return resourceAwsAmplifyAppRead(d, meta)
+}
+
+func resourceAwsAmplifyAppRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	output, err := conn.GetApp(&amplify.GetAppInput{
+		AppId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Amplify App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Amplify App (%s): %s", d.Id(), err)
+	}
+
+	app := output.App
+
+	d.Set("arn", app.AppArn)
+	if err := d.Set("auto_branch_creation_config", flattenAmplifyAutoBranchCreationConfig(app.AutoBranchCreationConfig)); err != nil {
+		return fmt.Errorf("error setting auto_branch_creation_config: %s", err)
+	}
+	if err := d.Set("auto_branch_creation_patterns", flattenStringSet(app.AutoBranchCreationPatterns)); err != nil {
+		return fmt.Errorf("error setting auto_branch_creation_patterns: %s", err)
+	}
+	d.Set("basic_auth_credentials", app.BasicAuthCredentials)
+	d.Set("build_spec", app.BuildSpec)
+	if err := d.Set("custom_rule", flattenAmplifyCustomRules(app.CustomRules)); err != nil {
+		return fmt.Errorf("error setting custom_rule: %s", err)
+	}
+	d.Set("default_domain", app.DefaultDomain)
+	d.Set("description", app.Description)
+	d.Set("enable_auto_branch_creation", app.EnableAutoBranchCreation)
+	d.Set("enable_basic_auth", app.EnableBasicAuth)
+	d.Set("enable_branch_auto_build", app.EnableBranchAutoBuild)
+	if err := d.Set("environment_variables", pointersMapToStringList(app.EnvironmentVariables)); err != nil {
+		return fmt.Errorf("error setting environment_variables: %s", err)
+	}
+	d.Set("iam_service_role_arn", app.IamServiceRoleArn)
+	d.Set("name", app.Name)
+	d.Set("platform", app.Platform)
+	if err := d.Set("production_branch", flattenAmplifyProductionBranch(app.ProductionBranch)); err != nil {
+		return fmt.Errorf("error setting production_branch: %s", err)
+	}
+	d.Set("repository", app.Repository)
+
+	if err := setTagsAll(d, meta, keyvaluetags.AmplifyKeyValueTags(app.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAmplifyAppUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	if d.HasChange("auto_branch_creation_config") || d.HasChange("auto_branch_creation_patterns") || d.HasChange("basic_auth_credentials") ||
+		d.HasChange("build_spec") || d.HasChange("custom_rule") || d.HasChange("description") || d.HasChange("enable_auto_branch_creation") ||
+		d.HasChange("enable_basic_auth") || d.HasChange("enable_branch_auto_build") || d.HasChange("environment_variables") ||
+		d.HasChange("iam_service_role_arn") || d.HasChange("name") || d.HasChange("platform") {
+		input := &amplify.UpdateAppInput{
+			AppId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("auto_branch_creation_config") {
+			input.AutoBranchCreationConfig = expandAmplifyAutoBranchCreationConfig(d.Get("auto_branch_creation_config").([]interface{}))
+		}
+
+		if d.HasChange("auto_branch_creation_patterns") {
+			input.AutoBranchCreationPatterns = expandStringSet(d.Get("auto_branch_creation_patterns").(*schema.Set))
+		}
+
+		if d.HasChange("basic_auth_credentials") {
+			input.BasicAuthCredentials = aws.String(d.Get("basic_auth_credentials").(string))
+		}
+
+		if d.HasChange("build_spec") {
+			input.BuildSpec = aws.String(d.Get("build_spec").(string))
+		}
+
+		if d.HasChange("custom_rule") {
+			// An empty list removes all custom rules.
+			input.CustomRules = expandAmplifyCustomRules(d.Get("custom_rule").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("enable_auto_branch_creation") {
+			input.EnableAutoBranchCreation = aws.Bool(d.Get("enable_auto_branch_creation").(bool))
+		}
+
+		if d.HasChange("enable_basic_auth") {
+			input.EnableBasicAuth = aws.Bool(d.Get("enable_basic_auth").(bool))
+		}
+
+		if d.HasChange("enable_branch_auto_build") {
+			input.EnableBranchAutoBuild = aws.Bool(d.Get("enable_branch_auto_build").(bool))
+		}
+
+		if d.HasChange("environment_variables") {
+			input.EnvironmentVariables = stringMapToPointers(d.Get("environment_variables").(map[string]interface{}))
+		}
+
+		if d.HasChange("iam_service_role_arn") {
+			input.IamServiceRoleArn = aws.String(d.Get("iam_service_role_arn").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("platform") {
+			input.Platform = aws.String(d.Get("platform").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Amplify App: %s", input)
+		_, err := conn.UpdateApp(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Amplify App (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.AmplifyUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Amplify App (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAmplifyAppRead(d, meta)
+}
+
+func resourceAwsAmplifyAppDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	log.Printf("[DEBUG] Deleting Amplify App (%s)", d.Id())
+	_, err := conn.DeleteApp(&amplify.DeleteAppInput{
+		AppId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Amplify App (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAmplifyAutoBranchCreationConfig(l []interface{}) *amplify.AutoBranchCreationConfig {
+	if len(l) == 0 || l[0] == nil {
+		return &amplify.AutoBranchCreationConfig{}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &amplify.AutoBranchCreationConfig{
+		EnableAutoBuild: aws.Bool(m["enable_auto_build"].(bool)),
+		EnableBasicAuth: aws.Bool(m["enable_basic_auth"].(bool)),
+	}
+
+	if v, ok := m["basic_auth_credentials"].(string); ok && v != "" {
+		config.BasicAuthCredentials = aws.String(v)
+	}
+
+	if v, ok := m["build_spec"].(string); ok && v != "" {
+		config.BuildSpec = aws.String(v)
+	}
+
+	if v, ok := m["environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		config.EnvironmentVariables = stringMapToPointers(v)
+	}
+
+	if v, ok := m["framework"].(string); ok && v != "" {
+		config.Framework = aws.String(v)
+	}
+
+	if v, ok := m["stage"].(string); ok && v != "" {
+		config.Stage = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenAmplifyAutoBranchCreationConfig(config *amplify.AutoBranchCreationConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"basic_auth_credentials": aws.StringValue(config.BasicAuthCredentials),
+		"build_spec":             aws.StringValue(config.BuildSpec),
+		"enable_auto_build":      aws.BoolValue(config.EnableAutoBuild),
+		"enable_basic_auth":      aws.BoolValue(config.EnableBasicAuth),
+		"environment_variables":  pointersMapToStringList(config.EnvironmentVariables),
+		"framework":              aws.StringValue(config.Framework),
+		"stage":                  aws.StringValue(config.Stage),
+	}
+
+	return []interface{}{m}
+}
+
+func expandAmplifyCustomRules(l []interface{}) []*amplify.CustomRule {
+	rules := make([]*amplify.CustomRule, 0, len(l))
+
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := &amplify.CustomRule{
+			Source: aws.String(m["source"].(string)),
+			Target: aws.String(m["target"].(string)),
+		}
+
+		if v, ok := m["condition"].(string); ok && v != "" {
+			rule.Condition = aws.String(v)
+		}
+
+		if v, ok := m["status"].(string); ok && v != "" {
+			rule.Status = aws.String(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func flattenAmplifyCustomRules(rules []*amplify.CustomRule) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"condition": aws.StringValue(rule.Condition),
+			"source":    aws.StringValue(rule.Source),
+			"status":    aws.StringValue(rule.Status),
+			"target":    aws.StringValue(rule.Target),
+		})
+	}
+
+	return l
+}
+
+func flattenAmplifyProductionBranch(branch *amplify.ProductionBranch) []interface{} {
+	if branch == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"branch_name":      aws.StringValue(branch.BranchName),
+		"last_deploy_time": "",
+		"status":           aws.StringValue(branch.Status),
+		"thumbnail_url":    aws.StringValue(branch.ThumbnailUrl),
+	}
+
+	if branch.LastDeployTime != nil {
+		m["last_deploy_time"] = aws.TimeValue(branch.LastDeployTime).Format(time.RFC3339)
+	}
+
+	return []interface{}{m}
+}
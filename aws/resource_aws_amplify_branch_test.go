@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAmplifyBranch_basic(t *testing.T) {
+	var v amplify.Branch
+	resourceName := "aws_amplify_branch.test"
+	appResourceName := "aws_amplify_app.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyBranchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyBranchConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", appResourceName, "id"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "amplify", regexp.MustCompile(`apps/.+/branches/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "basic_auth_credentials", ""),
+					resource.TestCheckResourceAttr(resourceName, "branch_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_build", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_basic_auth", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_notification", "false"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "framework", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyBranch_BasicAuthCredentials(t *testing.T) {
+	var v amplify.Branch
+	resourceName := "aws_amplify_branch.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyBranchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyBranchConfig_basicAuthCredentials(rName, "username1:password1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "basic_auth_credentials"),
+					resource.TestCheckResourceAttr(resourceName, "enable_basic_auth", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAmplifyBranchConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_basic_auth", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyBranch_EnvironmentVariables(t *testing.T) {
+	var v amplify.Branch
+	resourceName := "aws_amplify_branch.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyBranchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyBranchConfig_environmentVariables(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.ENVVAR1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAmplifyBranchConfig_environmentVariables(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyBranchExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables.ENVVAR1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAmplifyBranchDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_amplify_branch" {
+			continue
+		}
+
+		appID, branchName, err := decodeAmplifyBranchID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetBranch(&amplify.GetBranchInput{
+			AppId:      aws.String(appID),
+			BranchName: aws.String(branchName),
+		})
+		if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Amplify Branch %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAmplifyBranchExists(n string, v *amplify.Branch) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Amplify Branch ID is set")
+		}
+
+		appID, branchName, err := decodeAmplifyBranchID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+		resp, err := conn.GetBranch(&amplify.GetBranchInput{
+			AppId:      aws.String(appID),
+			BranchName: aws.String(branchName),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp.Branch
+
+		return nil
+	}
+}
+
+func testAccAWSAmplifyBranchConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAmplifyBranchConfig_basic(rName string) string {
+	return testAccAWSAmplifyBranchConfig_base(rName) + fmt.Sprintf(`
+resource "aws_amplify_branch" "test" {
+  app_id      = "${aws_amplify_app.test.id}"
+  branch_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAmplifyBranchConfig_basicAuthCredentials(rName, basicAuthCredentials string) string {
+	return testAccAWSAmplifyBranchConfig_base(rName) + fmt.Sprintf(`
+resource "aws_amplify_branch" "test" {
+  app_id      = "${aws_amplify_app.test.id}"
+  branch_name = %[1]q
+
+  basic_auth_credentials = "${base64encode(%[2]q)}"
+  enable_basic_auth      = true
+}
+`, rName, basicAuthCredentials)
+}
+
+func testAccAWSAmplifyBranchConfig_environmentVariables(rName, value string) string {
+	return testAccAWSAmplifyBranchConfig_base(rName) + fmt.Sprintf(`
+resource "aws_amplify_branch" "test" {
+  app_id      = "${aws_amplify_app.test.id}"
+  branch_name = %[1]q
+
+  environment_variables = {
+    ENVVAR1 = %[2]q
+  }
+}
+`, rName, value)
+}
+
+func TestDecodeAmplifyBranchID(t *testing.T) {
+	testCases := []struct {
+		Input              string
+		ExpectedAppID      string
+		ExpectedBranchName string
+		ExpectError        bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "d2ew6tz7xwcitp",
+			ExpectError: true,
+		},
+		{
+			Input:       "d2ew6tz7xwcitp/",
+			ExpectError: true,
+		},
+		{
+			Input:              "d2ew6tz7xwcitp/master",
+			ExpectedAppID:      "d2ew6tz7xwcitp",
+			ExpectedBranchName: "master",
+		},
+		{
+			Input:              "d2ew6tz7xwcitp/feature/login",
+			ExpectedAppID:      "d2ew6tz7xwcitp",
+			ExpectedBranchName: "feature/login",
+		},
+	}
+
+	for _, tc := range testCases {
+		appID, branchName, err := decodeAmplifyBranchID(tc.Input)
+
+		if tc.ExpectError && err == nil {
+			t.Fatalf("expected error for input %q", tc.Input)
+		}
+
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("unexpected error for input %q: %s", tc.Input, err)
+		}
+
+		if appID != tc.ExpectedAppID || branchName != tc.ExpectedBranchName {
+			t.Fatalf("input %q: expected %q/%q, got %q/%q", tc.Input, tc.ExpectedAppID, tc.ExpectedBranchName, appID, branchName)
+		}
+	}
+}
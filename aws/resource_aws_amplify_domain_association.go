@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/amplify/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/amplify/waiter"
+)
+
+func resourceAwsAmplifyDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAmplifyDomainAssociationCreate,
+		Read:   resourceAwsAmplifyDomainAssociationRead,
+		Update: resourceAwsAmplifyDomainAssociationUpdate,
+		Delete: resourceAwsAmplifyDomainAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_verification", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.DomainAssociationVerifiedTimeout),
+			Update: schema.DefaultTimeout(waiter.DomainAssociationVerifiedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_verification_dns_record": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"enable_auto_sub_domain": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"sub_domain": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 255,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"dns_record": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"verified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+				Set: resourceAwsAmplifyDomainAssociationSubDomainHash,
+			},
+			"wait_for_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsAmplifyDomainAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID := d.Get("app_id").(string)
+	domainName := d.Get("domain_name").(string)
+
+	input := &amplify.CreateDomainAssociationInput{
+		AppId:               aws.String(appID),
+		DomainName:          aws.String(domainName),
+		EnableAutoSubDomain: aws.Bool(d.Get("enable_auto_sub_domain").(bool)),
+		SubDomainSettings:   expandAmplifySubDomainSettings(d.Get("sub_domain").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating Amplify Domain Association: %s", input)
+	_, err := conn.CreateDomainAssociation(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Amplify Domain Association (%s/%s): %s", appID, domainName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", appID, domainName))
+
+	if _, err := waiter.DomainAssociationCreated(conn, appID, domainName, waiter.DomainAssociationCreatedTimeout); err != nil {
+		return fmt.Errorf("error waiting for Amplify Domain Association (%s) to create: %s", d.Id(), err)
+	}
+
+	if d.Get("wait_for_verification").(bool) {
+		if _, err := waiter.DomainAssociationVerified(conn, appID, domainName, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Amplify Domain Association (%s) to verify: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAmplifyDomainAssociationRead(d, meta)
+}
+
+func resourceAwsAmplifyDomainAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, domainName, err := decodeAmplifyDomainAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	domainAssociation, err := finder.DomainAssociationByAppIDAndDomainName(conn, appID, domainName)
+
+	if isResourceNotFoundError(err) {
+		log.Printf("[WARN] Amplify Domain Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Amplify Domain Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_id", appID)
+	d.Set("arn", domainAssociation.DomainAssociationArn)
+	d.Set("certificate_verification_dns_record", domainAssociation.CertificateVerificationDNSRecord)
+	d.Set("domain_name", domainAssociation.DomainName)
+	d.Set("enable_auto_sub_domain", domainAssociation.EnableAutoSubDomain)
+	if err := d.Set("sub_domain", flattenAmplifySubDomains(domainAssociation.SubDomains)); err != nil {
+		return fmt.Errorf("error setting sub_domain: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAmplifyDomainAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, domainName, err := decodeAmplifyDomainAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("enable_auto_sub_domain") || d.HasChange("sub_domain") {
+		input := &amplify.UpdateDomainAssociationInput{
+			AppId:      aws.String(appID),
+			DomainName: aws.String(domainName),
+			// The full set of sub-domain settings is always required.
+			SubDomainSettings: expandAmplifySubDomainSettings(d.Get("sub_domain").(*schema.Set).List()),
+		}
+
+		if d.HasChange("enable_auto_sub_domain") {
+			input.EnableAutoSubDomain = aws.Bool(d.Get("enable_auto_sub_domain").(bool))
+		}
+
+		log.Printf("[DEBUG] Updating Amplify Domain Association: %s", input)
+		_, err := conn.UpdateDomainAssociation(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Amplify Domain Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("wait_for_verification").(bool) {
+		if _, err := waiter.DomainAssociationVerified(conn, appID, domainName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Amplify Domain Association (%s) to verify: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAmplifyDomainAssociationRead(d, meta)
+}
+
+func resourceAwsAmplifyDomainAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, domainName, err := decodeAmplifyDomainAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Amplify Domain Association (%s)", d.Id())
+	_, err = conn.DeleteDomainAssociation(&amplify.DeleteDomainAssociationInput{
+		AppId:      aws.String(appID),
+		DomainName: aws.String(domainName),
+	})
+
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Amplify Domain Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAmplifyDomainAssociationID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format AppID/DomainName, received: %s", id)
+	}
+	return idParts[0], idParts[1], nil
+}
+
+func expandAmplifySubDomainSettings(l []interface{}) []*amplify.SubDomainSetting {
+	settings := make([]*amplify.SubDomainSetting, 0, len(l))
+
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		settings = append(settings, &amplify.SubDomainSetting{
+			BranchName: aws.String(m["branch_name"].(string)),
+			Prefix:     aws.String(m["prefix"].(string)),
+		})
+	}
+
+	return settings
+}
+
+func flattenAmplifySubDomains(subDomains []*amplify.SubDomain) *schema.Set {
+	s := schema.NewSet(resourceAwsAmplifyDomainAssociationSubDomainHash, []interface{}{})
+
+	for _, subDomain := range subDomains {
+		if subDomain == nil || subDomain.SubDomainSetting == nil {
+			continue
+		}
+
+		s.Add(map[string]interface{}{
+			"branch_name": aws.StringValue(subDomain.SubDomainSetting.BranchName),
+			"dns_record":  aws.StringValue(subDomain.DnsRecord),
+			"prefix":      aws.StringValue(subDomain.SubDomainSetting.Prefix),
+			"verified":    aws.BoolValue(subDomain.Verified),
+		})
+	}
+
+	return s
+}
+
+func resourceAwsAmplifyDomainAssociationSubDomainHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["branch_name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["prefix"].(string)))
+	return hashcode.String(buf.String())
+}
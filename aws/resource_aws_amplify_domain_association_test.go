@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/amplify/finder"
+)
+
+func TestAccAWSAmplifyDomainAssociation_basic(t *testing.T) {
+	domainName := os.Getenv("AMPLIFY_DOMAIN_NAME")
+	if domainName == "" {
+		t.Skip("Environment variable AMPLIFY_DOMAIN_NAME is not set")
+	}
+
+	var v amplify.DomainAssociation
+	resourceName := "aws_amplify_domain_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyDomainAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyDomainAssociationConfig_basic(rName, domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyDomainAssociationExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "amplify", regexp.MustCompile(`apps/.+/domains/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_sub_domain", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sub_domain.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_verification", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_verification"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyDomainAssociation_update(t *testing.T) {
+	domainName := os.Getenv("AMPLIFY_DOMAIN_NAME")
+	if domainName == "" {
+		t.Skip("Environment variable AMPLIFY_DOMAIN_NAME is not set")
+	}
+
+	var v amplify.DomainAssociation
+	resourceName := "aws_amplify_domain_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyDomainAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyDomainAssociationConfig_basic(rName, domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyDomainAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "sub_domain.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_verification", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_verification"},
+			},
+			{
+				Config: testAccAWSAmplifyDomainAssociationConfig_updated(rName, domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyDomainAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_sub_domain", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sub_domain.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAmplifyDomainAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_amplify_domain_association" {
+			continue
+		}
+
+		appID, domainName, err := decodeAmplifyDomainAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.DomainAssociationByAppIDAndDomainName(conn, appID, domainName)
+		if isResourceNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Amplify Domain Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAmplifyDomainAssociationExists(n string, v *amplify.DomainAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Amplify Domain Association ID is set")
+		}
+
+		appID, domainName, err := decodeAmplifyDomainAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+		domainAssociation, err := finder.DomainAssociationByAppIDAndDomainName(conn, appID, domainName)
+		if err != nil {
+			return err
+		}
+
+		*v = *domainAssociation
+
+		return nil
+	}
+}
+
+func testAccAWSAmplifyDomainAssociationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id      = "${aws_amplify_app.test.id}"
+  branch_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAmplifyDomainAssociationConfig_basic(rName, domainName string, waitForVerification bool) string {
+	return testAccAWSAmplifyDomainAssociationConfig_base(rName) + fmt.Sprintf(`
+resource "aws_amplify_domain_association" "test" {
+  app_id      = "${aws_amplify_app.test.id}"
+  domain_name = %[1]q
+
+  sub_domain {
+    branch_name = "${aws_amplify_branch.test.branch_name}"
+    prefix      = ""
+  }
+
+  wait_for_verification = %[2]t
+}
+`, domainName, waitForVerification)
+}
+
+func testAccAWSAmplifyDomainAssociationConfig_updated(rName, domainName string, waitForVerification bool) string {
+	return testAccAWSAmplifyDomainAssociationConfig_base(rName) + fmt.Sprintf(`
+resource "aws_amplify_domain_association" "test" {
+  app_id      = "${aws_amplify_app.test.id}"
+  domain_name = %[1]q
+
+  enable_auto_sub_domain = true
+
+  sub_domain {
+    branch_name = "${aws_amplify_branch.test.branch_name}"
+    prefix      = ""
+  }
+
+  sub_domain {
+    branch_name = "${aws_amplify_branch.test.branch_name}"
+    prefix      = "www"
+  }
+
+  wait_for_verification = %[2]t
+}
+`, domainName, waitForVerification)
+}
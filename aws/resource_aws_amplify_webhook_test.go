@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAmplifyWebhook_basic(t *testing.T) {
+	var v amplify.Webhook
+	resourceName := "aws_amplify_webhook.test"
+	appResourceName := "aws_amplify_app.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyWebhookConfig_basic(rName, "testdescription1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyWebhookExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", appResourceName, "id"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "amplify", regexp.MustCompile(`apps/.+/webhooks/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "branch_name", fmt.Sprintf("%s-1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "testdescription1"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(`^https://webhooks.amplify.`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAmplifyWebhookConfig_updated(rName, "testdescription2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyWebhookExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "branch_name", fmt.Sprintf("%s-2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "testdescription2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAmplifyWebhookDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_amplify_webhook" {
+			continue
+		}
+
+		_, err := conn.GetWebhook(&amplify.GetWebhookInput{
+			WebhookId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Amplify Webhook %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAmplifyWebhookExists(n string, v *amplify.Webhook) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Amplify Webhook ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+		resp, err := conn.GetWebhook(&amplify.GetWebhookInput{
+			WebhookId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp.Webhook
+
+		return nil
+	}
+}
+
+func testAccAWSAmplifyWebhookConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test1" {
+  app_id      = "${aws_amplify_app.test.id}"
+  branch_name = "%[1]s-1"
+}
+
+resource "aws_amplify_branch" "test2" {
+  app_id      = "${aws_amplify_app.test.id}"
+  branch_name = "%[1]s-2"
+}
+`, rName)
+}
+
+func testAccAWSAmplifyWebhookConfig_basic(rName, description string) string {
+	return testAccAWSAmplifyWebhookConfig_base(rName) + fmt.Sprintf(`
+resource "aws_amplify_webhook" "test" {
+  app_id      = "${aws_amplify_app.test.id}"
+  branch_name = "${aws_amplify_branch.test1.branch_name}"
+  description = %[1]q
+}
+`, description)
+}
+
+func testAccAWSAmplifyWebhookConfig_updated(rName, description string) string {
+	return testAccAWSAmplifyWebhookConfig_base(rName) + fmt.Sprintf(`
+resource "aws_amplify_webhook" "test" {
+  app_id      = "${aws_amplify_app.test.id}"
+  branch_name = "${aws_amplify_branch.test2.branch_name}"
+  description = %[1]q
+}
+`, description)
+}
@@ -49,6 +49,12 @@ func TestAccAWSAPIGatewayAuthorizer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_authorizer.acctest", "identity_validation_expression", ""),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_authorizer.acctest",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayAuthorizerImportStateIdFunc("aws_api_gateway_authorizer.acctest"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSAPIGatewayAuthorizerConfig_lambdaUpdate(apiGatewayName, authorizerName, lambdaName),
 				Check: resource.ComposeTestCheckFunc(
@@ -73,6 +79,17 @@ func TestAccAWSAPIGatewayAuthorizer_basic(t *testing.T) {
 	})
 }
 
+func testAccAWSAPIGatewayAuthorizerImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.ID), nil
+	}
+}
+
 func TestAccAWSAPIGatewayAuthorizer_cognito(t *testing.T) {
 	rString := acctest.RandString(7)
 	apiGatewayName := "tf-acctest-apigw-" + rString
@@ -37,10 +37,28 @@ func TestAccAWSAPIGatewayDeployment_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "variables.%"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSAPIGatewayDeploymentImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"execution_arn", "invoke_url", "stage_name"},
+			},
 		},
 	})
 }
 
+func testAccAWSAPIGatewayDeploymentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.ID), nil
+	}
+}
+
 func TestAccAWSAPIGatewayDeployment_createBeforeDestoryUpdate(t *testing.T) {
 	var deployment apigateway.Deployment
 	var stage apigateway.Stage
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -16,6 +17,23 @@ func resourceAwsApiGatewayMethodSettings() *schema.Resource {
 		Read:   resourceAwsApiGatewayMethodSettingsRead,
 		Update: resourceAwsApiGatewayMethodSettingsUpdate,
 		Delete: resourceAwsApiGatewayMethodSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The method path can contain slashes, e.g. resource/GET or */*
+				idParts := strings.SplitN(d.Id(), "/", 3)
+				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/STAGE-NAME/METHOD-PATH", d.Id())
+				}
+				restApiID := idParts[0]
+				stageName := idParts[1]
+				methodPath := idParts[2]
+				d.Set("rest_api_id", restApiID)
+				d.Set("stage_name", stageName)
+				d.Set("method_path", methodPath)
+				d.SetId(fmt.Sprintf("%s-%s-%s", restApiID, stageName, methodPath))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -42,42 +60,52 @@ func resourceAwsApiGatewayMethodSettings() *schema.Resource {
 						"metrics_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Computed: true,
 						},
 						"logging_level": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 						},
 						"data_trace_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Computed: true,
 						},
 						"throttling_burst_limit": {
 							Type:     schema.TypeInt,
 							Optional: true,
+							Computed: true,
 						},
 						"throttling_rate_limit": {
 							Type:     schema.TypeFloat,
 							Optional: true,
+							Computed: true,
 						},
 						"caching_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Computed: true,
 						},
 						"cache_ttl_in_seconds": {
 							Type:     schema.TypeInt,
 							Optional: true,
+							Computed: true,
 						},
 						"cache_data_encrypted": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Computed: true,
 						},
 						"require_authorization_for_cache_control": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							Computed: true,
 						},
 						"unauthorized_cache_control_header_strategy": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 						},
 					},
 				},
@@ -113,20 +141,34 @@ func resourceAwsApiGatewayMethodSettingsRead(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	d.Set("settings.0.metrics_enabled", settings.MetricsEnabled)
-	d.Set("settings.0.logging_level", settings.LoggingLevel)
-	d.Set("settings.0.data_trace_enabled", settings.DataTraceEnabled)
-	d.Set("settings.0.throttling_burst_limit", settings.ThrottlingBurstLimit)
-	d.Set("settings.0.throttling_rate_limit", settings.ThrottlingRateLimit)
-	d.Set("settings.0.caching_enabled", settings.CachingEnabled)
-	d.Set("settings.0.cache_ttl_in_seconds", settings.CacheTtlInSeconds)
-	d.Set("settings.0.cache_data_encrypted", settings.CacheDataEncrypted)
-	d.Set("settings.0.require_authorization_for_cache_control", settings.RequireAuthorizationForCacheControl)
-	d.Set("settings.0.unauthorized_cache_control_header_strategy", settings.UnauthorizedCacheControlHeaderStrategy)
+	if err := d.Set("settings", flattenAwsApiGatewayMethodSettings(settings)); err != nil {
+		return fmt.Errorf("error setting settings: %s", err)
+	}
 
 	return nil
 }
 
+func flattenAwsApiGatewayMethodSettings(settings *apigateway.MethodSetting) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"cache_data_encrypted":                       aws.BoolValue(settings.CacheDataEncrypted),
+		"cache_ttl_in_seconds":                       int(aws.Int64Value(settings.CacheTtlInSeconds)),
+		"caching_enabled":                            aws.BoolValue(settings.CachingEnabled),
+		"data_trace_enabled":                         aws.BoolValue(settings.DataTraceEnabled),
+		"logging_level":                              aws.StringValue(settings.LoggingLevel),
+		"metrics_enabled":                            aws.BoolValue(settings.MetricsEnabled),
+		"require_authorization_for_cache_control":    aws.BoolValue(settings.RequireAuthorizationForCacheControl),
+		"throttling_burst_limit":                     int(aws.Int64Value(settings.ThrottlingBurstLimit)),
+		"throttling_rate_limit":                      aws.Float64Value(settings.ThrottlingRateLimit),
+		"unauthorized_cache_control_header_strategy": aws.StringValue(settings.UnauthorizedCacheControlHeaderStrategy),
+	}
+
+	return []interface{}{m}
+}
+
 func resourceAwsApiGatewayMethodSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -35,6 +35,12 @@ func TestAccAWSAPIGatewayUsagePlanKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_usage_plan_key.main", "value", ""),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_usage_plan_key.main",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayUsagePlanKeyImportStateIdFunc("aws_api_gateway_usage_plan_key.main"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSApiGatewayUsagePlanKeyBasicUpdatedConfig(updatedName),
 				Check: resource.ComposeTestCheckFunc(
@@ -63,6 +69,17 @@ func TestAccAWSAPIGatewayUsagePlanKey_basic(t *testing.T) {
 	})
 }
 
+func testAccAWSAPIGatewayUsagePlanKeyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["usage_plan_id"], rs.Primary.Attributes["key_id"]), nil
+	}
+}
+
 func testAccCheckAWSAPIGatewayUsagePlanKeyExists(n string, res *apigateway.UsagePlanKey) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
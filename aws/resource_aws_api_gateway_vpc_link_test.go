@@ -10,10 +10,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &sweep.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    testSweepAPIGatewayVpcLinks,
 	})
@@ -41,6 +42,10 @@ func testSweepAPIGatewayVpcLinks(region string) error {
 				continue
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			if err := waitForApiGatewayVpcLinkDeletion(conn, id); err != nil {
 				log.Printf("[ERROR] Error waiting for API Gateway VPC Link (%s) deletion: %s", id, err)
 			}
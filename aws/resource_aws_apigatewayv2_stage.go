@@ -0,0 +1,492 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsApiGatewayV2Stage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2StageCreate,
+		Read:   resourceAwsApiGatewayV2StageRead,
+		Update: resourceAwsApiGatewayV2StageUpdate,
+		Delete: resourceAwsApiGatewayV2StageDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected API-ID/STAGE-NAME", d.Id())
+				}
+				d.Set("api_id", idParts[0])
+				d.SetId(idParts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			setTagsDiff,
+			// The API does not support removing route settings from a stage.
+			customdiff.ForceNewIf("route_settings", func(d *schema.ResourceDiff, meta interface{}) bool {
+				o, n := d.GetChange("route_settings")
+				return len(apiGatewayV2StageRouteKeys(o.(*schema.Set)).Difference(apiGatewayV2StageRouteKeys(n.(*schema.Set))).List()) > 0
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"access_log_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 0,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_deploy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"client_certificate_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_route_settings": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MinItems:         0,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: apiGatewayV2StageRouteSettingsSchema(false),
+				},
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"execution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invoke_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"route_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: apiGatewayV2StageRouteSettingsSchema(true),
+				},
+				Set: resourceAwsApiGatewayV2StageRouteSettingsHash,
+			},
+			"stage_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func apiGatewayV2StageRouteSettingsSchema(routeKey bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"data_trace_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"detailed_metrics_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"logging_level": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				apigatewayv2.LoggingLevelError,
+				apigatewayv2.LoggingLevelFalse,
+				apigatewayv2.LoggingLevelInfo,
+			}, false),
+		},
+		"throttling_burst_limit": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"throttling_rate_limit": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+	}
+
+	if routeKey {
+		s["route_key"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		}
+	}
+
+	return s
+}
+
+func resourceAwsApiGatewayV2StageCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	req := &apigatewayv2.CreateStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Get("name").(string)),
+		Tags:      keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().Apigatewayv2Tags(),
+	}
+	if v, ok := d.GetOk("access_log_settings"); ok {
+		req.AccessLogSettings = expandApiGatewayV2AccessLogSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("auto_deploy"); ok {
+		req.AutoDeploy = aws.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("client_certificate_id"); ok {
+		req.ClientCertificateId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_route_settings"); ok {
+		req.DefaultRouteSettings = expandApiGatewayV2DefaultRouteSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("deployment_id"); ok {
+		req.DeploymentId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("route_settings"); ok {
+		req.RouteSettings = expandApiGatewayV2RouteSettings(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("stage_variables"); ok {
+		req.StageVariables = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 stage: %s", req)
+	resp, err := conn.CreateStage(req)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 stage: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.StageName))
+
+	return resourceAwsApiGatewayV2StageRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2StageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	apiId := d.Get("api_id").(string)
+	resp, err := conn.GetStage(&apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiId),
+		StageName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 stage (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("access_log_settings", flattenApiGatewayV2AccessLogSettings(resp.AccessLogSettings)); err != nil {
+		return fmt.Errorf("error setting access_log_settings: %s", err)
+	}
+	d.Set("arn", arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "apigateway",
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("/apis/%s/stages/%s", apiId, d.Id()),
+	}.String())
+	d.Set("auto_deploy", resp.AutoDeploy)
+	d.Set("client_certificate_id", resp.ClientCertificateId)
+	if err := d.Set("default_route_settings", flattenApiGatewayV2DefaultRouteSettings(resp.DefaultRouteSettings)); err != nil {
+		return fmt.Errorf("error setting default_route_settings: %s", err)
+	}
+	d.Set("deployment_id", resp.DeploymentId)
+	d.Set("description", resp.Description)
+	d.Set("execution_arn", arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "execute-api",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("%s/%s", apiId, d.Id()),
+	}.String())
+	d.Set("name", resp.StageName)
+	if err := d.Set("route_settings", flattenApiGatewayV2RouteSettings(resp.RouteSettings)); err != nil {
+		return fmt.Errorf("error setting route_settings: %s", err)
+	}
+	if err := d.Set("stage_variables", pointersMapToStringList(resp.StageVariables)); err != nil {
+		return fmt.Errorf("error setting stage_variables: %s", err)
+	}
+	if err := setTagsAll(d, meta, keyvaluetags.Apigatewayv2KeyValueTags(resp.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	apiResp, err := conn.GetApi(&apigatewayv2.GetApiInput{
+		ApiId: aws.String(apiId),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 API (%s): %s", apiId, err)
+	}
+
+	// The $default stage of an HTTP API is served from the base of the API endpoint.
+	if d.Id() == "$default" {
+		d.Set("invoke_url", fmt.Sprintf("%s/", aws.StringValue(apiResp.ApiEndpoint)))
+	} else {
+		d.Set("invoke_url", fmt.Sprintf("%s/%s", aws.StringValue(apiResp.ApiEndpoint), d.Id()))
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2StageUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	if d.HasChange("access_log_settings") || d.HasChange("auto_deploy") || d.HasChange("client_certificate_id") || d.HasChange("default_route_settings") ||
+		d.HasChange("deployment_id") || d.HasChange("description") || d.HasChange("route_settings") || d.HasChange("stage_variables") {
+		req := &apigatewayv2.UpdateStageInput{
+			ApiId:     aws.String(d.Get("api_id").(string)),
+			StageName: aws.String(d.Id()),
+		}
+		if d.HasChange("access_log_settings") {
+			req.AccessLogSettings = expandApiGatewayV2AccessLogSettings(d.Get("access_log_settings").([]interface{}))
+		}
+		if d.HasChange("auto_deploy") {
+			req.AutoDeploy = aws.Bool(d.Get("auto_deploy").(bool))
+		}
+		if d.HasChange("client_certificate_id") {
+			req.ClientCertificateId = aws.String(d.Get("client_certificate_id").(string))
+		}
+		if d.HasChange("default_route_settings") {
+			req.DefaultRouteSettings = expandApiGatewayV2DefaultRouteSettings(d.Get("default_route_settings").([]interface{}))
+		}
+		if d.HasChange("deployment_id") {
+			req.DeploymentId = aws.String(d.Get("deployment_id").(string))
+		}
+		if d.HasChange("description") {
+			req.Description = aws.String(d.Get("description").(string))
+		}
+		if d.HasChange("route_settings") {
+			req.RouteSettings = expandApiGatewayV2RouteSettings(d.Get("route_settings").(*schema.Set))
+		}
+		if d.HasChange("stage_variables") {
+			o, n := d.GetChange("stage_variables")
+			variables := stringMapToPointers(n.(map[string]interface{}))
+			// Variables are removed by setting them to an empty value.
+			for k := range o.(map[string]interface{}) {
+				if _, ok := variables[k]; !ok {
+					variables[k] = aws.String("")
+				}
+			}
+			req.StageVariables = variables
+		}
+
+		log.Printf("[DEBUG] Updating API Gateway v2 stage: %s", req)
+		_, err := conn.UpdateStage(req)
+		if err != nil {
+			return fmt.Errorf("error updating API Gateway v2 stage (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.Apigatewayv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating API Gateway v2 stage (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsApiGatewayV2StageRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2StageDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 stage (%s)", d.Id())
+	_, err := conn.DeleteStage(&apigatewayv2.DeleteStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandApiGatewayV2AccessLogSettings(vSettings []interface{}) *apigatewayv2.AccessLogSettings {
+	settings := &apigatewayv2.AccessLogSettings{}
+
+	// An empty structure removes the access log settings.
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return settings
+	}
+	mSettings := vSettings[0].(map[string]interface{})
+
+	if vDestinationArn, ok := mSettings["destination_arn"].(string); ok && vDestinationArn != "" {
+		settings.DestinationArn = aws.String(vDestinationArn)
+	}
+	if vFormat, ok := mSettings["format"].(string); ok && vFormat != "" {
+		settings.Format = aws.String(vFormat)
+	}
+
+	return settings
+}
+
+func flattenApiGatewayV2AccessLogSettings(settings *apigatewayv2.AccessLogSettings) []interface{} {
+	if settings == nil || settings.DestinationArn == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"destination_arn": aws.StringValue(settings.DestinationArn),
+		"format":          aws.StringValue(settings.Format),
+	}}
+}
+
+func expandApiGatewayV2DefaultRouteSettings(vSettings []interface{}) *apigatewayv2.RouteSettings {
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return &apigatewayv2.RouteSettings{
+			DataTraceEnabled:       aws.Bool(false),
+			DetailedMetricsEnabled: aws.Bool(false),
+		}
+	}
+
+	return expandApiGatewayV2RouteSetting(vSettings[0].(map[string]interface{}))
+}
+
+func flattenApiGatewayV2DefaultRouteSettings(settings *apigatewayv2.RouteSettings) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{flattenApiGatewayV2RouteSetting(settings)}
+}
+
+func expandApiGatewayV2RouteSettings(vSettings *schema.Set) map[string]*apigatewayv2.RouteSettings {
+	settings := map[string]*apigatewayv2.RouteSettings{}
+
+	for _, v := range vSettings.List() {
+		mSettings := v.(map[string]interface{})
+		settings[mSettings["route_key"].(string)] = expandApiGatewayV2RouteSetting(mSettings)
+	}
+
+	return settings
+}
+
+func flattenApiGatewayV2RouteSettings(settings map[string]*apigatewayv2.RouteSettings) *schema.Set {
+	vSettings := []interface{}{}
+
+	for routeKey, routeSettings := range settings {
+		if routeSettings == nil {
+			continue
+		}
+
+		mSettings := flattenApiGatewayV2RouteSetting(routeSettings)
+		mSettings["route_key"] = routeKey
+		vSettings = append(vSettings, mSettings)
+	}
+
+	return schema.NewSet(resourceAwsApiGatewayV2StageRouteSettingsHash, vSettings)
+}
+
+func expandApiGatewayV2RouteSetting(mSettings map[string]interface{}) *apigatewayv2.RouteSettings {
+	settings := &apigatewayv2.RouteSettings{}
+
+	if vDataTraceEnabled, ok := mSettings["data_trace_enabled"].(bool); ok {
+		settings.DataTraceEnabled = aws.Bool(vDataTraceEnabled)
+	}
+	if vDetailedMetricsEnabled, ok := mSettings["detailed_metrics_enabled"].(bool); ok {
+		settings.DetailedMetricsEnabled = aws.Bool(vDetailedMetricsEnabled)
+	}
+	if vLoggingLevel, ok := mSettings["logging_level"].(string); ok && vLoggingLevel != "" {
+		settings.LoggingLevel = aws.String(vLoggingLevel)
+	}
+	if vThrottlingBurstLimit, ok := mSettings["throttling_burst_limit"].(int); ok && vThrottlingBurstLimit > 0 {
+		settings.ThrottlingBurstLimit = aws.Int64(int64(vThrottlingBurstLimit))
+	}
+	if vThrottlingRateLimit, ok := mSettings["throttling_rate_limit"].(float64); ok && vThrottlingRateLimit > 0 {
+		settings.ThrottlingRateLimit = aws.Float64(vThrottlingRateLimit)
+	}
+
+	return settings
+}
+
+func flattenApiGatewayV2RouteSetting(settings *apigatewayv2.RouteSettings) map[string]interface{} {
+	return map[string]interface{}{
+		"data_trace_enabled":       aws.BoolValue(settings.DataTraceEnabled),
+		"detailed_metrics_enabled": aws.BoolValue(settings.DetailedMetricsEnabled),
+		"logging_level":            aws.StringValue(settings.LoggingLevel),
+		"throttling_burst_limit":   int(aws.Int64Value(settings.ThrottlingBurstLimit)),
+		"throttling_rate_limit":    aws.Float64Value(settings.ThrottlingRateLimit),
+	}
+}
+
+// resourceAwsApiGatewayV2StageRouteSettingsHash hashes route settings by their
+// route key so that changes to the other settings are updated in place.
+func resourceAwsApiGatewayV2StageRouteSettingsHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["route_key"].(string)))
+	return hashcode.String(buf.String())
+}
+
+func apiGatewayV2StageRouteKeys(vSettings *schema.Set) *schema.Set {
+	routeKeys := &schema.Set{F: schema.HashString}
+
+	for _, v := range vSettings.List() {
+		routeKeys.Add(v.(map[string]interface{})["route_key"].(string))
+	}
+
+	return routeKeys
+}
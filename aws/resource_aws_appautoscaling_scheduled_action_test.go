@@ -25,10 +25,27 @@ func TestAccAWSAppautoscalingScheduledAction_dynamo(t *testing.T) {
 					testAccCheckAwsAppautoscalingScheduledActionExists("aws_appautoscaling_scheduled_action.hoge"),
 				),
 			},
+			{
+				ResourceName:      "aws_appautoscaling_scheduled_action.hoge",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingScheduledActionImportStateIdFunc("aws_appautoscaling_scheduled_action.hoge"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccAWSAppautoscalingScheduledActionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func TestAccAWSAppautoscalingScheduledAction_ECS(t *testing.T) {
 	ts := time.Now().AddDate(0, 0, 1).Format("2006-01-02T15:04:05")
 	resource.ParallelTest(t, resource.TestCase{
@@ -0,0 +1,642 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamFleet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamFleetCreate,
+		Read:   resourceAwsAppStreamFleetRead,
+		Update: resourceAwsAppStreamFleetUpdate,
+		Delete: resourceAwsAppStreamFleetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.FleetRunningTimeout),
+			Update: schema.DefaultTimeout(waiter.FleetRunningTimeout),
+			Delete: schema.DefaultTimeout(waiter.FleetStoppedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_capacity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"desired_instances": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"in_use": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"running": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"desired_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  appstream.FleetStateRunning,
+				ValidateFunc: validation.StringInSlice([]string{
+					appstream.FleetStateRunning,
+					appstream.FleetStateStopped,
+				}, false),
+			},
+			"disconnect_timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(60, 360000),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"domain_join_info": appStreamDomainJoinInfoSchema(false),
+			"enable_default_internet_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"fleet_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					appstream.FleetTypeAlwaysOn,
+					appstream.FleetTypeOnDemand,
+				}, false),
+			},
+			"idle_disconnect_timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+			"image_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"image_name"},
+				ValidateFunc:  validateArn,
+			},
+			"image_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"image_arn"},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"max_user_duration_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(600, 360000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppStreamName(),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":       tagsSchema(),
+			"tags_all":   tagsSchemaTrulyComputed(),
+			"vpc_config": appStreamVpcConfigSchema(false),
+		},
+	}
+}
+
+func resourceAwsAppStreamFleetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	name := d.Get("name").(string)
+
+	input := &appstream.CreateFleetInput{
+		ComputeCapacity: expandAppStreamComputeCapacity(d.Get("compute_capacity").([]interface{})),
+		InstanceType:    aws.String(d.Get("instance_type").(string)),
+		Name:            aws.String(name),
+		Tags:            keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().AppstreamTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disconnect_timeout_in_seconds"); ok {
+		input.DisconnectTimeoutInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain_join_info"); ok {
+		input.DomainJoinInfo = expandAppStreamDomainJoinInfo(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOkExists("enable_default_internet_access"); ok {
+		input.EnableDefaultInternetAccess = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("fleet_type"); ok {
+		input.FleetType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idle_disconnect_timeout_in_seconds"); ok {
+		input.IdleDisconnectTimeoutInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("image_arn"); ok {
+		input.ImageArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_name"); ok {
+		input.ImageName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_user_duration_in_seconds"); ok {
+		input.MaxUserDurationInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandAppStreamVpcConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Fleet: %s", input)
+	// A newly created AppStream service role may not be usable immediately.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateFleet(input)
+
+		if isAWSErr(err, appstream.ErrCodeInvalidRoleException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.CreateFleet(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating AppStream Fleet (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if d.Get("desired_state").(string) == appstream.FleetStateRunning {
+		if err := appStreamStartFleet(conn, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsAppStreamFleetRead(d, meta)
+}
+
+func resourceAwsAppStreamFleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleet, err := finder.FleetByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Fleet (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(fleet.Arn)
+	d.Set("arn", arn)
+	d.Set("created_time", aws.TimeValue(fleet.CreatedTime).Format(time.RFC3339))
+	d.Set("description", fleet.Description)
+	d.Set("disconnect_timeout_in_seconds", fleet.DisconnectTimeoutInSeconds)
+	d.Set("display_name", fleet.DisplayName)
+	d.Set("enable_default_internet_access", fleet.EnableDefaultInternetAccess)
+	d.Set("fleet_type", fleet.FleetType)
+	d.Set("idle_disconnect_timeout_in_seconds", fleet.IdleDisconnectTimeoutInSeconds)
+	d.Set("image_arn", fleet.ImageArn)
+	d.Set("image_name", fleet.ImageName)
+	d.Set("instance_type", fleet.InstanceType)
+	d.Set("max_user_duration_in_seconds", fleet.MaxUserDurationInSeconds)
+	d.Set("name", fleet.Name)
+	d.Set("state", fleet.State)
+
+	// Only settled states are reflected so that an interrupted start or stop is retried.
+	switch state := aws.StringValue(fleet.State); state {
+	case appstream.FleetStateRunning, appstream.FleetStateStopped:
+		d.Set("desired_state", state)
+	}
+
+	if err := d.Set("compute_capacity", flattenAppStreamComputeCapacityStatus(fleet.ComputeCapacityStatus)); err != nil {
+		return fmt.Errorf("error setting compute_capacity: %s", err)
+	}
+
+	if err := d.Set("domain_join_info", flattenAppStreamDomainJoinInfo(fleet.DomainJoinInfo)); err != nil {
+		return fmt.Errorf("error setting domain_join_info: %s", err)
+	}
+
+	if err := d.Set("vpc_config", flattenAppStreamVpcConfig(fleet.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %s", err)
+	}
+
+	tags, err := keyvaluetags.AppstreamListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppStream Fleet (%s): %s", arn, err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppStreamFleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	desiredState := d.Get("desired_state").(string)
+
+	// Stop a running fleet before applying any other changes.
+	if d.HasChange("desired_state") && desiredState == appstream.FleetStateStopped {
+		if err := appStreamStopFleet(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("compute_capacity") || d.HasChange("description") || d.HasChange("disconnect_timeout_in_seconds") || d.HasChange("display_name") ||
+		d.HasChange("domain_join_info") || d.HasChange("enable_default_internet_access") || d.HasChange("idle_disconnect_timeout_in_seconds") ||
+		d.HasChange("image_arn") || d.HasChange("image_name") || d.HasChange("max_user_duration_in_seconds") || d.HasChange("vpc_config") {
+		input := &appstream.UpdateFleetInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("compute_capacity") {
+			input.ComputeCapacity = expandAppStreamComputeCapacity(d.Get("compute_capacity").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("disconnect_timeout_in_seconds") {
+			input.DisconnectTimeoutInSeconds = aws.Int64(int64(d.Get("disconnect_timeout_in_seconds").(int)))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("domain_join_info") {
+			if v := expandAppStreamDomainJoinInfo(d.Get("domain_join_info").([]interface{})); v != nil {
+				input.DomainJoinInfo = v
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.FleetAttributeDomainJoinInfo))
+			}
+		}
+
+		if d.HasChange("enable_default_internet_access") {
+			input.EnableDefaultInternetAccess = aws.Bool(d.Get("enable_default_internet_access").(bool))
+		}
+
+		if d.HasChange("idle_disconnect_timeout_in_seconds") {
+			input.IdleDisconnectTimeoutInSeconds = aws.Int64(int64(d.Get("idle_disconnect_timeout_in_seconds").(int)))
+		}
+
+		if d.HasChange("image_arn") {
+			if v, ok := d.GetOk("image_arn"); ok {
+				input.ImageArn = aws.String(v.(string))
+			}
+		}
+
+		if d.HasChange("image_name") {
+			if v, ok := d.GetOk("image_name"); ok {
+				input.ImageName = aws.String(v.(string))
+			}
+		}
+
+		if d.HasChange("max_user_duration_in_seconds") {
+			input.MaxUserDurationInSeconds = aws.Int64(int64(d.Get("max_user_duration_in_seconds").(int)))
+		}
+
+		if d.HasChange("vpc_config") {
+			if v := expandAppStreamVpcConfig(d.Get("vpc_config").([]interface{})); v != nil {
+				input.VpcConfig = v
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.FleetAttributeVpcConfiguration))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating AppStream Fleet: %s", input)
+		if _, err := conn.UpdateFleet(input); err != nil {
+			return fmt.Errorf("error updating AppStream Fleet (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.AppstreamUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppStream Fleet (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("desired_state") && desiredState == appstream.FleetStateRunning {
+		if err := appStreamStartFleet(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsAppStreamFleetRead(d, meta)
+}
+
+func resourceAwsAppStreamFleetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleet, err := finder.FleetByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Fleet (%s): %s", d.Id(), err)
+	}
+
+	// A fleet must be stopped before it can be deleted.
+	if aws.StringValue(fleet.State) != appstream.FleetStateStopped {
+		if err := appStreamStopFleet(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream Fleet: %s", d.Id())
+	_, err = conn.DeleteFleet(&appstream.DeleteFleetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream Fleet (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func appStreamStartFleet(conn *appstream.AppStream, name string, timeout time.Duration) error {
+	input := &appstream.StartFleetInput{
+		Name: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Starting AppStream Fleet: %s", input)
+	if _, err := conn.StartFleet(input); err != nil {
+		return fmt.Errorf("error starting AppStream Fleet (%s): %s", name, err)
+	}
+
+	fleet, err := waiter.FleetRunning(conn, name, timeout)
+
+	// A fleet that fails to start reports the reasons in its errors.
+	if err != nil && fleet != nil && len(fleet.FleetErrors) > 0 {
+		var errors *multierror.Error
+
+		for _, fleetError := range fleet.FleetErrors {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %s", aws.StringValue(fleetError.ErrorCode), aws.StringValue(fleetError.ErrorMessage)))
+		}
+
+		err = fmt.Errorf("%s: %s", err, errors)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for AppStream Fleet (%s) to start: %s", name, err)
+	}
+
+	return nil
+}
+
+func appStreamStopFleet(conn *appstream.AppStream, name string, timeout time.Duration) error {
+	input := &appstream.StopFleetInput{
+		Name: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Stopping AppStream Fleet: %s", input)
+	if _, err := conn.StopFleet(input); err != nil {
+		return fmt.Errorf("error stopping AppStream Fleet (%s): %s", name, err)
+	}
+
+	if _, err := waiter.FleetStopped(conn, name, timeout); err != nil {
+		return fmt.Errorf("error waiting for AppStream Fleet (%s) to stop: %s", name, err)
+	}
+
+	return nil
+}
+
+func expandAppStreamComputeCapacity(tfList []interface{}) *appstream.ComputeCapacity {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &appstream.ComputeCapacity{
+		DesiredInstances: aws.Int64(int64(tfMap["desired_instances"].(int))),
+	}
+}
+
+func flattenAppStreamComputeCapacityStatus(apiObject *appstream.ComputeCapacityStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"available":         int(aws.Int64Value(apiObject.Available)),
+		"desired_instances": int(aws.Int64Value(apiObject.Desired)),
+		"in_use":            int(aws.Int64Value(apiObject.InUse)),
+		"running":           int(aws.Int64Value(apiObject.Running)),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func validateAppStreamName() schema.SchemaValidateFunc {
+	return validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$`), "must begin with an alphanumeric character and contain only alphanumeric characters, underscores, periods and hyphens, up to 101 characters")
+}
+
+func appStreamDomainJoinInfoSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"directory_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: forceNew,
+				},
+				"organizational_unit_distinguished_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringLenBetween(0, 2000),
+				},
+			},
+		},
+	}
+}
+
+func appStreamVpcConfigSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"security_group_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Computed: true,
+					ForceNew: forceNew,
+					MaxItems: 5,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"subnet_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Computed: true,
+					ForceNew: forceNew,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandAppStreamDomainJoinInfo(tfList []interface{}) *appstream.DomainJoinInfo {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appstream.DomainJoinInfo{}
+
+	if v, ok := tfMap["directory_name"].(string); ok && v != "" {
+		apiObject.DirectoryName = aws.String(v)
+	}
+
+	if v, ok := tfMap["organizational_unit_distinguished_name"].(string); ok && v != "" {
+		apiObject.OrganizationalUnitDistinguishedName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAppStreamDomainJoinInfo(apiObject *appstream.DomainJoinInfo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"directory_name":                         aws.StringValue(apiObject.DirectoryName),
+		"organizational_unit_distinguished_name": aws.StringValue(apiObject.OrganizationalUnitDistinguishedName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandAppStreamVpcConfig(tfList []interface{}) *appstream.VpcConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appstream.VpcConfig{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAppStreamVpcConfig(apiObject *appstream.VpcConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": flattenStringSet(apiObject.SecurityGroupIds),
+		"subnet_ids":         flattenStringSet(apiObject.SubnetIds),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamFleetStackAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamFleetStackAssociationCreate,
+		Read:   resourceAwsAppStreamFleetStackAssociationRead,
+		Delete: resourceAwsAppStreamFleetStackAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fleet_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppStreamName(),
+			},
+			"stack_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppStreamName(),
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamFleetStackAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	fleetName := d.Get("fleet_name").(string)
+	stackName := d.Get("stack_name").(string)
+
+	input := &appstream.AssociateFleetInput{
+		FleetName: aws.String(fleetName),
+		StackName: aws.String(stackName),
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Fleet Stack Association: %s", input)
+	if _, err := conn.AssociateFleet(input); err != nil {
+		return fmt.Errorf("error creating AppStream Fleet (%s) Stack (%s) Association: %s", fleetName, stackName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", fleetName, stackName))
+
+	return resourceAwsAppStreamFleetStackAssociationRead(d, meta)
+}
+
+func resourceAwsAppStreamFleetStackAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleetName, stackName, err := decodeAppStreamFleetStackAssociationID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = finder.FleetStackAssociation(conn, fleetName, stackName)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Fleet Stack Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Fleet Stack Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("fleet_name", fleetName)
+	d.Set("stack_name", stackName)
+
+	return nil
+}
+
+func resourceAwsAppStreamFleetStackAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleetName, stackName, err := decodeAppStreamFleetStackAssociationID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream Fleet Stack Association: %s", d.Id())
+	_, err = conn.DisassociateFleet(&appstream.DisassociateFleetInput{
+		FleetName: aws.String(fleetName),
+		StackName: aws.String(stackName),
+	})
+
+	if isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream Fleet Stack Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAppStreamFleetStackAssociationID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected FLEET-NAME/STACK-NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAppStreamFleetStackAssociation_basic(t *testing.T) {
+	resourceName := "aws_appstream_fleet_stack_association.test"
+	fleetResourceName := "aws_appstream_fleet.test"
+	stackResourceName := "aws_appstream_stack.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetStackAssociationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetStackAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_name", fleetResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_name", stackResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamFleetStackAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_fleet_stack_association" {
+			continue
+		}
+
+		fleetName, stackName, err := decodeAppStreamFleetStackAssociationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		err = finder.FleetStackAssociation(conn, fleetName, stackName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Fleet Stack Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamFleetStackAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Fleet Stack Association ID is set")
+		}
+
+		fleetName, stackName, err := decodeAppStreamFleetStackAssociationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		return finder.FleetStackAssociation(conn, fleetName, stackName)
+	}
+}
+
+func testAccAWSAppStreamFleetStackAssociationConfigBasic(rName string) string {
+	return testAccAWSAppStreamFleetConfigBasic(rName) + fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "aws_appstream_fleet_stack_association" "test" {
+  fleet_name = "${aws_appstream_fleet.test.name}"
+  stack_name = "${aws_appstream_stack.test.name}"
+}
+`, rName)
+}
@@ -0,0 +1,508 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_appstream_fleet", &sweep.Sweeper{
+		Name: "aws_appstream_fleet",
+		F:    testSweepAppStreamFleets,
+	})
+}
+
+func testSweepAppStreamFleets(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).appstreamconn
+	input := &appstream.DescribeFleetsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.DescribeFleets(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping AppStream Fleet sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing AppStream Fleets: %s", err))
+			return sweeperErrs
+		}
+
+		for _, fleet := range output.Fleets {
+			name := aws.StringValue(fleet.Name)
+
+			if err := testSweepAppStreamFleetStackAssociations(conn, name); err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+				continue
+			}
+
+			if aws.StringValue(fleet.State) != appstream.FleetStateStopped {
+				if testSweepDryRun() {
+					sweepRunner.RecordDryRunRequest(appstream.ServiceName, "StopFleet", &appstream.StopFleetInput{
+						Name: aws.String(name),
+					})
+				} else if err := appStreamStopFleet(conn, name, waiter.FleetStoppedTimeout); err != nil {
+					sweeperErrs = multierror.Append(sweeperErrs, err)
+					continue
+				}
+			}
+
+			log.Printf("[INFO] Deleting AppStream Fleet: %s", name)
+			_, err := conn.DeleteFleet(&appstream.DeleteFleetInput{
+				Name: aws.String(name),
+			})
+
+			if isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting AppStream Fleet (%s): %s", name, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func testSweepAppStreamFleetStackAssociations(conn *appstream.AppStream, fleetName string) error {
+	input := &appstream.ListAssociatedStacksInput{
+		FleetName: aws.String(fleetName),
+	}
+
+	for {
+		output, err := conn.ListAssociatedStacks(input)
+
+		if err != nil {
+			return fmt.Errorf("error listing AppStream Fleet (%s) associated Stacks: %s", fleetName, err)
+		}
+
+		for _, stackName := range output.Names {
+			log.Printf("[INFO] Deleting AppStream Fleet Stack Association: %s/%s", fleetName, aws.StringValue(stackName))
+			_, err := conn.DisassociateFleet(&appstream.DisassociateFleetInput{
+				FleetName: aws.String(fleetName),
+				StackName: stackName,
+			})
+
+			if err != nil && !isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+				return fmt.Errorf("error deleting AppStream Fleet Stack Association (%s/%s): %s", fleetName, aws.StringValue(stackName), err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAWSAppStreamFleet_basic(t *testing.T) {
+	var fleet appstream.Fleet
+	resourceName := "aws_appstream_fleet.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "appstream", fmt.Sprintf("fleet/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", "ON_DEMAND"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.small"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_disappears(t *testing.T) {
+	var fleet appstream.Fleet
+	resourceName := "aws_appstream_fleet.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					testAccCheckAWSAppStreamFleetDisappears(&fleet),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_DesiredState(t *testing.T) {
+	var fleet appstream.Fleet
+	resourceName := "aws_appstream_fleet.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigDesiredState(rName, "RUNNING", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "1"),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamFleetConfigDesiredState(rName, "RUNNING", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "2"),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamFleetConfigDesiredState(rName, "STOPPED", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_Update(t *testing.T) {
+	var fleet appstream.Fleet
+	resourceName := "aws_appstream_fleet.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigUpdate(rName, "Description 1", 900),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "description", "Description 1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "disconnect_timeout_in_seconds", "900"),
+					resource.TestCheckResourceAttr(resourceName, "enable_default_internet_access", "false"),
+					resource.TestCheckResourceAttr(resourceName, "max_user_duration_in_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamFleetConfigUpdate(rName, "Description 2", 1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "description", "Description 2"),
+					resource.TestCheckResourceAttr(resourceName, "disconnect_timeout_in_seconds", "1200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleet_tags(t *testing.T) {
+	var fleet appstream.Fleet
+	resourceName := "aws_appstream_fleet.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamFleetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamFleetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamFleetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_fleet" {
+			continue
+		}
+
+		_, err := finder.FleetByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Fleet %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamFleetDisappears(fleet *appstream.Fleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		_, err := conn.DeleteFleet(&appstream.DeleteFleetInput{
+			Name: fleet.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSAppStreamFleetExists(n string, v *appstream.Fleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Fleet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		fleet, err := finder.FleetByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *fleet
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSAppStream(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	input := &appstream.DescribeStacksInput{}
+
+	_, err := conn.DescribeStacks(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAWSAppStreamFleetConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+  fleet_type    = "ON_DEMAND"
+  desired_state = "STOPPED"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+}
+`, rName)
+}
+
+func testAccAWSAppStreamFleetConfigDesiredState(rName, desiredState string, desiredInstances int) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+  fleet_type    = "ON_DEMAND"
+  desired_state = %[2]q
+
+  compute_capacity {
+    desired_instances = %[3]d
+  }
+}
+`, rName, desiredState, desiredInstances)
+}
+
+func testAccAWSAppStreamFleetConfigUpdate(rName, description string, disconnectTimeout int) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_appstream_fleet" "test" {
+  name                           = %[1]q
+  description                    = %[2]q
+  display_name                   = %[1]q
+  disconnect_timeout_in_seconds  = %[3]d
+  enable_default_internet_access = false
+  image_name                     = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type                  = "stream.standard.small"
+  fleet_type                     = "ON_DEMAND"
+  max_user_duration_in_seconds   = 3600
+  desired_state                  = "STOPPED"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+
+  vpc_config {
+    subnet_ids = ["${aws_subnet.test.id}"]
+  }
+}
+`, rName, description, disconnectTimeout)
+}
+
+func testAccAWSAppStreamFleetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+  fleet_type    = "ON_DEMAND"
+  desired_state = "STOPPED"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamFleetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+  fleet_type    = "ON_DEMAND"
+  desired_state = "STOPPED"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
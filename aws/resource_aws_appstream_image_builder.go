@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamImageBuilder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamImageBuilderCreate,
+		Read:   resourceAwsAppStreamImageBuilderRead,
+		Update: resourceAwsAppStreamImageBuilderUpdate,
+		Delete: resourceAwsAppStreamImageBuilderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ImageBuilderRunningTimeout),
+			Delete: schema.DefaultTimeout(waiter.ImageBuilderDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_endpoints": appStreamAccessEndpointsSchema(true),
+			"appstream_agent_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"domain_join_info": appStreamDomainJoinInfoSchema(true),
+			"enable_default_internet_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"image_name"},
+				ValidateFunc:  validateArn,
+			},
+			"image_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"image_arn"},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppStreamName(),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":       tagsSchema(),
+			"tags_all":   tagsSchemaTrulyComputed(),
+			"vpc_config": appStreamVpcConfigSchema(true),
+		},
+	}
+}
+
+func resourceAwsAppStreamImageBuilderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	name := d.Get("name").(string)
+
+	input := &appstream.CreateImageBuilderInput{
+		InstanceType: aws.String(d.Get("instance_type").(string)),
+		Name:         aws.String(name),
+		Tags:         keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().AppstreamTags(),
+	}
+
+	if v, ok := d.GetOk("access_endpoints"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccessEndpoints = expandAppStreamAccessEndpoints(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("appstream_agent_version"); ok {
+		input.AppstreamAgentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain_join_info"); ok {
+		input.DomainJoinInfo = expandAppStreamDomainJoinInfo(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOkExists("enable_default_internet_access"); ok {
+		input.EnableDefaultInternetAccess = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("image_arn"); ok {
+		input.ImageArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_name"); ok {
+		input.ImageName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandAppStreamVpcConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Image Builder: %s", input)
+	// A newly created AppStream service role may not be usable immediately.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateImageBuilder(input)
+
+		if isAWSErr(err, appstream.ErrCodeInvalidRoleException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.CreateImageBuilder(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating AppStream Image Builder (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.ImageBuilderRunning(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for AppStream Image Builder (%s) to start: %s", d.Id(), err)
+	}
+
+	return resourceAwsAppStreamImageBuilderRead(d, meta)
+}
+
+func resourceAwsAppStreamImageBuilderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	imageBuilder, err := finder.ImageBuilderByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Image Builder (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Image Builder (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(imageBuilder.Arn)
+	d.Set("appstream_agent_version", imageBuilder.AppstreamAgentVersion)
+	d.Set("arn", arn)
+	d.Set("created_time", aws.TimeValue(imageBuilder.CreatedTime).Format(time.RFC3339))
+	d.Set("description", imageBuilder.Description)
+	d.Set("display_name", imageBuilder.DisplayName)
+	d.Set("enable_default_internet_access", imageBuilder.EnableDefaultInternetAccess)
+	d.Set("image_arn", imageBuilder.ImageArn)
+	d.Set("instance_type", imageBuilder.InstanceType)
+	d.Set("name", imageBuilder.Name)
+	d.Set("platform", imageBuilder.Platform)
+	d.Set("state", imageBuilder.State)
+
+	if err := d.Set("access_endpoints", flattenAppStreamAccessEndpoints(imageBuilder.AccessEndpoints)); err != nil {
+		return fmt.Errorf("error setting access_endpoints: %s", err)
+	}
+
+	if err := d.Set("domain_join_info", flattenAppStreamDomainJoinInfo(imageBuilder.DomainJoinInfo)); err != nil {
+		return fmt.Errorf("error setting domain_join_info: %s", err)
+	}
+
+	if err := d.Set("vpc_config", flattenAppStreamVpcConfig(imageBuilder.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %s", err)
+	}
+
+	tags, err := keyvaluetags.AppstreamListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppStream Image Builder (%s): %s", arn, err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppStreamImageBuilderUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.AppstreamUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppStream Image Builder (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppStreamImageBuilderRead(d, meta)
+}
+
+func resourceAwsAppStreamImageBuilderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	log.Printf("[DEBUG] Deleting AppStream Image Builder: %s", d.Id())
+	_, err := conn.DeleteImageBuilder(&appstream.DeleteImageBuilderInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream Image Builder (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.ImageBuilderDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for AppStream Image Builder (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_appstream_image_builder", &sweep.Sweeper{
+		Name: "aws_appstream_image_builder",
+		F:    testSweepAppStreamImageBuilders,
+	})
+}
+
+func testSweepAppStreamImageBuilders(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).appstreamconn
+	input := &appstream.DescribeImageBuildersInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.DescribeImageBuilders(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping AppStream Image Builder sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing AppStream Image Builders: %s", err))
+			return sweeperErrs
+		}
+
+		for _, imageBuilder := range output.ImageBuilders {
+			name := aws.StringValue(imageBuilder.Name)
+
+			log.Printf("[INFO] Deleting AppStream Image Builder: %s", name)
+			_, err := conn.DeleteImageBuilder(&appstream.DeleteImageBuilderInput{
+				Name: aws.String(name),
+			})
+
+			if isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting AppStream Image Builder (%s): %s", name, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamImageBuilder_basic(t *testing.T) {
+	var imageBuilder appstream.ImageBuilder
+	resourceName := "aws_appstream_image_builder.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamImageBuilderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &imageBuilder),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "appstream", fmt.Sprintf("image-builder/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "stream.standard.medium"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_name"},
+			},
+			{
+				Config: testAccAWSAppStreamImageBuilderConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamImageBuilderExists(resourceName, &imageBuilder),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamImageBuilderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_image_builder" {
+			continue
+		}
+
+		_, err := finder.ImageBuilderByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Image Builder %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamImageBuilderExists(n string, v *appstream.ImageBuilder) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Image Builder ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		imageBuilder, err := finder.ImageBuilderByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *imageBuilder
+
+		return nil
+	}
+}
+
+func testAccAWSAppStreamImageBuilderConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  name          = %[1]q
+  image_name    = "AppStream-WinServer2012R2-07-19-2021"
+  instance_type = "stream.standard.medium"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamImageBuilderConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  name          = %[1]q
+  image_name    = "AppStream-WinServer2012R2-07-19-2021"
+  instance_type = "stream.standard.medium"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,525 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamStack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamStackCreate,
+		Read:   resourceAwsAppStreamStackRead,
+		Update: resourceAwsAppStreamStackUpdate,
+		Delete: resourceAwsAppStreamStackDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"access_endpoints": appStreamAccessEndpointsSchema(false),
+			"application_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"settings_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 100),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"feedback_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppStreamName(),
+			},
+			"redirect_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"storage_connectors": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connector_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								appstream.StorageConnectorTypeGoogleDrive,
+								appstream.StorageConnectorTypeHomefolders,
+								appstream.StorageConnectorTypeOneDrive,
+							}, false),
+						},
+						"domains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 64),
+							},
+						},
+						"resource_identifier": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"user_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								appstream.ActionClipboardCopyFromLocalDevice,
+								appstream.ActionClipboardCopyToLocalDevice,
+								appstream.ActionFileDownload,
+								appstream.ActionFileUpload,
+								appstream.ActionPrintingToLocalDevice,
+							}, false),
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								appstream.PermissionDisabled,
+								appstream.PermissionEnabled,
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamStackCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	name := d.Get("name").(string)
+
+	input := &appstream.CreateStackInput{
+		Name: aws.String(name),
+		Tags: keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().AppstreamTags(),
+	}
+
+	if v, ok := d.GetOk("access_endpoints"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccessEndpoints = expandAppStreamAccessEndpoints(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("application_settings"); ok {
+		input.ApplicationSettings = expandAppStreamApplicationSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("feedback_url"); ok {
+		input.FeedbackURL = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("redirect_url"); ok {
+		input.RedirectURL = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_connectors"); ok && v.(*schema.Set).Len() > 0 {
+		input.StorageConnectors = expandAppStreamStorageConnectors(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("user_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.UserSettings = expandAppStreamUserSettings(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Stack: %s", input)
+	if _, err := conn.CreateStack(input); err != nil {
+		return fmt.Errorf("error creating AppStream Stack (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsAppStreamStackRead(d, meta)
+}
+
+func resourceAwsAppStreamStackRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	stack, err := finder.StackByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream Stack (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(stack.Arn)
+	d.Set("arn", arn)
+	d.Set("created_time", aws.TimeValue(stack.CreatedTime).Format(time.RFC3339))
+	d.Set("description", stack.Description)
+	d.Set("display_name", stack.DisplayName)
+	d.Set("feedback_url", stack.FeedbackURL)
+	d.Set("name", stack.Name)
+	d.Set("redirect_url", stack.RedirectURL)
+
+	if err := d.Set("access_endpoints", flattenAppStreamAccessEndpoints(stack.AccessEndpoints)); err != nil {
+		return fmt.Errorf("error setting access_endpoints: %s", err)
+	}
+
+	if err := d.Set("application_settings", flattenAppStreamApplicationSettingsResponse(stack.ApplicationSettings)); err != nil {
+		return fmt.Errorf("error setting application_settings: %s", err)
+	}
+
+	if err := d.Set("storage_connectors", flattenAppStreamStorageConnectors(stack.StorageConnectors)); err != nil {
+		return fmt.Errorf("error setting storage_connectors: %s", err)
+	}
+
+	if err := d.Set("user_settings", flattenAppStreamUserSettings(stack.UserSettings)); err != nil {
+		return fmt.Errorf("error setting user_settings: %s", err)
+	}
+
+	tags, err := keyvaluetags.AppstreamListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppStream Stack (%s): %s", arn, err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppStreamStackUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	if d.HasChange("access_endpoints") || d.HasChange("application_settings") || d.HasChange("description") || d.HasChange("display_name") ||
+		d.HasChange("feedback_url") || d.HasChange("redirect_url") || d.HasChange("storage_connectors") || d.HasChange("user_settings") {
+		input := &appstream.UpdateStackInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_endpoints") {
+			if v := d.Get("access_endpoints").(*schema.Set); v.Len() > 0 {
+				input.AccessEndpoints = expandAppStreamAccessEndpoints(v.List())
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeAccessEndpoints))
+			}
+		}
+
+		if d.HasChange("application_settings") {
+			input.ApplicationSettings = expandAppStreamApplicationSettings(d.Get("application_settings").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("feedback_url") {
+			if v := d.Get("feedback_url").(string); v != "" {
+				input.FeedbackURL = aws.String(v)
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeFeedbackUrl))
+			}
+		}
+
+		if d.HasChange("redirect_url") {
+			if v := d.Get("redirect_url").(string); v != "" {
+				input.RedirectURL = aws.String(v)
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeRedirectUrl))
+			}
+		}
+
+		if d.HasChange("storage_connectors") {
+			if v := d.Get("storage_connectors").(*schema.Set); v.Len() > 0 {
+				input.StorageConnectors = expandAppStreamStorageConnectors(v.List())
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, aws.String(appstream.StackAttributeStorageConnectors))
+			}
+		}
+
+		if d.HasChange("user_settings") {
+			if v := d.Get("user_settings").(*schema.Set); v.Len() > 0 {
+				input.UserSettings = expandAppStreamUserSettings(v.List())
+			}
+		}
+
+		log.Printf("[DEBUG] Updating AppStream Stack: %s", input)
+		if _, err := conn.UpdateStack(input); err != nil {
+			return fmt.Errorf("error updating AppStream Stack (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.AppstreamUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppStream Stack (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppStreamStackRead(d, meta)
+}
+
+func resourceAwsAppStreamStackDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	log.Printf("[DEBUG] Deleting AppStream Stack: %s", d.Id())
+	_, err := conn.DeleteStack(&appstream.DeleteStackInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream Stack (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func appStreamAccessEndpointsSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: forceNew,
+		MaxItems: 4,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"endpoint_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: forceNew,
+					ValidateFunc: validation.StringInSlice([]string{
+						appstream.AccessEndpointTypeStreaming,
+					}, false),
+				},
+				"vpce_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: forceNew,
+				},
+			},
+		},
+	}
+}
+
+func expandAppStreamAccessEndpoints(tfList []interface{}) []*appstream.AccessEndpoint {
+	var apiObjects []*appstream.AccessEndpoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &appstream.AccessEndpoint{
+			EndpointType: aws.String(tfMap["endpoint_type"].(string)),
+		}
+
+		if v, ok := tfMap["vpce_id"].(string); ok && v != "" {
+			apiObject.VpceId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAppStreamAccessEndpoints(apiObjects []*appstream.AccessEndpoint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"endpoint_type": aws.StringValue(apiObject.EndpointType),
+			"vpce_id":       aws.StringValue(apiObject.VpceId),
+		})
+	}
+
+	return tfList
+}
+
+func expandAppStreamApplicationSettings(tfList []interface{}) *appstream.ApplicationSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appstream.ApplicationSettings{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["settings_group"].(string); ok && v != "" {
+		apiObject.SettingsGroup = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAppStreamApplicationSettingsResponse(apiObject *appstream.ApplicationSettingsResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":        aws.BoolValue(apiObject.Enabled),
+		"settings_group": aws.StringValue(apiObject.SettingsGroup),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandAppStreamStorageConnectors(tfList []interface{}) []*appstream.StorageConnector {
+	var apiObjects []*appstream.StorageConnector
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &appstream.StorageConnector{
+			ConnectorType: aws.String(tfMap["connector_type"].(string)),
+		}
+
+		if v, ok := tfMap["domains"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Domains = expandStringList(v)
+		}
+
+		if v, ok := tfMap["resource_identifier"].(string); ok && v != "" {
+			apiObject.ResourceIdentifier = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAppStreamStorageConnectors(apiObjects []*appstream.StorageConnector) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"connector_type":      aws.StringValue(apiObject.ConnectorType),
+			"domains":             aws.StringValueSlice(apiObject.Domains),
+			"resource_identifier": aws.StringValue(apiObject.ResourceIdentifier),
+		})
+	}
+
+	return tfList
+}
+
+func expandAppStreamUserSettings(tfList []interface{}) []*appstream.UserSetting {
+	var apiObjects []*appstream.UserSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &appstream.UserSetting{
+			Action:     aws.String(tfMap["action"].(string)),
+			Permission: aws.String(tfMap["permission"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenAppStreamUserSettings(apiObjects []*appstream.UserSetting) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     aws.StringValue(apiObject.Action),
+			"permission": aws.StringValue(apiObject.Permission),
+		})
+	}
+
+	return tfList
+}
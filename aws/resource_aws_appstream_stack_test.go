@@ -0,0 +1,357 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_appstream_stack", &sweep.Sweeper{
+		Name: "aws_appstream_stack",
+		F:    testSweepAppStreamStacks,
+		Dependencies: []string{
+			"aws_appstream_fleet",
+		},
+	})
+}
+
+func testSweepAppStreamStacks(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).appstreamconn
+	input := &appstream.DescribeStacksInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.DescribeStacks(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping AppStream Stack sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing AppStream Stacks: %s", err))
+			return sweeperErrs
+		}
+
+		for _, stack := range output.Stacks {
+			name := aws.StringValue(stack.Name)
+
+			log.Printf("[INFO] Deleting AppStream Stack: %s", name)
+			_, err := conn.DeleteStack(&appstream.DeleteStackInput{
+				Name: aws.String(name),
+			})
+
+			if isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting AppStream Stack (%s): %s", name, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamStack_basic(t *testing.T) {
+	var stack appstream.Stack
+	resourceName := "aws_appstream_stack.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "appstream", fmt.Sprintf("stack/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_disappears(t *testing.T) {
+	var stack appstream.Stack
+	resourceName := "aws_appstream_stack.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					testAccCheckAWSAppStreamStackDisappears(&stack),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_Complete(t *testing.T) {
+	var stack appstream.Stack
+	resourceName := "aws_appstream_stack.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigComplete(rName, "Description 1", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "description", "Description 1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "feedback_url", "https://example.com/feedback"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", "https://example.com/redirect"),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.#", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigComplete(rName, "Description 2", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "description", "Description 2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.#", "5"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "feedback_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_tags(t *testing.T) {
+	var stack appstream.Stack
+	resourceName := "aws_appstream_stack.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamStackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_stack" {
+			continue
+		}
+
+		_, err := finder.StackByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Stack %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamStackDisappears(stack *appstream.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		_, err := conn.DeleteStack(&appstream.DeleteStackInput{
+			Name: stack.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSAppStreamStackExists(n string, v *appstream.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Stack ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		stack, err := finder.StackByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *stack
+
+		return nil
+	}
+}
+
+func testAccAWSAppStreamStackConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAppStreamStackConfigComplete(rName, description, permission string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name         = %[1]q
+  description  = %[2]q
+  display_name = %[1]q
+  feedback_url = "https://example.com/feedback"
+  redirect_url = "https://example.com/redirect"
+
+  storage_connectors {
+    connector_type = "HOMEFOLDERS"
+  }
+
+  user_settings {
+    action     = "CLIPBOARD_COPY_FROM_LOCAL_DEVICE"
+    permission = "ENABLED"
+  }
+
+  user_settings {
+    action     = "CLIPBOARD_COPY_TO_LOCAL_DEVICE"
+    permission = %[3]q
+  }
+
+  user_settings {
+    action     = "FILE_DOWNLOAD"
+    permission = %[3]q
+  }
+
+  user_settings {
+    action     = "FILE_UPLOAD"
+    permission = "ENABLED"
+  }
+
+  user_settings {
+    action     = "PRINTING_TO_LOCAL_DEVICE"
+    permission = %[3]q
+  }
+}
+`, rName, description, permission)
+}
+
+func testAccAWSAppStreamStackConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamStackConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
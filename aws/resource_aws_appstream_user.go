@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamUserCreate,
+		Read:   resourceAwsAppStreamUserRead,
+		Update: resourceAwsAppStreamUserUpdate,
+		Delete: resourceAwsAppStreamUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// Only users in the AppStream user pool can be created through the API.
+				ValidateFunc: validation.StringInSlice([]string{
+					appstream.AuthenticationTypeUserpool,
+				}, false),
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"first_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"last_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"send_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	userName := d.Get("user_name").(string)
+	authType := d.Get("authentication_type").(string)
+
+	input := &appstream.CreateUserInput{
+		AuthenticationType: aws.String(authType),
+		UserName:           aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("first_name"); ok {
+		input.FirstName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("last_name"); ok {
+		input.LastName = aws.String(v.(string))
+	}
+
+	if !d.Get("send_email_notification").(bool) {
+		input.MessageAction = aws.String(appstream.MessageActionSuppress)
+	}
+
+	log.Printf("[DEBUG] Creating AppStream User: %s", input)
+	if _, err := conn.CreateUser(input); err != nil {
+		return fmt.Errorf("error creating AppStream User (%s): %s", userName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userName, authType))
+
+	if !d.Get("enabled").(bool) {
+		if err := appStreamSetUserEnabled(conn, userName, authType, false); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsAppStreamUserRead(d, meta)
+}
+
+func resourceAwsAppStreamUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authType, err := decodeAppStreamUserID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	user, err := finder.UserByNameAndAuthType(conn, userName, authType)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream User (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", user.Arn)
+	d.Set("authentication_type", user.AuthenticationType)
+	d.Set("created_time", aws.TimeValue(user.CreatedTime).Format(time.RFC3339))
+	d.Set("enabled", user.Enabled)
+	d.Set("first_name", user.FirstName)
+	d.Set("last_name", user.LastName)
+	d.Set("status", user.Status)
+	d.Set("user_name", user.UserName)
+
+	return nil
+}
+
+func resourceAwsAppStreamUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	if d.HasChange("enabled") {
+		userName, authType, err := decodeAppStreamUserID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if err := appStreamSetUserEnabled(conn, userName, authType, d.Get("enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsAppStreamUserRead(d, meta)
+}
+
+func resourceAwsAppStreamUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authType, err := decodeAppStreamUserID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream User: %s", d.Id())
+	_, err = conn.DeleteUser(&appstream.DeleteUserInput{
+		AuthenticationType: aws.String(authType),
+		UserName:           aws.String(userName),
+	})
+
+	if isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream User (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func appStreamSetUserEnabled(conn *appstream.AppStream, userName, authType string, enabled bool) error {
+	var err error
+
+	if enabled {
+		input := &appstream.EnableUserInput{
+			AuthenticationType: aws.String(authType),
+			UserName:           aws.String(userName),
+		}
+
+		log.Printf("[DEBUG] Enabling AppStream User: %s", input)
+		_, err = conn.EnableUser(input)
+	} else {
+		input := &appstream.DisableUserInput{
+			AuthenticationType: aws.String(authType),
+			UserName:           aws.String(userName),
+		}
+
+		log.Printf("[DEBUG] Disabling AppStream User: %s", input)
+		_, err = conn.DisableUser(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error setting AppStream User (%s/%s) enabled to %t: %s", userName, authType, enabled, err)
+	}
+
+	return nil
+}
+
+// decodeAppStreamUserID splits the ID on its last separator as user names may contain slashes.
+func decodeAppStreamUserID(id string) (string, string, error) {
+	idx := strings.LastIndex(id, "/")
+
+	if idx <= 0 || idx == len(id)-1 {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected USER-NAME/AUTHENTICATION-TYPE", id)
+	}
+
+	return id[:idx], id[idx+1:], nil
+}
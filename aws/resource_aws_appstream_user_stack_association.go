@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamUserStackAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppStreamUserStackAssociationCreate,
+		Read:   resourceAwsAppStreamUserStackAssociationRead,
+		Delete: resourceAwsAppStreamUserStackAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authentication_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					appstream.AuthenticationTypeApi,
+					appstream.AuthenticationTypeSaml,
+					appstream.AuthenticationTypeUserpool,
+				}, false),
+			},
+			"send_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"stack_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppStreamName(),
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamUserStackAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+	userName := d.Get("user_name").(string)
+	authType := d.Get("authentication_type").(string)
+	stackName := d.Get("stack_name").(string)
+
+	input := &appstream.BatchAssociateUserStackInput{
+		UserStackAssociations: []*appstream.UserStackAssociation{
+			{
+				AuthenticationType:    aws.String(authType),
+				SendEmailNotification: aws.Bool(d.Get("send_email_notification").(bool)),
+				StackName:             aws.String(stackName),
+				UserName:              aws.String(userName),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Creating AppStream User Stack Association: %s", input)
+	output, err := conn.BatchAssociateUserStack(input)
+
+	if err == nil && output != nil && len(output.Errors) > 0 {
+		err = fmt.Errorf("%s: %s", aws.StringValue(output.Errors[0].ErrorCode), aws.StringValue(output.Errors[0].ErrorMessage))
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating AppStream User (%s/%s) Stack (%s) Association: %s", userName, authType, stackName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", userName, authType, stackName))
+
+	return resourceAwsAppStreamUserStackAssociationRead(d, meta)
+}
+
+func resourceAwsAppStreamUserStackAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authType, stackName, err := decodeAppStreamUserStackAssociationID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := finder.UserStackAssociation(conn, userName, authType, stackName)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream User Stack Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppStream User Stack Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("authentication_type", association.AuthenticationType)
+	d.Set("stack_name", association.StackName)
+	d.Set("user_name", association.UserName)
+
+	return nil
+}
+
+func resourceAwsAppStreamUserStackAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authType, stackName, err := decodeAppStreamUserStackAssociationID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &appstream.BatchDisassociateUserStackInput{
+		UserStackAssociations: []*appstream.UserStackAssociation{
+			{
+				AuthenticationType: aws.String(authType),
+				StackName:          aws.String(stackName),
+				UserName:           aws.String(userName),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream User Stack Association: %s", d.Id())
+	output, err := conn.BatchDisassociateUserStack(input)
+
+	if isAWSErr(err, appstream.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err == nil && output != nil && len(output.Errors) > 0 {
+		switch code := aws.StringValue(output.Errors[0].ErrorCode); code {
+		case appstream.UserStackAssociationErrorCodeStackNotFound, appstream.UserStackAssociationErrorCodeUserNameNotFound:
+			return nil
+		default:
+			err = fmt.Errorf("%s: %s", code, aws.StringValue(output.Errors[0].ErrorMessage))
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppStream User Stack Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// decodeAppStreamUserStackAssociationID splits the ID from the right as user names may contain slashes.
+func decodeAppStreamUserStackAssociationID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) < 3 {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected USER-NAME/AUTHENTICATION-TYPE/STACK-NAME", id)
+	}
+
+	userName := strings.Join(parts[:len(parts)-2], "/")
+	authType := parts[len(parts)-2]
+	stackName := parts[len(parts)-1]
+
+	if userName == "" || authType == "" || stackName == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected USER-NAME/AUTHENTICATION-TYPE/STACK-NAME", id)
+	}
+
+	return userName, authType, stackName, nil
+}
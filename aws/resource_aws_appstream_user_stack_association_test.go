@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAppStreamUserStackAssociation_basic(t *testing.T) {
+	resourceName := "aws_appstream_user_stack_association.test"
+	stackResourceName := "aws_appstream_stack.test"
+	userResourceName := "aws_appstream_user.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rEmail := fmt.Sprintf("%s@example.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserStackAssociationConfigBasic(rName, rEmail),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserStackAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "authentication_type", userResourceName, "authentication_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_name", stackResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", userResourceName, "user_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_email_notification"},
+			},
+		},
+	})
+}
+
+func TestDecodeAppStreamUserStackAssociationID(t *testing.T) {
+	testCases := []struct {
+		Input             string
+		ExpectedUserName  string
+		ExpectedAuthType  string
+		ExpectedStackName string
+		ErrCount          int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "user@example.com/USERPOOL",
+			ErrCount: 1,
+		},
+		{
+			Input:    "/USERPOOL/stack",
+			ErrCount: 1,
+		},
+		{
+			Input:    "user@example.com//stack",
+			ErrCount: 1,
+		},
+		{
+			Input:    "user@example.com/USERPOOL/",
+			ErrCount: 1,
+		},
+		{
+			Input:             "user@example.com/USERPOOL/stack",
+			ExpectedUserName:  "user@example.com",
+			ExpectedAuthType:  "USERPOOL",
+			ExpectedStackName: "stack",
+		},
+		{
+			Input:             "user/name@example.com/SAML/stack",
+			ExpectedUserName:  "user/name@example.com",
+			ExpectedAuthType:  "SAML",
+			ExpectedStackName: "stack",
+		},
+	}
+
+	for _, tc := range testCases {
+		userName, authType, stackName, err := decodeAppStreamUserStackAssociationID(tc.Input)
+
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+
+		if userName != tc.ExpectedUserName {
+			t.Fatalf("expected %q to return user name %q, received: %q", tc.Input, tc.ExpectedUserName, userName)
+		}
+
+		if authType != tc.ExpectedAuthType {
+			t.Fatalf("expected %q to return authentication type %q, received: %q", tc.Input, tc.ExpectedAuthType, authType)
+		}
+
+		if stackName != tc.ExpectedStackName {
+			t.Fatalf("expected %q to return stack name %q, received: %q", tc.Input, tc.ExpectedStackName, stackName)
+		}
+	}
+}
+
+func testAccCheckAWSAppStreamUserStackAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_user_stack_association" {
+			continue
+		}
+
+		userName, authType, stackName, err := decodeAppStreamUserStackAssociationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.UserStackAssociation(conn, userName, authType, stackName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream User Stack Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamUserStackAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream User Stack Association ID is set")
+		}
+
+		userName, authType, stackName, err := decodeAppStreamUserStackAssociationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		_, err = finder.UserStackAssociation(conn, userName, authType, stackName)
+
+		return err
+	}
+}
+
+func testAccAWSAppStreamUserStackAssociationConfigBasic(rName, email string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "aws_appstream_user" "test" {
+  user_name               = %[2]q
+  authentication_type     = "USERPOOL"
+  send_email_notification = false
+}
+
+resource "aws_appstream_user_stack_association" "test" {
+  authentication_type = "${aws_appstream_user.test.authentication_type}"
+  stack_name          = "${aws_appstream_stack.test.name}"
+  user_name           = "${aws_appstream_user.test.user_name}"
+}
+`, rName, email)
+}
@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAppStreamUser_basic(t *testing.T) {
+	var user appstream.User
+	resourceName := "aws_appstream_user.test"
+	rEmail := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("tf-acc-test"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserConfigBasic(rEmail, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName, &user),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "USERPOOL"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "first_name", "Test"),
+					resource.TestCheckResourceAttr(resourceName, "last_name", "User"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", rEmail),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_email_notification"},
+			},
+			{
+				Config: testAccAWSAppStreamUserConfigBasic(rEmail, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamUser_disappears(t *testing.T) {
+	var user appstream.User
+	resourceName := "aws_appstream_user.test"
+	rEmail := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("tf-acc-test"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppStream(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserConfigBasic(rEmail, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName, &user),
+					testAccCheckAWSAppStreamUserDisappears(&user),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestDecodeAppStreamUserID(t *testing.T) {
+	testCases := []struct {
+		Input            string
+		ExpectedUserName string
+		ExpectedAuthType string
+		ErrCount         int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "user@example.com",
+			ErrCount: 1,
+		},
+		{
+			Input:    "/USERPOOL",
+			ErrCount: 1,
+		},
+		{
+			Input:    "user@example.com/",
+			ErrCount: 1,
+		},
+		{
+			Input:            "user@example.com/USERPOOL",
+			ExpectedUserName: "user@example.com",
+			ExpectedAuthType: "USERPOOL",
+		},
+		{
+			Input:            "user/name@example.com/USERPOOL",
+			ExpectedUserName: "user/name@example.com",
+			ExpectedAuthType: "USERPOOL",
+		},
+	}
+
+	for _, tc := range testCases {
+		userName, authType, err := decodeAppStreamUserID(tc.Input)
+
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+
+		if userName != tc.ExpectedUserName {
+			t.Fatalf("expected %q to return user name %q, received: %q", tc.Input, tc.ExpectedUserName, userName)
+		}
+
+		if authType != tc.ExpectedAuthType {
+			t.Fatalf("expected %q to return authentication type %q, received: %q", tc.Input, tc.ExpectedAuthType, authType)
+		}
+	}
+}
+
+func testAccCheckAWSAppStreamUserDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_user" {
+			continue
+		}
+
+		userName, authType, err := decodeAppStreamUserID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.UserByNameAndAuthType(conn, userName, authType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream User %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamUserDisappears(user *appstream.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		_, err := conn.DeleteUser(&appstream.DeleteUserInput{
+			AuthenticationType: user.AuthenticationType,
+			UserName:           user.UserName,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSAppStreamUserExists(n string, v *appstream.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream User ID is set")
+		}
+
+		userName, authType, err := decodeAppStreamUserID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		user, err := finder.UserByNameAndAuthType(conn, userName, authType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *user
+
+		return nil
+	}
+}
+
+func testAccAWSAppStreamUserConfigBasic(email string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_user" "test" {
+  user_name               = %[1]q
+  authentication_type     = "USERPOOL"
+  first_name              = "Test"
+  last_name               = "User"
+  enabled                 = %[2]t
+  send_email_notification = false
+}
+`, email, enabled)
+}
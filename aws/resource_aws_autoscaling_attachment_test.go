@@ -32,6 +32,13 @@ func TestAccAWSAutoscalingAttachment_elb(t *testing.T) {
 					testAccCheckAWSAutocalingElbAttachmentExists("aws_autoscaling_group.asg", 1),
 				),
 			},
+			{
+				ResourceName:      "aws_autoscaling_attachment.asg_attachment_foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingAttachmentImportStateIdFunc("aws_autoscaling_attachment.asg_attachment_foo", "elb"),
+				// The Create function uses resource.PrefixedUniqueId(), so IDs cannot be aligned
+				ImportStateCheck: testAccCheckAWSAutoscalingAttachmentImportState("elb"),
+			},
 			{
 				Config: testAccAWSAutoscalingAttachment_elb_double_associated(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -75,6 +82,13 @@ func TestAccAWSAutoscalingAttachment_albTargetGroup(t *testing.T) {
 					testAccCheckAWSAutocalingAlbAttachmentExists("aws_autoscaling_group.asg", 1),
 				),
 			},
+			{
+				ResourceName:      "aws_autoscaling_attachment.asg_attachment_foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingAttachmentImportStateIdFunc("aws_autoscaling_attachment.asg_attachment_foo", "alb_target_group_arn"),
+				// The Create function uses resource.PrefixedUniqueId(), so IDs cannot be aligned
+				ImportStateCheck: testAccCheckAWSAutoscalingAttachmentImportState("alb_target_group_arn"),
+			},
 			{
 				Config: testAccAWSAutoscalingAttachment_alb_double_associated(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -97,6 +111,37 @@ func TestAccAWSAutoscalingAttachment_albTargetGroup(t *testing.T) {
 	})
 }
 
+func testAccAWSAutoscalingAttachmentImportStateIdFunc(resourceName, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["autoscaling_group_name"], rs.Primary.Attributes[attribute]), nil
+	}
+}
+
+func testAccCheckAWSAutoscalingAttachmentImportState(attribute string) resource.ImportStateCheckFunc {
+	return func(s []*terraform.InstanceState) error {
+		if len(s) != 1 {
+			return fmt.Errorf("expected 1 state: %#v", s)
+		}
+
+		rs := s[0]
+
+		if rs.Attributes["autoscaling_group_name"] == "" {
+			return fmt.Errorf("expected autoscaling_group_name attribute to be set")
+		}
+
+		if rs.Attributes[attribute] == "" {
+			return fmt.Errorf("expected %s attribute to be set", attribute)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSAutocalingAttachmentDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
 
@@ -16,6 +16,14 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 		Read:   resourceAwsAutoscalingNotificationRead,
 		Update: resourceAwsAutoscalingNotificationUpdate,
 		Delete: resourceAwsAutoscalingNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Without group names, all notification configurations of the
+				// account are searched for the topic during read
+				d.Set("topic_arn", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"topic_arn": {
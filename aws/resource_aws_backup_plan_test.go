@@ -31,6 +31,11 @@ func TestAccAwsBackupPlan_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr("aws_backup_plan.test", "rule.712706565.lifecycle.#"),
 				),
 			},
+			{
+				ResourceName:      "aws_backup_plan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
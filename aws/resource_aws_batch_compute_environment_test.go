@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_compute_environment", &sweep.Sweeper{
 		Name: "aws_batch_compute_environment",
 		Dependencies: []string{
 			"aws_batch_job_queue",
@@ -53,6 +54,13 @@ func testSweepBatchComputeEnvironments(region string) error {
 		}
 
 		log.Printf("[INFO] Deleting Batch Compute Environment: %s", name)
+		if testSweepDryRun() {
+			sweepRunner.RecordDryRunRequest(batch.ServiceName, "DeleteComputeEnvironment", &batch.DeleteComputeEnvironmentInput{
+				ComputeEnvironment: aws.String(name),
+			})
+			continue
+		}
+
 		err := deleteBatchComputeEnvironment(name, 20*time.Minute, conn)
 
 		if err != nil {
@@ -77,6 +85,11 @@ func TestAccAWSBatchComputeEnvironment_createEc2(t *testing.T) {
 					testAccCheckAwsBatchComputeEnvironmentExists(),
 				),
 			},
+			{
+				ResourceName:      "aws_batch_compute_environment.ec2",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
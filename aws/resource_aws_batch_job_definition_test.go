@@ -63,6 +63,11 @@ func TestAccAWSBatchJobDefinition_basic(t *testing.T) {
 					testAccCheckBatchJobDefinitionAttributes(&jd, &compare),
 				),
 			},
+			{
+				ResourceName:      "aws_batch_job_definition.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_queue", &sweep.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    testSweepBatchJobQueues,
 	})
@@ -62,6 +63,13 @@ func testSweepBatchJobQueues(region string) error {
 		}
 
 		log.Printf("[INFO] Deleting Batch Job Queue: %s", *name)
+		if testSweepDryRun() {
+			sweepRunner.RecordDryRunRequest(batch.ServiceName, "DeleteJobQueue", &batch.DeleteJobQueueInput{
+				JobQueue: name,
+			})
+			continue
+		}
+
 		err = deleteBatchJobQueue(*name, conn)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete Batch Job Queue %s: %s", *name, err)
@@ -87,6 +95,11 @@ func TestAccAWSBatchJobQueue_basic(t *testing.T) {
 					testAccCheckBatchJobQueueAttributes(&jq),
 				),
 			},
+			{
+				ResourceName:      "aws_batch_job_queue.test_queue",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
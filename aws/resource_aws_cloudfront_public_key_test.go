@@ -29,6 +29,11 @@ func TestAccAWSCloudFrontPublicKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_cloudfront_public_key.example", "name", fmt.Sprintf("tf-acc-test-%d", rInt)),
 				),
 			},
+			{
+				ResourceName:      "aws_cloudfront_public_key.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudhsm_v2_cluster", &sweep.Sweeper{
 		Name: "aws_cloudhsm_v2_cluster",
 		F:    testSweepCloudhsmv2Clusters,
 	})
@@ -51,6 +52,10 @@ func testSweepCloudhsmv2Clusters(region string) error {
 					continue
 				}
 
+				if testSweepDryRun() {
+					continue
+				}
+
 				if err := waitForCloudhsmv2HsmDeletion(conn, hsmID, 120*time.Minute); err != nil {
 					log.Printf("[ERROR] Error waiting for CloudHSMv2 Cluster (%s) HSM (%s) deletion: %s", clusterID, hsmID, err)
 				}
@@ -64,6 +69,10 @@ func testSweepCloudhsmv2Clusters(region string) error {
 				continue
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			if err := waitForCloudhsmv2ClusterDeletion(conn, clusterID, 120*time.Minute); err != nil {
 				log.Printf("[ERROR] Error waiting for CloudHSMv2 Cluster (%s) deletion: %s", clusterID, err)
 			}
@@ -20,6 +20,21 @@ func resourceAwsCloudWatchLogMetricFilter() *schema.Resource {
 		Read:   resourceAwsCloudWatchLogMetricFilterRead,
 		Update: resourceAwsCloudWatchLogMetricFilterUpdate,
 		Delete: resourceAwsCloudWatchLogMetricFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Neither log group names nor metric filter names can contain colons
+				idParts := strings.Split(d.Id(), ":")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOG-GROUP-NAME:NAME", d.Id())
+				}
+				logGroupName := idParts[0]
+				name := idParts[1]
+				d.Set("log_group_name", logGroupName)
+				d.Set("name", name)
+				d.SetId(name)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -39,6 +39,12 @@ func TestAccAWSCloudWatchLogMetricFilter_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      "aws_cloudwatch_log_metric_filter.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSCloudWatchLogMetricFilterImportStateIdFunc("aws_cloudwatch_log_metric_filter.foobar"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSCloudWatchLogMetricFilterConfigModified(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -68,6 +74,17 @@ func TestAccAWSCloudWatchLogMetricFilter_basic(t *testing.T) {
 	})
 }
 
+func testAccAWSCloudWatchLogMetricFilterImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["log_group_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccCheckCloudWatchLogMetricFilterName(mf *cloudwatchlogs.MetricFilter, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if name != *mf.FilterName {
@@ -26,10 +26,27 @@ func TestAccAWSCloudWatchLogStream_basic(t *testing.T) {
 					testAccCheckCloudWatchLogStreamExists("aws_cloudwatch_log_stream.foobar", &ls),
 				),
 			},
+			{
+				ResourceName:      "aws_cloudwatch_log_stream.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSCloudWatchLogStreamImportStateIdFunc("aws_cloudwatch_log_stream.foobar"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccAWSCloudWatchLogStreamImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["log_group_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func TestAccAWSCloudWatchLogStream_disappears(t *testing.T) {
 	var ls cloudwatchlogs.LogStream
 	rName := acctest.RandString(15)
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/codecommit"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCodeCommitRepository() *schema.Resource {
@@ -20,6 +21,8 @@ func resourceAwsCodeCommitRepository() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"repository_name": {
 				Type:         schema.TypeString,
@@ -58,7 +61,8 @@ func resourceAwsCodeCommitRepository() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -69,7 +73,7 @@ func resourceAwsCodeCommitRepositoryCreate(d *schema.ResourceData, meta interfac
 	input := &codecommit.CreateRepositoryInput{
 		RepositoryName:        aws.String(d.Get("repository_name").(string)),
 		RepositoryDescription: aws.String(d.Get("description").(string)),
-		Tags:                  tagsFromMapCodeCommit(d.Get("tags").(map[string]interface{})),
+		Tags:                  keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().CodecommitTags(),
 	}
 
 	out, err := conn.CreateRepository(input)
@@ -104,8 +108,12 @@ func resourceAwsCodeCommitRepositoryUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if !d.IsNewResource() {
-		if err := setTagsCodeCommit(conn, d); err != nil {
-			return fmt.Errorf("error updating CodeCommit Repository tags for %s: %s", d.Id(), err)
+		if d.HasChange("tags_all") {
+			o, n := d.GetChange("tags_all")
+
+			if err := keyvaluetags.CodecommitUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+				return fmt.Errorf("error updating CodeCommit Repository tags for %s: %s", d.Id(), err)
+			}
 		}
 	}
 	return resourceAwsCodeCommitRepositoryRead(d, meta)
@@ -149,7 +157,7 @@ func resourceAwsCodeCommitRepositoryRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return fmt.Errorf("error listing CodeCommit Repository tags for %s: %s", d.Id(), err)
 	}
-	if err := d.Set("tags", tagsToMapCodeCommit(tagList.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.CodecommitKeyValueTags(tagList.Tags).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -14,6 +14,9 @@ func resourceAwsCodeCommitTrigger() *schema.Resource {
 		Create: resourceAwsCodeCommitTriggerCreate,
 		Read:   resourceAwsCodeCommitTriggerRead,
 		Delete: resourceAwsCodeCommitTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"repository_name": {
@@ -102,12 +105,24 @@ func resourceAwsCodeCommitTriggerRead(d *schema.ResourceData, meta interface{})
 	}
 
 	resp, err := conn.GetRepositoryTriggers(input)
+	if isAWSErr(err, codecommit.ErrCodeRepositoryDoesNotExistException, "") {
+		log.Printf("[WARN] CodeCommit Repository (%s) not found, removing trigger from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Error reading CodeCommit Trigger: %s", err.Error())
 	}
 
 	log.Printf("[DEBUG] CodeCommit Trigger: %s", resp)
 
+	d.Set("configuration_id", resp.ConfigurationId)
+	d.Set("repository_name", d.Id())
+
+	if err := d.Set("trigger", flattenAwsCodeCommitTriggers(resp.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %s", err)
+	}
+
 	return nil
 }
 
@@ -157,3 +172,17 @@ func expandAwsCodeCommitTriggers(configured []interface{}) []*codecommit.Reposit
 	}
 	return triggers
 }
+
+func flattenAwsCodeCommitTriggers(triggers []*codecommit.RepositoryTrigger) []interface{} {
+	result := make([]interface{}, 0, len(triggers))
+	for _, t := range triggers {
+		result = append(result, map[string]interface{}{
+			"branches":        flattenStringList(t.Branches),
+			"custom_data":     aws.StringValue(t.CustomData),
+			"destination_arn": aws.StringValue(t.DestinationArn),
+			"events":          flattenStringList(t.Events),
+			"name":            aws.StringValue(t.Name),
+		})
+	}
+	return result
+}
@@ -25,6 +25,11 @@ func TestAccAWSCodeCommitTrigger_basic(t *testing.T) {
 						"aws_codecommit_trigger.test", "trigger.#", "1"),
 				),
 			},
+			{
+				ResourceName:      "aws_codecommit_trigger.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
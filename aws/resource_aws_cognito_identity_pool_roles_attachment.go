@@ -17,6 +17,9 @@ func resourceAwsCognitoIdentityPoolRolesAttachment() *schema.Resource {
 		Read:   resourceAwsCognitoIdentityPoolRolesAttachmentRead,
 		Update: resourceAwsCognitoIdentityPoolRolesAttachmentUpdate,
 		Delete: resourceAwsCognitoIdentityPoolRolesAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"identity_pool_id": {
@@ -151,7 +154,7 @@ func resourceAwsCognitoIdentityPoolRolesAttachmentRead(d *schema.ResourceData, m
 	log.Printf("[DEBUG] Reading Cognito Identity Pool Roles Association: %s", d.Id())
 
 	ip, err := conn.GetIdentityPoolRoles(&cognitoidentity.GetIdentityPoolRolesInput{
-		IdentityPoolId: aws.String(d.Get("identity_pool_id").(string)),
+		IdentityPoolId: aws.String(d.Id()),
 	})
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
@@ -162,6 +165,8 @@ func resourceAwsCognitoIdentityPoolRolesAttachmentRead(d *schema.ResourceData, m
 		return err
 	}
 
+	d.Set("identity_pool_id", ip.IdentityPoolId)
+
 	if err := d.Set("roles", flattenCognitoIdentityPoolRoles(ip.Roles)); err != nil {
 		return fmt.Errorf("Error setting roles error: %#v", err)
 	}
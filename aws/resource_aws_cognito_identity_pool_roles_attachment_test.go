@@ -31,6 +31,11 @@ func TestAccAWSCognitoIdentityPoolRolesAttachment_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_cognito_identity_pool_roles_attachment.main", "roles.authenticated"),
 				),
 			},
+			{
+				ResourceName:      "aws_cognito_identity_pool_roles_attachment.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSCognitoIdentityPoolRolesAttachmentConfig_basic(updatedName),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/configservice"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsConfigAggregateAuthorization() *schema.Resource {
@@ -22,6 +23,8 @@ func resourceAwsConfigAggregateAuthorization() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -38,7 +41,8 @@ func resourceAwsConfigAggregateAuthorization() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -52,7 +56,7 @@ func resourceAwsConfigAggregateAuthorizationPut(d *schema.ResourceData, meta int
 	req := &configservice.PutAggregationAuthorizationInput{
 		AuthorizedAccountId: aws.String(accountId),
 		AuthorizedAwsRegion: aws.String(region),
-		Tags:                tagsFromMapConfigService(d.Get("tags").(map[string]interface{})),
+		Tags:                keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().ConfigserviceTags(),
 	}
 
 	_, err := conn.PutAggregationAuthorization(req)
@@ -97,13 +101,19 @@ func resourceAwsConfigAggregateAuthorizationRead(d *schema.ResourceData, meta in
 
 	d.Set("arn", aggregationAuthorization.AggregationAuthorizationArn)
 
-	if err := saveTagsConfigService(conn, d, aws.StringValue(aggregationAuthorization.AggregationAuthorizationArn)); err != nil {
+	tags, err := keyvaluetags.ConfigserviceListTags(conn, aws.StringValue(aggregationAuthorization.AggregationAuthorizationArn))
+
+	if err != nil {
 		if isAWSErr(err, configservice.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Aggregate Authorization not found, removing from state: %s", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error setting tags for %s: %s", d.Id(), err)
+		return fmt.Errorf("error listing tags for %s: %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	return nil
@@ -112,13 +122,17 @@ func resourceAwsConfigAggregateAuthorizationRead(d *schema.ResourceData, meta in
 func resourceAwsConfigAggregateAuthorizationUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).configconn
 
-	if err := setTagsConfigService(conn, d, d.Get("arn").(string)); err != nil {
-		if isAWSErr(err, configservice.ErrCodeResourceNotFoundException, "") {
-			log.Printf("[WARN] Aggregate Authorization not found, removing from state: %s", d.Id())
-			d.SetId("")
-			return nil
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ConfigserviceUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			if isAWSErr(err, configservice.ErrCodeResourceNotFoundException, "") {
+				log.Printf("[WARN] Aggregate Authorization not found, removing from state: %s", d.Id())
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 		}
-		return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 	}
 	return resourceAwsConfigAggregateAuthorizationRead(d, meta)
 }
@@ -14,6 +14,9 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/waiter"
 )
 
 func resourceAwsDbInstance() *schema.Resource {
@@ -27,11 +30,13 @@ func resourceAwsDbInstance() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(40 * time.Minute),
-			Update: schema.DefaultTimeout(80 * time.Minute),
-			Delete: schema.DefaultTimeout(40 * time.Minute),
+			Create: schema.DefaultTimeout(waiter.DBInstanceCreateTimeout),
+			Update: schema.DefaultTimeout(waiter.DBInstanceUpdateTimeout),
+			Delete: schema.DefaultTimeout(waiter.DBInstanceDeleteTimeout),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -475,7 +480,8 @@ func resourceAwsDbInstance() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -499,7 +505,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	// we expect everything to be in sync before returning completion.
 	var requiresRebootDbInstance bool
 
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().RdsTags()
 
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
@@ -823,19 +829,9 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		log.Println(
 			"[INFO] Waiting for DB Instance to be available")
 
-		stateConf := &resource.StateChangeConf{
-			Pending:    resourceAwsDbInstanceCreatePendingStates,
-			Target:     []string{"available", "storage-optimization"},
-			Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-			MinTimeout: 10 * time.Second,
-			Delay:      30 * time.Second, // Wait 30 secs before starting
-		}
-
-		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = waiter.DBInstanceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return err
+			return fmt.Errorf("error waiting for DB Instance (%s) creation: %s", d.Id(), err)
 		}
 
 		return resourceAwsDbInstanceRead(d, meta)
@@ -1200,19 +1196,10 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(d.Get("identifier").(string))
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsDbInstanceCreatePendingStates,
-		Target:     []string{"available", "storage-optimization"},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
-	}
-
 	log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
-	_, err := stateConf.WaitForState()
+	_, err := waiter.DBInstanceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return fmt.Errorf("error waiting for DB Instance (%s) creation: %s", d.Id(), err)
 	}
 
 	if requiresModifyDbInstance {
@@ -1225,7 +1212,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
-		err = waitUntilAwsDbInstanceIsAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
+		_, err = waiter.DBInstanceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
 		}
@@ -1243,7 +1230,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
-		err = waitUntilAwsDbInstanceIsAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
+		_, err = waiter.DBInstanceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
 		}
@@ -1253,16 +1240,18 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	v, err := resourceAwsDbInstanceRetrieve(d.Id(), meta.(*AWSClient).rdsconn)
+	v, err := finder.DBInstanceByID(meta.(*AWSClient).rdsconn, d.Id())
 
-	if err != nil {
-		return err
-	}
-	if v == nil {
+	if isResourceNotFoundError(err) {
+		log.Printf("[WARN] DB Instance (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading DB Instance (%s): %s", d.Id(), err)
+	}
+
 	d.Set("name", v.DBName)
 	d.Set("identifier", v.DBInstanceIdentifier)
 	d.Set("resource_id", v.DbiResourceId)
@@ -1352,7 +1341,9 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if len(resp.TagList) > 0 {
 		dt = resp.TagList
 	}
-	d.Set("tags", tagsToMapRDS(dt))
+	if err := setTagsAll(d, meta, keyvaluetags.RdsKeyValueTags(dt).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	// Create an empty schema.Set to hold all vpc security group ids
 	ids := &schema.Set{
@@ -1415,33 +1406,12 @@ func resourceAwsDbInstanceDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Println("[INFO] Waiting for DB Instance to be destroyed")
-	return waitUntilAwsDbInstanceIsDeleted(d.Id(), conn, d.Timeout(schema.TimeoutDelete))
-}
-
-func waitUntilAwsDbInstanceIsAvailableAfterUpdate(id string, conn *rds.RDS, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsDbInstanceUpdatePendingStates,
-		Target:     []string{"available", "storage-optimization"},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(id, conn),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
+	_, err = waiter.DBInstanceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("error waiting for DB Instance (%s) deletion: %s", d.Id(), err)
 	}
-	_, err := stateConf.WaitForState()
-	return err
-}
 
-func waitUntilAwsDbInstanceIsDeleted(id string, conn *rds.RDS, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsDbInstanceDeletePendingStates,
-		Target:     []string{},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(id, conn),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
-	}
-	_, err := stateConf.WaitForState()
-	return err
+	return nil
 }
 
 func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -1666,7 +1636,7 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Waiting for DB Instance (%s) to be available", d.Id())
-		err = waitUntilAwsDbInstanceIsAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
+		_, err = waiter.DBInstanceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
 		}
@@ -1694,11 +1664,14 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.RdsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -1707,32 +1680,6 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 	return resourceAwsDbInstanceRead(d, meta)
 }
 
-// resourceAwsDbInstanceRetrieve fetches DBInstance information from the AWS
-// API. It returns an error if there is a communication problem or unexpected
-// error with AWS. When the DBInstance is not found, it returns no error and a
-// nil pointer.
-func resourceAwsDbInstanceRetrieve(id string, conn *rds.RDS) (*rds.DBInstance, error) {
-	opts := rds.DescribeDBInstancesInput{
-		DBInstanceIdentifier: aws.String(id),
-	}
-
-	log.Printf("[DEBUG] DB Instance describe configuration: %#v", opts)
-
-	resp, err := conn.DescribeDBInstances(&opts)
-	if err != nil {
-		if isAWSErr(err, rds.ErrCodeDBInstanceNotFoundFault, "") {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("Error retrieving DB Instances: %s", err)
-	}
-
-	if len(resp.DBInstances) != 1 || resp.DBInstances[0] == nil || aws.StringValue(resp.DBInstances[0].DBInstanceIdentifier) != id {
-		return nil, nil
-	}
-
-	return resp.DBInstances[0], nil
-}
-
 func resourceAwsDbInstanceImport(
 	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
@@ -1742,27 +1689,6 @@ func resourceAwsDbInstanceImport(
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceAwsDbInstanceStateRefreshFunc(id string, conn *rds.RDS) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		v, err := resourceAwsDbInstanceRetrieve(id, conn)
-
-		if err != nil {
-			log.Printf("Error on retrieving DB Instance when waiting: %s", err)
-			return nil, "", err
-		}
-
-		if v == nil {
-			return nil, "", nil
-		}
-
-		if v.DBInstanceStatus != nil {
-			log.Printf("[DEBUG] DB Instance status for instance %s: %s", id, *v.DBInstanceStatus)
-		}
-
-		return v, *v.DBInstanceStatus, nil
-	}
-}
-
 func buildCloudwatchLogsExportConfiguration(d *schema.ResourceData) *rds.CloudwatchLogsExportConfiguration {
 
 	oraw, nraw := d.GetChange("enabled_cloudwatch_logs_exports")
@@ -1795,53 +1721,3 @@ func diffCloudwatchLogsExportConfiguration(old, new []interface{}) ([]interface{
 
 	return create, disable
 }
-
-// Database instance status: http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Overview.DBInstance.Status.html
-var resourceAwsDbInstanceCreatePendingStates = []string{
-	"backing-up",
-	"configuring-enhanced-monitoring",
-	"configuring-iam-database-auth",
-	"configuring-log-exports",
-	"creating",
-	"maintenance",
-	"modifying",
-	"rebooting",
-	"renaming",
-	"resetting-master-credentials",
-	"starting",
-	"stopping",
-	"upgrading",
-}
-
-var resourceAwsDbInstanceDeletePendingStates = []string{
-	"available",
-	"backing-up",
-	"configuring-enhanced-monitoring",
-	"configuring-log-exports",
-	"creating",
-	"deleting",
-	"incompatible-parameters",
-	"modifying",
-	"starting",
-	"stopping",
-	"storage-full",
-	"storage-optimization",
-}
-
-var resourceAwsDbInstanceUpdatePendingStates = []string{
-	"backing-up",
-	"configuring-enhanced-monitoring",
-	"configuring-iam-database-auth",
-	"configuring-log-exports",
-	"creating",
-	"maintenance",
-	"modifying",
-	"moving-to-vpc",
-	"rebooting",
-	"renaming",
-	"resetting-master-credentials",
-	"starting",
-	"stopping",
-	"storage-full",
-	"upgrading",
-}
@@ -6,7 +6,6 @@ import (
 	"os"
 	"regexp"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -14,10 +13,12 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance", &sweep.Sweeper{
 		Name: "aws_db_instance",
 		F:    testSweepDbInstances,
 	})
@@ -44,7 +45,11 @@ func testSweepDbInstances(region string) error {
 				continue
 			}
 
-			err = waitUntilAwsDbInstanceIsDeleted(*dbi.DBInstanceIdentifier, conn, 40*time.Minute)
+			if testSweepDryRun() {
+				continue
+			}
+
+			_, err = waiter.DBInstanceDeleted(conn, *dbi.DBInstanceIdentifier, waiter.DBInstanceDeleteTimeout)
 			if err != nil {
 				log.Printf("[ERROR] Failure while waiting for DB instance %s to be deleted: %s",
 					*dbi.DBInstanceIdentifier, err)
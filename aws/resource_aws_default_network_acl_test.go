@@ -41,6 +41,11 @@ func TestAccAWSDefaultNetworkAcl_basic(t *testing.T) {
 					testAccCheckResourceAttrAccountID("aws_default_network_acl.default", "owner_id"),
 				),
 			},
+			{
+				ResourceName:      "aws_default_network_acl.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
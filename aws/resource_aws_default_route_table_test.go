@@ -27,6 +27,12 @@ func TestAccAWSDefaultRouteTable_basic(t *testing.T) {
 					testAccCheckResourceAttrAccountID("aws_default_route_table.foo", "owner_id"),
 				),
 			},
+			{
+				ResourceName:      "aws_default_route_table.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSDefaultRouteTableImportStateIdFunc("aws_default_route_table.foo"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccDefaultRouteTableConfig_noRouteBlock,
 				Check: resource.ComposeTestCheckFunc(
@@ -47,6 +53,17 @@ func TestAccAWSDefaultRouteTable_basic(t *testing.T) {
 	})
 }
 
+func testAccAWSDefaultRouteTableImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["vpc_id"], nil
+	}
+}
+
 func TestAccAWSDefaultRouteTable_swap(t *testing.T) {
 	var v ec2.RouteTable
 
@@ -15,6 +15,12 @@ func resourceAwsDefaultSecurityGroup() *schema.Resource {
 	dsg.Create = resourceAwsDefaultSecurityGroupCreate
 	dsg.Delete = resourceAwsDefaultSecurityGroupDelete
 
+	// Rules are managed inline, so they are not split out into separate
+	// aws_security_group_rule resources on import
+	dsg.Importer = &schema.ResourceImporter{
+		State: schema.ImportStatePassthrough,
+	}
+
 	// Descriptions cannot be updated
 	delete(dsg.Schema, "description")
 
@@ -39,6 +39,12 @@ func TestAccAWSDefaultSecurityGroup_basic(t *testing.T) {
 						"aws_default_security_group.web", "ingress.3629188364.cidr_blocks.0", "10.0.0.0/8"),
 				),
 			},
+			{
+				ResourceName:            "aws_default_security_group.web",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"revoke_rules_on_delete"},
+			},
 		},
 	})
 }
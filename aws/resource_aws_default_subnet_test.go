@@ -33,6 +33,11 @@ func TestAccAWSDefaultSubnet_basic(t *testing.T) {
 					testAccCheckResourceAttrAccountID("aws_default_subnet.foo", "owner_id"),
 				),
 			},
+			{
+				ResourceName:      "aws_default_subnet.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
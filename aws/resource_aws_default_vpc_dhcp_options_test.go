@@ -31,6 +31,11 @@ func TestAccAWSDefaultVpcDhcpOptions_basic(t *testing.T) {
 					testAccCheckResourceAttrAccountID("aws_default_vpc_dhcp_options.foo", "owner_id"),
 				),
 			},
+			{
+				ResourceName:      "aws_default_vpc_dhcp_options.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
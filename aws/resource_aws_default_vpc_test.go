@@ -38,6 +38,11 @@ func TestAccAWSDefaultVpc_basic(t *testing.T) {
 					testAccCheckResourceAttrAccountID("aws_default_vpc.foo", "owner_id"),
 				),
 			},
+			{
+				ResourceName:      "aws_default_vpc.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
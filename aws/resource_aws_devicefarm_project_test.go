@@ -31,6 +31,11 @@ func TestAccAWSDeviceFarmProject_basic(t *testing.T) {
 						"aws_devicefarm_project.foo", "name", fmt.Sprintf("tf-testproject-%d", beforeInt)),
 				),
 			},
+			{
+				ResourceName:      "aws_devicefarm_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 
 			{
 				Config: testAccDeviceFarmProjectConfig(afterInt),
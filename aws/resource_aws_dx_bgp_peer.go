@@ -3,6 +3,8 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +19,9 @@ func resourceAwsDxBgpPeer() *schema.Resource {
 		Create: resourceAwsDxBgpPeerCreate,
 		Read:   resourceAwsDxBgpPeerRead,
 		Delete: resourceAwsDxBgpPeerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDxBgpPeerImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"address_family": {
@@ -145,12 +150,15 @@ func resourceAwsDxBgpPeerRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	bgpPeer := bgpPeerRaw.(*directconnect.BGPPeer)
+	d.Set("address_family", bgpPeer.AddressFamily)
 	d.Set("amazon_address", bgpPeer.AmazonAddress)
+	d.Set("bgp_asn", bgpPeer.Asn)
 	d.Set("bgp_auth_key", bgpPeer.AuthKey)
 	d.Set("customer_address", bgpPeer.CustomerAddress)
 	d.Set("bgp_status", bgpPeer.BgpStatus)
 	d.Set("bgp_peer_id", bgpPeer.BgpPeerId)
 	d.Set("aws_device", bgpPeer.AwsDeviceV2)
+	d.Set("virtual_interface_id", vifId)
 
 	return nil
 }
@@ -218,3 +226,24 @@ func dxBgpPeerStateRefresh(conn *directconnect.DirectConnect, vifId, addrFamily
 		return "", directconnect.BGPPeerStateDeleted, nil
 	}
 }
+
+func resourceAwsDxBgpPeerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected VIRTUAL-INTERFACE-ID/ADDRESS-FAMILY/BGP-ASN", d.Id())
+	}
+
+	vifId := idParts[0]
+	addrFamily := idParts[1]
+	asn, err := strconv.ParseInt(idParts[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing BGP ASN (%s): %s", idParts[2], err)
+	}
+
+	d.Set("address_family", addrFamily)
+	d.Set("bgp_asn", asn)
+	d.Set("virtual_interface_id", vifId)
+	d.SetId(fmt.Sprintf("%s-%s-%d", vifId, addrFamily, asn))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -32,6 +32,12 @@ func TestAccAwsDxBgpPeer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_dx_bgp_peer.foo", "address_family", "ipv6"),
 				),
 			},
+			{
+				ResourceName:      "aws_dx_bgp_peer.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsDxBgpPeerImportStateIdFunc("aws_dx_bgp_peer.foo"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -73,6 +79,17 @@ func testAccCheckAwsDxBgpPeerExists(name string) resource.TestCheckFunc {
 	}
 }
 
+func testAccAwsDxBgpPeerImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["virtual_interface_id"], rs.Primary.Attributes["address_family"], rs.Primary.Attributes["bgp_asn"]), nil
+	}
+}
+
 func testAccDxBgpPeerConfig(vifId string, bgpAsn int) string {
 	return fmt.Sprintf(`
 resource "aws_dx_bgp_peer" "foo" {
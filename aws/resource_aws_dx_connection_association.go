@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +16,9 @@ func resourceAwsDxConnectionAssociation() *schema.Resource {
 		Create: resourceAwsDxConnectionAssociationCreate,
 		Read:   resourceAwsDxConnectionAssociationRead,
 		Delete: resourceAwsDxConnectionAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"connection_id": {
@@ -65,11 +69,15 @@ func resourceAwsDxConnectionAssociationRead(d *schema.ResourceData, meta interfa
 	if len(resp.Connections) != 1 {
 		return fmt.Errorf("Found %d DX connections for %s, expected 1", len(resp.Connections), d.Id())
 	}
-	if *resp.Connections[0].LagId != d.Get("lag_id").(string) {
+	if aws.StringValue(resp.Connections[0].LagId) == "" {
+		log.Printf("[WARN] Direct Connect connection (%s) is not associated with a LAG, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	d.Set("connection_id", resp.Connections[0].ConnectionId)
+	d.Set("lag_id", resp.Connections[0].LagId)
+
 	return nil
 }
 
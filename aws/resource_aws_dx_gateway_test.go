@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway", &sweep.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    testSweepDirectConnectGateways,
 		Dependencies: []string{
@@ -67,6 +68,10 @@ func testSweepDirectConnectGateways(region string) error {
 				return fmt.Errorf("error deleting Direct Connect Gateway (%s): %s", id, err)
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			if err := waitForDirectConnectGatewayDeletion(conn, id, 20*time.Minute); err != nil {
 				return fmt.Errorf("error waiting for Direct Connect Gateway (%s) to be deleted: %s", id, err)
 			}
@@ -16,6 +16,9 @@ func resourceAwsEbsEncryptionByDefault() *schema.Resource {
 		Read:   resourceAwsEbsEncryptionByDefaultRead,
 		Update: resourceAwsEbsEncryptionByDefaultUpdate,
 		Delete: resourceAwsEbsEncryptionByDefaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"enabled": {
@@ -25,6 +25,11 @@ func TestAccAWSEBSEncryptionByDefault_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAwsEbsEncryptionByDefaultConfig(true),
 				Check: resource.ComposeTestCheckFunc(
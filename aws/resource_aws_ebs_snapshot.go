@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEbsSnapshot() *schema.Resource {
@@ -16,6 +17,11 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 		Create: resourceAwsEbsSnapshotCreate,
 		Read:   resourceAwsEbsSnapshotRead,
 		Delete: resourceAwsEbsSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -58,11 +64,8 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ForceNew: true,
-			},
+			"tags":     tagsSchemaForceNew(),
+			"tags_all": tagsSchemaTrulyComputedForceNew(),
 		},
 	}
 }
@@ -106,8 +109,14 @@ func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := setTags(conn, d); err != nil {
-		log.Printf("[WARN] error setting tags: %s", err)
+	if tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().Ec2Tags(); len(tags) > 0 {
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: []*string{aws.String(d.Id())},
+			Tags:      tags,
+		})
+		if err != nil {
+			log.Printf("[WARN] error setting tags: %s", err)
+		}
 	}
 
 	return resourceAwsEbsSnapshotRead(d, meta)
@@ -145,7 +154,7 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(snapshot.Tags).IgnoreAws().Map()); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEbsSnapshotCopy() *schema.Resource {
@@ -17,6 +18,8 @@ func resourceAwsEbsSnapshotCopy() *schema.Resource {
 		Read:   resourceAwsEbsSnapshotCopyRead,
 		Delete: resourceAwsEbsSnapshotCopyDelete,
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"volume_id": {
 				Type:     schema.TypeString,
@@ -63,11 +66,8 @@ func resourceAwsEbsSnapshotCopy() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ForceNew: true,
-			},
+			"tags":     tagsSchemaForceNew(),
+			"tags_all": tagsSchemaTrulyComputedForceNew(),
 		},
 	}
 }
@@ -101,8 +101,14 @@ func resourceAwsEbsSnapshotCopyCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if err := setTags(conn, d); err != nil {
-		log.Printf("[WARN] error setting tags: %s", err)
+	if tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().Ec2Tags(); len(tags) > 0 {
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: []*string{aws.String(d.Id())},
+			Tags:      tags,
+		})
+		if err != nil {
+			log.Printf("[WARN] error setting tags: %s", err)
+		}
 	}
 
 	return resourceAwsEbsSnapshotCopyRead(d, meta)
@@ -132,7 +138,7 @@ func resourceAwsEbsSnapshotCopyRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(snapshot.Tags).IgnoreAws().Map()); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
@@ -79,6 +79,11 @@ func TestAccAWSEBSSnapshot_withDescription(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_ebs_snapshot.test", "description", rName),
 				),
 			},
+			{
+				ResourceName:      "aws_ebs_snapshot.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
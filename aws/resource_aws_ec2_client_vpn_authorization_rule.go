@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEc2ClientVpnAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnAuthorizationRuleCreate,
+		Read:   resourceAwsEc2ClientVpnAuthorizationRuleRead,
+		Delete: resourceAwsEc2ClientVpnAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnAuthorizationRuleImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ClientVpnAuthorizationRuleActiveTimeout),
+			Delete: schema.DefaultTimeout(waiter.ClientVpnAuthorizationRuleRevokedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"authorize_all_groups"},
+			},
+			"authorize_all_groups": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"access_group_id"},
+			},
+			"client_vpn_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_network_cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetNetworkCidr := d.Get("target_network_cidr").(string)
+	accessGroupID := d.Get("access_group_id").(string)
+
+	if accessGroupID == "" && !d.Get("authorize_all_groups").(bool) {
+		return fmt.Errorf("one of access_group_id or authorize_all_groups must be set")
+	}
+
+	input := &ec2.AuthorizeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		input.AccessGroupId = aws.String(accessGroupID)
+	} else {
+		input.AuthorizeAllGroups = aws.Bool(true)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Client VPN authorization rule: %s", input)
+	_, err := conn.AuthorizeClientVpnIngress(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Client VPN authorization rule: %s", err)
+	}
+
+	d.SetId(ec2ClientVpnAuthorizationRuleCreateID(endpointID, targetNetworkCidr, accessGroupID))
+
+	if _, err := waiter.ClientVpnAuthorizationRuleActive(conn, endpointID, targetNetworkCidr, accessGroupID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Client VPN authorization rule (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnAuthorizationRuleRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	rule, err := finder.ClientVpnAuthorizationRule(conn, endpointID, targetNetworkCidr, accessGroupID)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Client VPN authorization rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Client VPN authorization rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_group_id", rule.GroupId)
+	d.Set("authorize_all_groups", rule.AccessAll)
+	d.Set("client_vpn_endpoint_id", rule.ClientVpnEndpointId)
+	d.Set("description", rule.Description)
+	d.Set("target_network_cidr", rule.DestinationCidr)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.RevokeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		input.AccessGroupId = aws.String(accessGroupID)
+	} else {
+		input.RevokeAllGroups = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Revoking Client VPN authorization rule: %s", input)
+	_, err = conn.RevokeClientVpnIngress(input)
+
+	if isAWSErr(err, finder.ErrCodeClientVpnEndpointIdNotFound, "") || isAWSErr(err, finder.ErrCodeClientVpnAuthorizationRuleNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking Client VPN authorization rule (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.ClientVpnAuthorizationRuleRevoked(conn, endpointID, targetNetworkCidr, accessGroupID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Client VPN authorization rule (%s) to be revoked: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := ec2ClientVpnAuthorizationRuleParseID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Authorization rules have no identifier of their own, so the ID is made up of
+// the endpoint ID, the target network CIDR and, if set, the access group ID.
+// CIDR blocks contain "/", so the parts are separated by ",".
+const ec2ClientVpnAuthorizationRuleIDSeparator = ","
+
+func ec2ClientVpnAuthorizationRuleCreateID(endpointID, targetNetworkCidr, accessGroupID string) string {
+	parts := []string{endpointID, targetNetworkCidr}
+
+	if accessGroupID != "" {
+		parts = append(parts, accessGroupID)
+	}
+
+	return strings.Join(parts, ec2ClientVpnAuthorizationRuleIDSeparator)
+}
+
+func ec2ClientVpnAuthorizationRuleParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ec2ClientVpnAuthorizationRuleIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], "", nil
+	}
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected ENDPOINT-ID,TARGET-NETWORK-CIDR or ENDPOINT-ID,TARGET-NETWORK-CIDR,ACCESS-GROUP-ID", id)
+}
@@ -0,0 +1,320 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestEc2ClientVpnAuthorizationRuleParseID(t *testing.T) {
+	testCases := []struct {
+		Input                     string
+		ExpectedEndpointID        string
+		ExpectedTargetNetworkCidr string
+		ExpectedAccessGroupID     string
+		ErrCount                  int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "cvpn-endpoint-0ac3a1abbccddd666",
+			ErrCount: 1,
+		},
+		{
+			Input:    "cvpn-endpoint-0ac3a1abbccddd666,,",
+			ErrCount: 1,
+		},
+		{
+			Input:    "cvpn-endpoint-0ac3a1abbccddd666,10.1.0.0/24,group-1,extra",
+			ErrCount: 1,
+		},
+		{
+			Input:                     "cvpn-endpoint-0ac3a1abbccddd666,10.1.0.0/24",
+			ExpectedEndpointID:        "cvpn-endpoint-0ac3a1abbccddd666",
+			ExpectedTargetNetworkCidr: "10.1.0.0/24",
+		},
+		{
+			Input:                     "cvpn-endpoint-0ac3a1abbccddd666,10.1.0.0/24,group-1",
+			ExpectedEndpointID:        "cvpn-endpoint-0ac3a1abbccddd666",
+			ExpectedTargetNetworkCidr: "10.1.0.0/24",
+			ExpectedAccessGroupID:     "group-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if endpointID != tc.ExpectedEndpointID {
+			t.Fatalf("expected %q to return endpoint ID %q, received: %q", tc.Input, tc.ExpectedEndpointID, endpointID)
+		}
+		if targetNetworkCidr != tc.ExpectedTargetNetworkCidr {
+			t.Fatalf("expected %q to return target network CIDR %q, received: %q", tc.Input, tc.ExpectedTargetNetworkCidr, targetNetworkCidr)
+		}
+		if accessGroupID != tc.ExpectedAccessGroupID {
+			t.Fatalf("expected %q to return access group ID %q, received: %q", tc.Input, tc.ExpectedAccessGroupID, accessGroupID)
+		}
+	}
+}
+
+func TestAccAwsEc2ClientVpnAuthorizationRule_basic(t *testing.T) {
+	var rule ec2.AuthorizationRule
+	rStr := acctest.RandString(5)
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigBasic(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "target_network_cidr", "10.1.1.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "authorize_all_groups", "true"),
+					resource.TestCheckResourceAttr(resourceName, "access_group_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsEc2ClientVpnAuthorizationRule_groups(t *testing.T) {
+	var rule1, rule2 ec2.AuthorizationRule
+	rStr := acctest.RandString(5)
+	resource1Name := "aws_ec2_client_vpn_authorization_rule.test1"
+	resource2Name := "aws_ec2_client_vpn_authorization_rule.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigGroups(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resource1Name, &rule1),
+					resource.TestCheckResourceAttr(resource1Name, "access_group_id", "group-1"),
+					resource.TestCheckResourceAttr(resource1Name, "authorize_all_groups", "false"),
+					resource.TestCheckResourceAttr(resource1Name, "description", "group one"),
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resource2Name, &rule2),
+					resource.TestCheckResourceAttr(resource2Name, "access_group_id", "group-2"),
+					resource.TestCheckResourceAttr(resource2Name, "authorize_all_groups", "false"),
+				),
+			},
+			{
+				ResourceName:      resource1Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsEc2ClientVpnAuthorizationRule_disappears(t *testing.T) {
+	var rule ec2.AuthorizationRule
+	rStr := acctest.RandString(5)
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigBasic(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName, &rule),
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleDisappears(&rule),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_authorization_rule" {
+			continue
+		}
+
+		endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ClientVpnAuthorizationRule(conn, endpointID, targetNetworkCidr, accessGroupID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Client VPN authorization rule (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleDisappears(rule *ec2.AuthorizationRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.RevokeClientVpnIngressInput{
+			ClientVpnEndpointId: rule.ClientVpnEndpointId,
+			TargetNetworkCidr:   rule.DestinationCidr,
+		}
+
+		var accessGroupID string
+		if aws.BoolValue(rule.AccessAll) {
+			input.RevokeAllGroups = aws.Bool(true)
+		} else {
+			accessGroupID = aws.StringValue(rule.GroupId)
+			input.AccessGroupId = aws.String(accessGroupID)
+		}
+
+		if _, err := conn.RevokeClientVpnIngress(input); err != nil {
+			return err
+		}
+
+		_, err := waiter.ClientVpnAuthorizationRuleRevoked(conn, aws.StringValue(rule.ClientVpnEndpointId), aws.StringValue(rule.DestinationCidr), accessGroupID, waiter.ClientVpnAuthorizationRuleRevokedTimeout)
+
+		return err
+	}
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(name string, rule *ec2.AuthorizationRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.ClientVpnAuthorizationRule(conn, endpointID, targetNetworkCidr, accessGroupID)
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Status.Code) != ec2.ClientVpnAuthorizationRuleStatusCodeActive {
+			return fmt.Errorf("Client VPN authorization rule (%s) is not active: %s", rs.Primary.ID, aws.StringValue(output.Status.Code))
+		}
+
+		*rule = *output
+
+		return nil
+	}
+}
+
+func testAccEc2ClientVpnAuthorizationRuleConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "tls_private_key" "example" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "example" {
+  key_algorithm   = "RSA"
+  private_key_pem = "${tls_private_key.example.private_key_pem}"
+
+  subject {
+    common_name  = "example.com"
+    organization = "ACME Examples, Inc"
+  }
+
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "key_encipherment",
+    "digital_signature",
+    "server_auth",
+  ]
+}
+
+resource "aws_acm_certificate" "cert" {
+  private_key      = "${tls_private_key.example.private_key_pem}"
+  certificate_body = "${tls_self_signed_cert.example.cert_pem}"
+}
+
+resource "aws_ec2_client_vpn_endpoint" "test" {
+  description            = "terraform-testacc-clientvpn-%s"
+  server_certificate_arn = "${aws_acm_certificate.cert.arn}"
+  client_cidr_block      = "10.0.0.0/16"
+
+  authentication_options {
+    type                       = "certificate-authentication"
+    root_certificate_chain_arn = "${aws_acm_certificate.cert.arn}"
+  }
+
+  connection_log_options {
+    enabled = false
+  }
+}
+`, rName)
+}
+
+func testAccEc2ClientVpnAuthorizationRuleConfigBasic(rName string) string {
+	return testAccEc2ClientVpnAuthorizationRuleConfigBase(rName) + `
+resource "aws_ec2_client_vpn_authorization_rule" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  target_network_cidr    = "10.1.1.0/24"
+  authorize_all_groups   = true
+}
+`
+}
+
+func testAccEc2ClientVpnAuthorizationRuleConfigGroups(rName string) string {
+	return testAccEc2ClientVpnAuthorizationRuleConfigBase(rName) + `
+resource "aws_ec2_client_vpn_authorization_rule" "test1" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  target_network_cidr    = "10.1.1.0/24"
+  access_group_id        = "group-1"
+  description            = "group one"
+}
+
+resource "aws_ec2_client_vpn_authorization_rule" "test2" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  target_network_cidr    = "10.1.1.0/24"
+  access_group_id        = "group-2"
+}
+`
+}
@@ -27,6 +27,12 @@ func TestAccAwsEc2ClientVpnNetworkAssociation_basic(t *testing.T) {
 					testAccCheckAwsEc2ClientVpnNetworkAssociationExists("aws_ec2_client_vpn_network_association.test", &assoc1),
 				),
 			},
+			{
+				ResourceName:      "aws_ec2_client_vpn_network_association.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsEc2ClientVpnNetworkAssociationImportStateIdFunc("aws_ec2_client_vpn_network_association.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -134,6 +140,17 @@ func testAccCheckAwsEc2ClientVpnNetworkAssociationExists(name string, assoc *ec2
 	}
 }
 
+func testAccAwsEc2ClientVpnNetworkAssociationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["client_vpn_endpoint_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccEc2ClientVpnNetworkAssociationConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEc2ClientVpnRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnRouteCreate,
+		Read:   resourceAwsEc2ClientVpnRouteRead,
+		Delete: resourceAwsEc2ClientVpnRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnRouteImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ClientVpnRouteActiveTimeout),
+			Delete: schema.DefaultTimeout(waiter.ClientVpnRouteDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_vpc_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetSubnetID := d.Get("target_vpc_subnet_id").(string)
+	destinationCidr := d.Get("destination_cidr_block").(string)
+
+	input := &ec2.CreateClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(endpointID),
+		DestinationCidrBlock: aws.String(destinationCidr),
+		TargetVpcSubnetId:    aws.String(targetSubnetID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Client VPN route: %s", input)
+	_, err := conn.CreateClientVpnRoute(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Client VPN route: %s", err)
+	}
+
+	d.SetId(ec2ClientVpnRouteCreateID(endpointID, targetSubnetID, destinationCidr))
+
+	if _, err := waiter.ClientVpnRouteActive(conn, endpointID, destinationCidr, targetSubnetID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Client VPN route (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnRouteRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnRouteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID, targetSubnetID, destinationCidr, err := ec2ClientVpnRouteParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	route, err := finder.ClientVpnRoute(conn, endpointID, destinationCidr, targetSubnetID)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Client VPN route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Client VPN route (%s): %s", d.Id(), err)
+	}
+
+	d.Set("client_vpn_endpoint_id", route.ClientVpnEndpointId)
+	d.Set("description", route.Description)
+	d.Set("destination_cidr_block", route.DestinationCidr)
+	d.Set("origin", route.Origin)
+	d.Set("target_vpc_subnet_id", route.TargetSubnet)
+	d.Set("type", route.Type)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID, targetSubnetID, destinationCidr, err := ec2ClientVpnRouteParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Client VPN route: %s", d.Id())
+	_, err = conn.DeleteClientVpnRoute(&ec2.DeleteClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(endpointID),
+		DestinationCidrBlock: aws.String(destinationCidr),
+		TargetVpcSubnetId:    aws.String(targetSubnetID),
+	})
+
+	if isAWSErr(err, finder.ErrCodeClientVpnEndpointIdNotFound, "") || isAWSErr(err, finder.ErrCodeClientVpnRouteNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Client VPN route (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.ClientVpnRouteDeleted(conn, endpointID, destinationCidr, targetSubnetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Client VPN route (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := ec2ClientVpnRouteParseID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// CIDR blocks contain "/", so the parts of the route ID are separated by ",".
+const ec2ClientVpnRouteIDSeparator = ","
+
+func ec2ClientVpnRouteCreateID(endpointID, targetSubnetID, destinationCidr string) string {
+	return strings.Join([]string{endpointID, targetSubnetID, destinationCidr}, ec2ClientVpnRouteIDSeparator)
+}
+
+func ec2ClientVpnRouteParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ec2ClientVpnRouteIDSeparator)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected ENDPOINT-ID,TARGET-SUBNET-ID,DESTINATION-CIDR-BLOCK", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAwsEc2ClientVpnRoute_basic(t *testing.T) {
+	var route ec2.ClientVpnRoute
+	rStr := acctest.RandString(5)
+	resourceName := "aws_ec2_client_vpn_route.test"
+	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
+	subnetResourceName := "aws_subnet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnRouteConfigBasic(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", endpointResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_vpc_subnet_id", subnetResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "description", "terraform-testacc-clientvpn-route"),
+					resource.TestCheckResourceAttr(resourceName, "origin", "add-route"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Nat"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsEc2ClientVpnRoute_disappears(t *testing.T) {
+	var route ec2.ClientVpnRoute
+	rStr := acctest.RandString(5)
+	resourceName := "aws_ec2_client_vpn_route.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnRouteConfigBasic(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnRouteExists(resourceName, &route),
+					testAccCheckAwsEc2ClientVpnRouteDisappears(&route),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ClientVpnRouteDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_route" {
+			continue
+		}
+
+		endpointID, targetSubnetID, destinationCidr, err := ec2ClientVpnRouteParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ClientVpnRoute(conn, endpointID, destinationCidr, targetSubnetID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Client VPN route (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2ClientVpnRouteDisappears(route *ec2.ClientVpnRoute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		_, err := conn.DeleteClientVpnRoute(&ec2.DeleteClientVpnRouteInput{
+			ClientVpnEndpointId:  route.ClientVpnEndpointId,
+			DestinationCidrBlock: route.DestinationCidr,
+			TargetVpcSubnetId:    route.TargetSubnet,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = waiter.ClientVpnRouteDeleted(conn, aws.StringValue(route.ClientVpnEndpointId), aws.StringValue(route.DestinationCidr), aws.StringValue(route.TargetSubnet), waiter.ClientVpnRouteDeletedTimeout)
+
+		return err
+	}
+}
+
+func testAccCheckAwsEc2ClientVpnRouteExists(name string, route *ec2.ClientVpnRoute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		endpointID, targetSubnetID, destinationCidr, err := ec2ClientVpnRouteParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.ClientVpnRoute(conn, endpointID, destinationCidr, targetSubnetID)
+
+		if err != nil {
+			return err
+		}
+
+		*route = *output
+
+		return nil
+	}
+}
+
+func testAccEc2ClientVpnRouteConfigBasic(rName string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + `
+resource "aws_ec2_client_vpn_route" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  destination_cidr_block = "10.2.0.0/16"
+  target_vpc_subnet_id   = "${aws_ec2_client_vpn_network_association.test.subnet_id}"
+  description            = "terraform-testacc-clientvpn-route"
+}
+`
+}
@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func TestAccAWSEc2TrafficMirrorFilterRule_basic(t *testing.T) {
+	var v ec2.TrafficMirrorFilterRule
+	resourceName := "aws_ec2_traffic_mirror_filter_rule.test"
+	filterResourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSEc2TrafficMirror(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterRuleConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule_action", ec2.TrafficMirrorRuleActionAccept),
+					resource.TestCheckResourceAttr(resourceName, "rule_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_direction", ec2.TrafficDirectionIngress),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", filterResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSEc2TrafficMirrorFilterRuleImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterRuleConfig_full(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test rule"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.0.from_port", "22"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.0.to_port", "53"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "6"),
+					resource.TestCheckResourceAttr(resourceName, "rule_action", ec2.TrafficMirrorRuleActionReject),
+					resource.TestCheckResourceAttr(resourceName, "rule_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source_cidr_block", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.0.from_port", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.0.to_port", "10"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_direction", ec2.TrafficDirectionEgress),
+				),
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterRuleConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_filter_rule" {
+			continue
+		}
+
+		_, err := finder.TrafficMirrorFilterRuleByID(conn, rs.Primary.Attributes["traffic_mirror_filter_id"], rs.Primary.ID)
+		if isResourceNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Traffic Mirror Filter Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleExists(n string, v *ec2.TrafficMirrorFilterRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Filter Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		rule, err := finder.TrafficMirrorFilterRuleByID(conn, rs.Primary.Attributes["traffic_mirror_filter_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*v = *rule
+
+		return nil
+	}
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["traffic_mirror_filter_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleConfig_basic() string {
+	return `
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  destination_cidr_block   = "10.0.0.0/8"
+  rule_action              = "accept"
+  rule_number              = 1
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+}
+`
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleConfig_full() string {
+	return `
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  description              = "test rule"
+  destination_cidr_block   = "10.1.0.0/16"
+  protocol                 = 6
+  rule_action              = "reject"
+  rule_number              = 2
+  source_cidr_block        = "10.2.0.0/16"
+  traffic_direction        = "egress"
+
+  destination_port_range {
+    from_port = 22
+    to_port   = 53
+  }
+
+  source_port_range {
+    from_port = 0
+    to_port   = 10
+  }
+}
+`
+}
@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_session", &sweep.Sweeper{
+		Name: "aws_ec2_traffic_mirror_session",
+		F:    testSweepEc2TrafficMirrorSessions,
+	})
+}
+
+func testSweepEc2TrafficMirrorSessions(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+	input := &ec2.DescribeTrafficMirrorSessionsInput{}
+	var sweeperErrs error
+
+	for {
+		output, err := conn.DescribeTrafficMirrorSessions(input)
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping EC2 Traffic Mirror Session sweep for %s: %s", region, err)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error retrieving EC2 Traffic Mirror Sessions: %s", err)
+		}
+
+		for _, session := range output.TrafficMirrorSessions {
+			id := aws.StringValue(session.TrafficMirrorSessionId)
+
+			log.Printf("[INFO] Deleting EC2 Traffic Mirror Session (%s)", id)
+			_, err := conn.DeleteTrafficMirrorSession(&ec2.DeleteTrafficMirrorSessionInput{
+				TrafficMirrorSessionId: session.TrafficMirrorSessionId,
+			})
+			if isAWSErr(err, finder.ErrCodeInvalidTrafficMirrorSessionIdNotFound, "") {
+				continue
+			}
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting EC2 Traffic Mirror Session (%s): %s", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs
+}
+
+func TestAccAWSEc2TrafficMirrorSession_basic(t *testing.T) {
+	var v ec2.TrafficMirrorSession
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	filterResourceName := "aws_ec2_traffic_mirror_filter.test"
+	targetResourceName := "aws_ec2_traffic_mirror_target.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSEc2TrafficMirror(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorSessionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", "aws_instance.source", "primary_network_interface_id"),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", filterResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_target_id", targetResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "virtual_network_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfig_full(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test session"),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "100"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_id", "16777215"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorSession_Tags(t *testing.T) {
+	var v ec2.TrafficMirrorSession
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSEc2TrafficMirror(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorSessionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_session" {
+			continue
+		}
+
+		_, err := finder.TrafficMirrorSessionByID(conn, rs.Primary.ID)
+		if isResourceNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Traffic Mirror Session %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionExists(n string, v *ec2.TrafficMirrorSession) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Session ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		session, err := finder.TrafficMirrorSessionByID(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*v = *session
+
+		return nil
+	}
+}
+
+// testAccAWSEc2TrafficMirrorSessionConfig_base returns a configuration with a
+// Nitro-based source instance, as only those can be traffic mirror sources.
+func testAccAWSEc2TrafficMirrorSessionConfig_base(rName string) string {
+	return testAccAWSEc2TrafficMirrorTargetConfig_base(rName) + fmt.Sprintf(`
+data "aws_ami" "amzn-linux" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn2-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_instance" "source" {
+  ami           = "${data.aws_ami.amzn-linux.id}"
+  instance_type = "m5.large"
+  subnet_id     = "${aws_subnet.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_target" "test" {
+  network_interface_id = "${aws_network_interface.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfig_basic(rName string) string {
+	return testAccAWSEc2TrafficMirrorSessionConfig_base(rName) + `
+resource "aws_ec2_traffic_mirror_session" "test" {
+  network_interface_id     = "${aws_instance.source.primary_network_interface_id}"
+  session_number           = 1
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+}
+`
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfig_full(rName string) string {
+	return testAccAWSEc2TrafficMirrorSessionConfig_base(rName) + `
+resource "aws_ec2_traffic_mirror_session" "test" {
+  description              = "test session"
+  network_interface_id     = "${aws_instance.source.primary_network_interface_id}"
+  packet_length            = 100
+  session_number           = 2
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+  virtual_network_id       = 16777215
+}
+`
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSEc2TrafficMirrorSessionConfig_base(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  network_interface_id     = "${aws_instance.source.primary_network_interface_id}"
+  session_number           = 1
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
@@ -11,10 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    testSweepEc2TransitGateways,
 		Dependencies: []string{
@@ -91,6 +92,10 @@ func testSweepEc2TransitGateways(region string) error {
 				return fmt.Errorf("error deleting EC2 Transit Gateway (%s): %s", id, err)
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			if err := waitForEc2TransitGatewayDeletion(conn, id); err != nil {
 				return fmt.Errorf("error waiting for EC2 Transit Gateway (%s) deletion: %s", id, err)
 			}
@@ -15,6 +15,9 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/waiter"
 )
 
 func resourceAwsEcsService() *schema.Resource {
@@ -27,6 +30,8 @@ func resourceAwsEcsService() *schema.Resource {
 			State: resourceAwsEcsServiceImport,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -326,7 +331,8 @@ func resourceAwsEcsService() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -362,7 +368,7 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 		DeploymentController: expandEcsDeploymentController(d.Get("deployment_controller").([]interface{})),
 		SchedulingStrategy:   aws.String(schedulingStrategy),
 		ServiceName:          aws.String(d.Get("name").(string)),
-		Tags:                 tagsFromMapECS(d.Get("tags").(map[string]interface{})),
+		Tags:                 keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().EcsTags(),
 		TaskDefinition:       aws.String(d.Get("task_definition").(string)),
 		EnableECSManagedTags: aws.Bool(d.Get("enable_ecs_managed_tags").(bool)),
 	}
@@ -505,60 +511,47 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
 	log.Printf("[DEBUG] Reading ECS service %s", d.Id())
-	input := ecs.DescribeServicesInput{
-		Cluster:  aws.String(d.Get("cluster").(string)),
-		Include:  []*string{aws.String(ecs.ServiceFieldTags)},
-		Services: []*string{aws.String(d.Id())},
-	}
+	cluster := d.Get("cluster").(string)
 
-	var out *ecs.DescribeServicesOutput
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	var service *ecs.Service
+	err := resource.Retry(waiter.ServiceDescribeTimeout, func() *resource.RetryError {
 		var err error
-		out, err = conn.DescribeServices(&input)
-		if err != nil {
-			if d.IsNewResource() && isAWSErr(err, ecs.ErrCodeServiceNotFoundException, "") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+		service, err = finder.ServiceByARNAndCluster(conn, d.Id(), cluster)
+
+		if d.IsNewResource() && isResourceNotFoundError(err) {
+			return resource.RetryableError(err)
 		}
 
-		if len(out.Services) < 1 {
-			if d.IsNewResource() {
-				return resource.RetryableError(fmt.Errorf("ECS service not created yet: %q", d.Id()))
-			}
-			log.Printf("[WARN] ECS Service %s not found, removing from state.", d.Id())
-			d.SetId("")
-			return nil
+		if err != nil {
+			return resource.NonRetryableError(err)
 		}
 
-		service := out.Services[0]
-		if d.IsNewResource() && *service.Status == "INACTIVE" {
+		if d.IsNewResource() && aws.StringValue(service.Status) == waiter.ServiceStatusInactive {
 			return resource.RetryableError(fmt.Errorf("ECS service currently INACTIVE: %q", d.Id()))
 		}
 
 		return nil
 	})
 	if isResourceTimeoutError(err) {
-		out, err = conn.DescribeServices(&input)
-	}
-	if err != nil {
-		return fmt.Errorf("Error reading ECS service: %s", err)
+		service, err = finder.ServiceByARNAndCluster(conn, d.Id(), cluster)
 	}
 
-	if len(out.Services) < 1 {
+	if isResourceNotFoundError(err) {
 		if d.IsNewResource() {
 			return fmt.Errorf("ECS service not created: %q", d.Id())
 		}
-		log.Printf("[WARN] Removing ECS service %s (%s) because it's gone", d.Get("name").(string), d.Id())
+		log.Printf("[WARN] ECS Service (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	service := out.Services[0]
+	if err != nil {
+		return fmt.Errorf("Error reading ECS service: %s", err)
+	}
 
 	// Status==INACTIVE means deleted service
-	if *service.Status == "INACTIVE" {
-		log.Printf("[WARN] Removing ECS service %q because it's INACTIVE", *service.ServiceArn)
+	if aws.StringValue(service.Status) == waiter.ServiceStatusInactive {
+		log.Printf("[WARN] Removing ECS service %q because it's INACTIVE", aws.StringValue(service.ServiceArn))
 		d.SetId("")
 		return nil
 	}
@@ -631,7 +624,7 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting service_registries for (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapECS(service.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.EcsKeyValueTags(service.Tags).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -866,39 +859,11 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
-		oldTagsMap := oldTagsRaw.(map[string]interface{})
-		newTagsMap := newTagsRaw.(map[string]interface{})
-		createTags, removeTags := diffTagsECS(tagsFromMapECS(oldTagsMap), tagsFromMapECS(newTagsMap))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if len(removeTags) > 0 {
-			removeTagKeys := make([]*string, len(removeTags))
-			for i, removeTag := range removeTags {
-				removeTagKeys[i] = removeTag.Key
-			}
-
-			input := &ecs.UntagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				TagKeys:     removeTagKeys,
-			}
-
-			log.Printf("[DEBUG] Untagging ECS Cluster: %s", input)
-			if _, err := conn.UntagResource(input); err != nil {
-				return fmt.Errorf("error untagging ECS Cluster (%s): %s", d.Id(), err)
-			}
-		}
-
-		if len(createTags) > 0 {
-			input := &ecs.TagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				Tags:        createTags,
-			}
-
-			log.Printf("[DEBUG] Tagging ECS Cluster: %s", input)
-			if _, err := conn.TagResource(input); err != nil {
-				return fmt.Errorf("error tagging ECS Cluster (%s): %s", d.Id(), err)
-			}
+		if err := keyvaluetags.EcsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating ECS Service (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -909,31 +874,25 @@ func resourceAwsEcsServiceDelete(d *schema.ResourceData, meta interface{}) error
 	conn := meta.(*AWSClient).ecsconn
 
 	// Check if it's not already gone
-	resp, err := conn.DescribeServices(&ecs.DescribeServicesInput{
-		Services: []*string{aws.String(d.Id())},
-		Cluster:  aws.String(d.Get("cluster").(string)),
-	})
-	if err != nil {
-		if isAWSErr(err, ecs.ErrCodeServiceNotFoundException, "") {
-			log.Printf("[DEBUG] Removing ECS Service from state, %q is already gone", d.Id())
-			return nil
-		}
-		return err
-	}
+	service, err := finder.ServiceByARNAndCluster(conn, d.Id(), d.Get("cluster").(string))
 
-	if len(resp.Services) == 0 {
+	if isResourceNotFoundError(err) {
 		log.Printf("[DEBUG] Removing ECS Service from state, %q is already gone", d.Id())
 		return nil
 	}
 
-	log.Printf("[DEBUG] ECS service %s is currently %s", d.Id(), *resp.Services[0].Status)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] ECS service %s is currently %s", d.Id(), aws.StringValue(service.Status))
 
-	if *resp.Services[0].Status == "INACTIVE" {
+	if aws.StringValue(service.Status) == waiter.ServiceStatusInactive {
 		return nil
 	}
 
 	// Drain the ECS service
-	if *resp.Services[0].Status != "DRAINING" && aws.StringValue(resp.Services[0].SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
+	if aws.StringValue(service.Status) != waiter.ServiceStatusDraining && aws.StringValue(service.SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
 		log.Printf("[DEBUG] Draining ECS service %s", d.Id())
 		_, err = conn.UpdateService(&ecs.UpdateServiceInput{
 			Service:      aws.String(d.Id()),
@@ -950,7 +909,7 @@ func resourceAwsEcsServiceDelete(d *schema.ResourceData, meta interface{}) error
 		Cluster: aws.String(d.Get("cluster").(string)),
 	}
 	// Wait until the ECS service is drained
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(waiter.ServiceDeleteTimeout, func() *resource.RetryError {
 		log.Printf("[DEBUG] Trying to delete ECS service %s", input)
 		_, err := conn.DeleteService(&input)
 		if err != nil {
@@ -969,29 +928,8 @@ func resourceAwsEcsServiceDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Wait until it's deleted
-	wait := resource.StateChangeConf{
-		Pending:    []string{"ACTIVE", "DRAINING"},
-		Target:     []string{"INACTIVE"},
-		Timeout:    10 * time.Minute,
-		MinTimeout: 1 * time.Second,
-		Refresh: func() (interface{}, string, error) {
-			log.Printf("[DEBUG] Checking if ECS service %s is INACTIVE", d.Id())
-			resp, err := conn.DescribeServices(&ecs.DescribeServicesInput{
-				Services: []*string{aws.String(d.Id())},
-				Cluster:  aws.String(d.Get("cluster").(string)),
-			})
-			if err != nil {
-				return resp, "FAILED", err
-			}
-
-			log.Printf("[DEBUG] ECS service (%s) is currently %q", d.Id(), *resp.Services[0].Status)
-			return resp, *resp.Services[0].Status, nil
-		},
-	}
-
-	_, err = wait.WaitForState()
-	if err != nil {
-		return err
+	if _, err := waiter.ServiceInactive(conn, d.Id(), d.Get("cluster").(string), waiter.ServiceInactiveTimeout); err != nil {
+		return fmt.Errorf("error waiting for ECS service (%s) to become inactive: %s", d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] ECS service %s deleted.", d.Id())
@@ -16,6 +16,9 @@ func resourceAwsEgressOnlyInternetGateway() *schema.Resource {
 		Create: resourceAwsEgressOnlyInternetGatewayCreate,
 		Read:   resourceAwsEgressOnlyInternetGatewayRead,
 		Delete: resourceAwsEgressOnlyInternetGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -79,6 +82,11 @@ func resourceAwsEgressOnlyInternetGatewayRead(d *schema.ResourceData, meta inter
 		return nil
 	}
 
+	igw := resp.EgressOnlyInternetGateways[0]
+	if len(igw.Attachments) == 1 {
+		d.Set("vpc_id", igw.Attachments[0].VpcId)
+	}
+
 	return nil
 }
 
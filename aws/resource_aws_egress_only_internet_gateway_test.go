@@ -9,10 +9,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &sweep.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    testSweepEc2EgressOnlyInternetGateways,
 	})
@@ -74,6 +75,11 @@ func TestAccAWSEgressOnlyInternetGateway_basic(t *testing.T) {
 					testAccCheckAWSEgressOnlyInternetGatewayExists("aws_egress_only_internet_gateway.foo", &igw),
 				),
 			},
+			{
+				ResourceName:      "aws_egress_only_internet_gateway.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // Implement a test sweeper for EIPs.
@@ -20,7 +21,7 @@ import (
 // although we depend on aws_vpc to potentially have
 // the majority of those associations removed.
 func init() {
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &sweep.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_vpc",
@@ -14,10 +14,13 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_cluster", &sweep.Sweeper{
 		Name: "aws_eks_cluster",
 		F:    testSweepEksClusters,
 	})
@@ -55,7 +58,11 @@ func testSweepEksClusters(region string) error {
 				log.Printf("[ERROR] Failed to delete EKS Cluster %s: %s", name, err)
 				continue
 			}
-			err = waitForDeleteEksCluster(conn, name, 15*time.Minute)
+			if testSweepDryRun() {
+				continue
+			}
+
+			_, err = waiter.ClusterDeleted(conn, name, waiter.ClusterDeleteTimeout)
 			if err != nil {
 				log.Printf("[ERROR] Failed to wait for EKS Cluster %s deletion: %s", name, err)
 			}
@@ -326,22 +333,12 @@ func testAccCheckAWSEksClusterExists(resourceName string, cluster *eks.Cluster)
 		}
 
 		conn := testAccProvider.Meta().(*AWSClient).eksconn
-		output, err := conn.DescribeCluster(&eks.DescribeClusterInput{
-			Name: aws.String(rs.Primary.ID),
-		})
+		output, err := finder.ClusterByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
 
-		if output == nil || output.Cluster == nil {
-			return fmt.Errorf("EKS Cluster (%s) not found", rs.Primary.ID)
-		}
-
-		if aws.StringValue(output.Cluster.Name) != rs.Primary.ID {
-			return fmt.Errorf("EKS Cluster (%s) not found", rs.Primary.ID)
-		}
-
-		*cluster = *output.Cluster
+		*cluster = *output
 
 		return nil
 	}
@@ -357,22 +354,17 @@ func testAccCheckAWSEksClusterDestroy(s *terraform.State) error {
 
 		// Handle eventual consistency
 		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			output, err := conn.DescribeCluster(&eks.DescribeClusterInput{
-				Name: aws.String(rs.Primary.ID),
-			})
+			_, err := finder.ClusterByName(conn, rs.Primary.ID)
 
-			if err != nil {
-				if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
-					return nil
-				}
-				return resource.NonRetryableError(err)
+			if isResourceNotFoundError(err) {
+				return nil
 			}
 
-			if output != nil && output.Cluster != nil && aws.StringValue(output.Cluster.Name) == rs.Primary.ID {
-				return resource.RetryableError(fmt.Errorf("EKS Cluster %s still exists", rs.Primary.ID))
+			if err != nil {
+				return resource.NonRetryableError(err)
 			}
 
-			return nil
+			return resource.RetryableError(fmt.Errorf("EKS Cluster %s still exists", rs.Primary.ID))
 		})
 
 		return err
@@ -11,11 +11,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // initialize sweeper
 func init() {
-	resource.AddTestSweepers("aws_beanstalk_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_beanstalk_application", &sweep.Sweeper{
 		Name:         "aws_beanstalk_application",
 		Dependencies: []string{"aws_beanstalk_environment"},
 		F:            testSweepBeanstalkApplications,
@@ -3,12 +3,14 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsElasticBeanstalkApplicationVersion() *schema.Resource {
@@ -17,6 +19,22 @@ func resourceAwsElasticBeanstalkApplicationVersion() *schema.Resource {
 		Read:   resourceAwsElasticBeanstalkApplicationVersionRead,
 		Update: resourceAwsElasticBeanstalkApplicationVersionUpdate,
 		Delete: resourceAwsElasticBeanstalkApplicationVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected APPLICATION/NAME", d.Id())
+				}
+				application := idParts[0]
+				name := idParts[1]
+				d.Set("application", application)
+				d.Set("name", name)
+				d.SetId(name)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"application": {
@@ -52,7 +70,8 @@ func resourceAwsElasticBeanstalkApplicationVersion() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -76,7 +95,7 @@ func resourceAwsElasticBeanstalkApplicationVersionCreate(d *schema.ResourceData,
 		Description:     aws.String(description),
 		SourceBundle:    &s3Location,
 		VersionLabel:    aws.String(name),
-		Tags:            tagsFromMapBeanstalk(d.Get("tags").(map[string]interface{})),
+		Tags:            keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreElasticbeanstalk().ElasticbeanstalkTags(),
 	}
 
 	log.Printf("[DEBUG] Elastic Beanstalk Application Version create opts: %s", createOpts)
@@ -113,16 +132,35 @@ func resourceAwsElasticBeanstalkApplicationVersionRead(d *schema.ResourceData, m
 			len(resp.ApplicationVersions), d.Id())
 	}
 
+	if err := d.Set("application", resp.ApplicationVersions[0].ApplicationName); err != nil {
+		return err
+	}
+
+	if v := resp.ApplicationVersions[0].SourceBundle; v != nil {
+		d.Set("bucket", v.S3Bucket)
+		d.Set("key", v.S3Key)
+	}
+
 	if err := d.Set("description", resp.ApplicationVersions[0].Description); err != nil {
 		return err
 	}
 
+	if err := d.Set("name", resp.ApplicationVersions[0].VersionLabel); err != nil {
+		return err
+	}
+
 	if err := d.Set("arn", resp.ApplicationVersions[0].ApplicationVersionArn); err != nil {
 		return err
 	}
 
-	if err := saveTagsBeanstalk(conn, d, aws.StringValue(resp.ApplicationVersions[0].ApplicationVersionArn)); err != nil {
-		return fmt.Errorf("error saving tags for %s: %s", d.Id(), err)
+	tags, err := keyvaluetags.ElasticbeanstalkListTags(conn, aws.StringValue(resp.ApplicationVersions[0].ApplicationVersionArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for %s: %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreElasticbeanstalk().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	return nil
@@ -137,8 +175,12 @@ func resourceAwsElasticBeanstalkApplicationVersionUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if err := setTagsBeanstalk(conn, d, d.Get("arn").(string)); err != nil {
-		return fmt.Errorf("error setting tags for %s: %s", d.Id(), err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ElasticbeanstalkUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error setting tags for %s: %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsElasticBeanstalkApplicationVersionRead(d, meta)
@@ -27,6 +27,13 @@ func TestAccAWSBeanstalkAppVersion_basic(t *testing.T) {
 					testAccCheckApplicationVersionExists("aws_elastic_beanstalk_application_version.default", &appVersion),
 				),
 			},
+			{
+				ResourceName:            "aws_elastic_beanstalk_application_version.default",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSBeanstalkAppVersionImportStateIdFunc("aws_elastic_beanstalk_application_version.default"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+			},
 		},
 	})
 }
@@ -101,6 +108,17 @@ func TestAccAWSBeanstalkAppVersion_tags(t *testing.T) {
 	})
 }
 
+func testAccAWSBeanstalkAppVersionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["application"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccCheckApplicationVersionDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
 
@@ -18,6 +18,9 @@ func resourceAwsElasticBeanstalkConfigurationTemplate() *schema.Resource {
 		Read:   resourceAwsElasticBeanstalkConfigurationTemplateRead,
 		Update: resourceAwsElasticBeanstalkConfigurationTemplateUpdate,
 		Delete: resourceAwsElasticBeanstalkConfigurationTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElasticBeanstalkConfigurationTemplateImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -122,7 +125,9 @@ func resourceAwsElasticBeanstalkConfigurationTemplateRead(d *schema.ResourceData
 		return fmt.Errorf("Error reading application properties: found %d applications, expected 1", len(resp.ConfigurationSettings))
 	}
 
+	d.Set("application", resp.ConfigurationSettings[0].ApplicationName)
 	d.Set("description", resp.ConfigurationSettings[0].Description)
+	d.Set("name", resp.ConfigurationSettings[0].TemplateName)
 	return nil
 }
 
@@ -242,3 +247,36 @@ func gatherOptionSettings(d *schema.ResourceData) []*elasticbeanstalk.Configurat
 
 	return extractOptionSettings(optionSettingsSet)
 }
+
+func resourceAwsElasticBeanstalkConfigurationTemplateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).elasticbeanstalkconn
+
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected APPLICATION/NAME", d.Id())
+	}
+
+	application := idParts[0]
+	name := idParts[1]
+
+	// The solution stack name is not refreshed on read as the API returns the
+	// full solution stack name, which may differ from the configured one
+	resp, err := conn.DescribeConfigurationSettings(&elasticbeanstalk.DescribeConfigurationSettingsInput{
+		ApplicationName: aws.String(application),
+		TemplateName:    aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Elastic Beanstalk configuration template (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.ConfigurationSettings) != 1 {
+		return nil, fmt.Errorf("error reading Elastic Beanstalk configuration template (%s): found %d templates, expected 1", d.Id(), len(resp.ConfigurationSettings))
+	}
+
+	d.Set("application", application)
+	d.Set("name", name)
+	d.Set("solution_stack_name", resp.ConfigurationSettings[0].SolutionStackName)
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -26,6 +26,13 @@ func TestAccAWSBeanstalkConfigurationTemplate_basic(t *testing.T) {
 					testAccCheckBeanstalkConfigurationTemplateExists("aws_elastic_beanstalk_configuration_template.tf_template", &config),
 				),
 			},
+			{
+				ResourceName:            "aws_elastic_beanstalk_configuration_template.tf_template",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSBeanstalkConfigurationTemplateImportStateIdFunc("aws_elastic_beanstalk_configuration_template.tf_template"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"setting", "solution_stack_name"},
+			},
 		},
 	})
 }
@@ -70,6 +77,17 @@ func TestAccAWSBeanstalkConfigurationTemplate_Setting(t *testing.T) {
 	})
 }
 
+func testAccAWSBeanstalkConfigurationTemplateImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["application"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccCheckBeanstalkConfigurationTemplateDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
 
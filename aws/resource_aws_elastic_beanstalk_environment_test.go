@@ -15,11 +15,13 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // initialize sweeper
 func init() {
-	resource.AddTestSweepers("aws_beanstalk_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_beanstalk_environment", &sweep.Sweeper{
 		Name: "aws_beanstalk_environment",
 		F:    testSweepBeanstalkEnvironments,
 	})
@@ -68,6 +70,10 @@ func testSweepBeanstalkEnvironments(region string) error {
 			return err
 		}
 
+		if testSweepDryRun() {
+			continue
+		}
+
 		waitForReadyTimeOut, _ := time.ParseDuration("5m")
 		pollInterval, _ := time.ParseDuration("10s")
 
@@ -743,7 +749,7 @@ func testAccCheckBeanstalkEnvTagsMatch(env *elasticbeanstalk.EnvironmentDescript
 			return err
 		}
 
-		foundTags := tagsToMapBeanstalk(tags.ResourceTags)
+		foundTags := keyvaluetags.ElasticbeanstalkKeyValueTags(tags.ResourceTags).IgnoreElasticbeanstalk().Map()
 
 		if !reflect.DeepEqual(foundTags, expectedValue) {
 			return fmt.Errorf("Tag value: %s.  Expected %s", foundTags, expectedValue)
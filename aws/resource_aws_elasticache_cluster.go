@@ -16,6 +16,9 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
 )
 
 func resourceAwsElasticacheCluster() *schema.Resource {
@@ -233,7 +236,8 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -292,6 +296,7 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 				}
 				return diff.ForceNew("node_type")
 			},
+			setTagsDiff,
 		),
 	}
 }
@@ -308,7 +313,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 		securityIdSet := d.Get("security_group_ids").(*schema.Set)
 		securityNames := expandStringList(securityNameSet.List())
 		securityIds := expandStringList(securityIdSet.List())
-		tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+		tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().ElasticacheTags()
 
 		req.CacheSecurityGroupNames = securityNames
 		req.SecurityGroupIds = securityIds
@@ -394,7 +399,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(id)
 
-	err = waitForCreateElasticacheCacheCluster(conn, d.Id(), 40*time.Minute)
+	_, err = waiter.CacheClusterAvailable(conn, d.Id(), waiter.CacheClusterCreateTimeout)
 	if err != nil {
 		return fmt.Errorf("error waiting for Elasticache Cache Cluster (%s) to be created: %s", d.Id(), err)
 	}
@@ -404,87 +409,83 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 
 func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
-	req := &elasticache.DescribeCacheClustersInput{
-		CacheClusterId:    aws.String(d.Id()),
-		ShowCacheNodeInfo: aws.Bool(true),
+
+	c, err := finder.CacheClusterByID(conn, d.Id())
+
+	if isResourceNotFoundError(err) {
+		log.Printf("[WARN] ElastiCache Cluster (%s) not found", d.Id())
+		d.SetId("")
+		return nil
 	}
 
-	res, err := conn.DescribeCacheClusters(req)
 	if err != nil {
-		if isAWSErr(err, elasticache.ErrCodeCacheClusterNotFoundFault, "") {
-			log.Printf("[WARN] ElastiCache Cluster (%s) not found", d.Id())
-			d.SetId("")
-			return nil
+		return fmt.Errorf("error reading ElastiCache Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cluster_id", c.CacheClusterId)
+	d.Set("node_type", c.CacheNodeType)
+	d.Set("num_cache_nodes", c.NumCacheNodes)
+	d.Set("engine", c.Engine)
+	d.Set("engine_version", c.EngineVersion)
+	if c.ConfigurationEndpoint != nil {
+		d.Set("port", c.ConfigurationEndpoint.Port)
+		d.Set("configuration_endpoint", aws.String(fmt.Sprintf("%s:%d", *c.ConfigurationEndpoint.Address, *c.ConfigurationEndpoint.Port)))
+		d.Set("cluster_address", aws.String((*c.ConfigurationEndpoint.Address)))
+	} else if len(c.CacheNodes) > 0 {
+		d.Set("port", int(aws.Int64Value(c.CacheNodes[0].Endpoint.Port)))
+	}
+
+	if c.ReplicationGroupId != nil {
+		d.Set("replication_group_id", c.ReplicationGroupId)
+	}
+
+	d.Set("subnet_group_name", c.CacheSubnetGroupName)
+	d.Set("security_group_names", flattenElastiCacheSecurityGroupNames(c.CacheSecurityGroups))
+	d.Set("security_group_ids", flattenElastiCacheSecurityGroupIds(c.SecurityGroups))
+	if c.CacheParameterGroup != nil {
+		d.Set("parameter_group_name", c.CacheParameterGroup.CacheParameterGroupName)
+	}
+	d.Set("maintenance_window", c.PreferredMaintenanceWindow)
+	d.Set("snapshot_window", c.SnapshotWindow)
+	d.Set("snapshot_retention_limit", c.SnapshotRetentionLimit)
+	if c.NotificationConfiguration != nil {
+		if *c.NotificationConfiguration.TopicStatus == "active" {
+			d.Set("notification_topic_arn", c.NotificationConfiguration.TopicArn)
 		}
+	}
+	d.Set("availability_zone", c.PreferredAvailabilityZone)
+	if *c.PreferredAvailabilityZone == "Multiple" {
+		d.Set("az_mode", "cross-az")
+	} else {
+		d.Set("az_mode", "single-az")
+	}
 
+	if err := setCacheNodeData(d, c); err != nil {
 		return err
 	}
+	// list tags for resource
+	// set tags
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "elasticache",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("cluster:%s", d.Id()),
+	}.String()
+	resp, err := conn.ListTagsForResource(&elasticache.ListTagsForResourceInput{
+		ResourceName: aws.String(arn),
+	})
 
-	if len(res.CacheClusters) == 1 {
-		c := res.CacheClusters[0]
-		d.Set("cluster_id", c.CacheClusterId)
-		d.Set("node_type", c.CacheNodeType)
-		d.Set("num_cache_nodes", c.NumCacheNodes)
-		d.Set("engine", c.Engine)
-		d.Set("engine_version", c.EngineVersion)
-		if c.ConfigurationEndpoint != nil {
-			d.Set("port", c.ConfigurationEndpoint.Port)
-			d.Set("configuration_endpoint", aws.String(fmt.Sprintf("%s:%d", *c.ConfigurationEndpoint.Address, *c.ConfigurationEndpoint.Port)))
-			d.Set("cluster_address", aws.String((*c.ConfigurationEndpoint.Address)))
-		} else if len(c.CacheNodes) > 0 {
-			d.Set("port", int(aws.Int64Value(c.CacheNodes[0].Endpoint.Port)))
-		}
-
-		if c.ReplicationGroupId != nil {
-			d.Set("replication_group_id", c.ReplicationGroupId)
-		}
-
-		d.Set("subnet_group_name", c.CacheSubnetGroupName)
-		d.Set("security_group_names", flattenElastiCacheSecurityGroupNames(c.CacheSecurityGroups))
-		d.Set("security_group_ids", flattenElastiCacheSecurityGroupIds(c.SecurityGroups))
-		if c.CacheParameterGroup != nil {
-			d.Set("parameter_group_name", c.CacheParameterGroup.CacheParameterGroupName)
-		}
-		d.Set("maintenance_window", c.PreferredMaintenanceWindow)
-		d.Set("snapshot_window", c.SnapshotWindow)
-		d.Set("snapshot_retention_limit", c.SnapshotRetentionLimit)
-		if c.NotificationConfiguration != nil {
-			if *c.NotificationConfiguration.TopicStatus == "active" {
-				d.Set("notification_topic_arn", c.NotificationConfiguration.TopicArn)
-			}
-		}
-		d.Set("availability_zone", c.PreferredAvailabilityZone)
-		if *c.PreferredAvailabilityZone == "Multiple" {
-			d.Set("az_mode", "cross-az")
-		} else {
-			d.Set("az_mode", "single-az")
-		}
-
-		if err := setCacheNodeData(d, c); err != nil {
-			return err
-		}
-		// list tags for resource
-		// set tags
-		arn := arn.ARN{
-			Partition: meta.(*AWSClient).partition,
-			Service:   "elasticache",
-			Region:    meta.(*AWSClient).region,
-			AccountID: meta.(*AWSClient).accountid,
-			Resource:  fmt.Sprintf("cluster:%s", d.Id()),
-		}.String()
-		resp, err := conn.ListTagsForResource(&elasticache.ListTagsForResourceInput{
-			ResourceName: aws.String(arn),
-		})
-
-		if err != nil {
-			log.Printf("[DEBUG] Error retrieving tags for ARN: %s", arn)
-		}
+	if err != nil {
+		log.Printf("[DEBUG] Error retrieving tags for ARN: %s", arn)
+	}
 
-		var et []*elasticache.Tag
-		if len(resp.TagList) > 0 {
-			et = resp.TagList
-		}
-		d.Set("tags", tagsToMapEC(et))
+	var et []*elasticache.Tag
+	if len(resp.TagList) > 0 {
+		et = resp.TagList
+	}
+	if err := setTagsAll(d, meta, keyvaluetags.ElasticacheKeyValueTags(et).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	return nil
@@ -500,8 +501,12 @@ func resourceAwsElasticacheClusterUpdate(d *schema.ResourceData, meta interface{
 		AccountID: meta.(*AWSClient).accountid,
 		Resource:  fmt.Sprintf("cluster:%s", d.Id()),
 	}.String()
-	if err := setTagsEC(conn, d, arn); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ElasticacheUpdateTags(conn, arn, o, n); err != nil {
+			return err
+		}
 	}
 
 	req := &elasticache.ModifyCacheClusterInput{
@@ -601,17 +606,7 @@ func resourceAwsElasticacheClusterUpdate(d *schema.ResourceData, meta interface{
 		}
 
 		log.Printf("[DEBUG] Waiting for update: %s", d.Id())
-		pending := []string{"modifying", "rebooting cache cluster nodes", "snapshotting"}
-		stateConf := &resource.StateChangeConf{
-			Pending:    pending,
-			Target:     []string{"available"},
-			Refresh:    cacheClusterStateRefreshFunc(conn, d.Id(), "available", pending),
-			Timeout:    80 * time.Minute,
-			MinTimeout: 10 * time.Second,
-			Delay:      30 * time.Second,
-		}
-
-		_, sterr := stateConf.WaitForState()
+		_, sterr := waiter.CacheClusterUpdated(conn, d.Id(), waiter.CacheClusterUpdateTimeout)
 		if sterr != nil {
 			return fmt.Errorf("Error waiting for elasticache (%s) to update: %s", d.Id(), sterr)
 		}
@@ -671,7 +666,7 @@ func resourceAwsElasticacheClusterDelete(d *schema.ResourceData, meta interface{
 		}
 		return fmt.Errorf("error deleting Elasticache Cache Cluster (%s): %s", d.Id(), err)
 	}
-	err = waitForDeleteElasticacheCacheCluster(conn, d.Id(), 40*time.Minute)
+	_, err = waiter.CacheClusterDeleted(conn, d.Id(), waiter.CacheClusterDeleteTimeout)
 	if err != nil {
 		return fmt.Errorf("error waiting for Elasticache Cache Cluster (%s) to be deleted: %s", d.Id(), err)
 	}
@@ -679,77 +674,6 @@ func resourceAwsElasticacheClusterDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func cacheClusterStateRefreshFunc(conn *elasticache.ElastiCache, clusterID, givenState string, pending []string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := conn.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
-			CacheClusterId:    aws.String(clusterID),
-			ShowCacheNodeInfo: aws.Bool(true),
-		})
-		if err != nil {
-			if isAWSErr(err, elasticache.ErrCodeCacheClusterNotFoundFault, "") {
-				log.Printf("[DEBUG] Detect deletion")
-				return nil, "", nil
-			}
-
-			log.Printf("[ERROR] CacheClusterStateRefreshFunc: %s", err)
-			return nil, "", err
-		}
-
-		if len(resp.CacheClusters) == 0 {
-			return nil, "", fmt.Errorf("Error: no Cache Clusters found for id (%s)", clusterID)
-		}
-
-		var c *elasticache.CacheCluster
-		for _, cluster := range resp.CacheClusters {
-			if *cluster.CacheClusterId == clusterID {
-				log.Printf("[DEBUG] Found matching ElastiCache cluster: %s", *cluster.CacheClusterId)
-				c = cluster
-			}
-		}
-
-		if c == nil {
-			return nil, "", fmt.Errorf("Error: no matching Elastic Cache cluster for id (%s)", clusterID)
-		}
-
-		log.Printf("[DEBUG] ElastiCache Cluster (%s) status: %v", clusterID, *c.CacheClusterStatus)
-
-		// return the current state if it's in the pending array
-		for _, p := range pending {
-			log.Printf("[DEBUG] ElastiCache: checking pending state (%s) for cluster (%s), cluster status: %s", pending, clusterID, *c.CacheClusterStatus)
-			s := *c.CacheClusterStatus
-			if p == s {
-				log.Printf("[DEBUG] Return with status: %v", *c.CacheClusterStatus)
-				return c, p, nil
-			}
-		}
-
-		// return given state if it's not in pending
-		if givenState != "" {
-			log.Printf("[DEBUG] ElastiCache: checking given state (%s) of cluster (%s) against cluster status (%s)", givenState, clusterID, *c.CacheClusterStatus)
-			// check to make sure we have the node count we're expecting
-			if int64(len(c.CacheNodes)) != *c.NumCacheNodes {
-				log.Printf("[DEBUG] Node count is not what is expected: %d found, %d expected", len(c.CacheNodes), *c.NumCacheNodes)
-				return nil, "creating", nil
-			}
-
-			log.Printf("[DEBUG] Node count matched (%d)", len(c.CacheNodes))
-			// loop the nodes and check their status as well
-			for _, n := range c.CacheNodes {
-				log.Printf("[DEBUG] Checking cache node for status: %s", n)
-				if n.CacheNodeStatus != nil && *n.CacheNodeStatus != "available" {
-					log.Printf("[DEBUG] Node (%s) is not yet available, status: %s", *n.CacheNodeId, *n.CacheNodeStatus)
-					return nil, "creating", nil
-				}
-				log.Printf("[DEBUG] Cache node not in expected state")
-			}
-			log.Printf("[DEBUG] ElastiCache returning given state (%s), cluster: %s", givenState, c)
-			return c, givenState, nil
-		}
-		log.Printf("[DEBUG] current status: %v", *c.CacheClusterStatus)
-		return c, *c.CacheClusterStatus, nil
-	}
-}
-
 func createElasticacheCacheCluster(conn *elasticache.ElastiCache, input *elasticache.CreateCacheClusterInput) (string, error) {
 	log.Printf("[DEBUG] Creating Elasticache Cache Cluster: %s", input)
 	output, err := conn.CreateCacheCluster(input)
@@ -765,22 +689,6 @@ func createElasticacheCacheCluster(conn *elasticache.ElastiCache, input *elastic
 	return strings.ToLower(aws.StringValue(output.CacheCluster.CacheClusterId)), nil
 }
 
-func waitForCreateElasticacheCacheCluster(conn *elasticache.ElastiCache, cacheClusterID string, timeout time.Duration) error {
-	pending := []string{"creating", "modifying", "restoring", "snapshotting"}
-	stateConf := &resource.StateChangeConf{
-		Pending:    pending,
-		Target:     []string{"available"},
-		Refresh:    cacheClusterStateRefreshFunc(conn, cacheClusterID, "available", pending),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	log.Printf("[DEBUG] Waiting for Elasticache Cache Cluster (%s) to be created", cacheClusterID)
-	_, err := stateConf.WaitForState()
-	return err
-}
-
 func deleteElasticacheCacheCluster(conn *elasticache.ElastiCache, cacheClusterID string) error {
 	input := &elasticache.DeleteCacheClusterInput{
 		CacheClusterId: aws.String(cacheClusterID),
@@ -807,18 +715,3 @@ func deleteElasticacheCacheCluster(conn *elasticache.ElastiCache, cacheClusterID
 
 	return err
 }
-
-func waitForDeleteElasticacheCacheCluster(conn *elasticache.ElastiCache, cacheClusterID string, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"creating", "available", "deleting", "incompatible-parameters", "incompatible-network", "restore-failed", "snapshotting"},
-		Target:     []string{},
-		Refresh:    cacheClusterStateRefreshFunc(conn, cacheClusterID, "", []string{}),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-	log.Printf("[DEBUG] Waiting for Elasticache Cache Cluster deletion: %v", cacheClusterID)
-
-	_, err := stateConf.WaitForState()
-	return err
-}
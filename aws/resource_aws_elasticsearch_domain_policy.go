@@ -18,6 +18,13 @@ func resourceAwsElasticSearchDomainPolicy() *schema.Resource {
 		Read:   resourceAwsElasticSearchDomainPolicyRead,
 		Update: resourceAwsElasticSearchDomainPolicyUpsert,
 		Delete: resourceAwsElasticSearchDomainPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("domain_name", d.Id())
+				d.SetId("esd-policy-" + d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -65,10 +65,27 @@ func TestAccAWSElasticSearchDomainPolicy_basic(t *testing.T) {
 					},
 				),
 			},
+			{
+				ResourceName:      "aws_elasticsearch_domain_policy.main",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSElasticSearchDomainPolicyImportStateIdFunc("aws_elasticsearch_domain_policy.main"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccAWSElasticSearchDomainPolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["domain_name"], nil
+	}
+}
+
 func buildESDomainArn(name, partition, accId, region string) (string, error) {
 	if partition == "" {
 		return "", fmt.Errorf("Unable to construct ES Domain ARN because of missing AWS partition")
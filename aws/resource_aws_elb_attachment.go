@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,6 +17,9 @@ func resourceAwsElbAttachment() *schema.Resource {
 		Create: resourceAwsElbAttachmentCreate,
 		Read:   resourceAwsElbAttachmentRead,
 		Delete: resourceAwsElbAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElbAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"elb": {
@@ -135,3 +139,17 @@ func resourceAwsElbAttachmentDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+func resourceAwsElbAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ELB-NAME/INSTANCE-ID", d.Id())
+	}
+
+	elbName := idParts[0]
+	d.Set("elb", elbName)
+	d.Set("instance", idParts[1])
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", elbName)))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -36,6 +36,14 @@ func TestAccAWSELBAttachment_basic(t *testing.T) {
 				),
 			},
 
+			{
+				ResourceName:      "aws_elb_attachment.foo1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSELBAttachmentImportStateIdFunc("aws_elb_attachment.foo1"),
+				// The Create function uses resource.PrefixedUniqueId(), so IDs cannot be aligned
+				ImportStateCheck: testAccCheckAWSELBAttachmentImportState,
+			},
+
 			{
 				Config: testAccAWSELBAttachmentConfig2,
 				Check: resource.ComposeTestCheckFunc(
@@ -64,6 +72,35 @@ func TestAccAWSELBAttachment_basic(t *testing.T) {
 }
 
 // remove and instance and check that it's correctly re-attached.
+func testAccAWSELBAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["elb"], rs.Primary.Attributes["instance"]), nil
+	}
+}
+
+func testAccCheckAWSELBAttachmentImportState(s []*terraform.InstanceState) error {
+	if len(s) != 1 {
+		return fmt.Errorf("expected 1 state: %#v", s)
+	}
+
+	rs := s[0]
+
+	if rs.Attributes["elb"] == "" {
+		return fmt.Errorf("expected elb attribute to be set")
+	}
+
+	if rs.Attributes["instance"] == "" {
+		return fmt.Errorf("expected instance attribute to be set")
+	}
+
+	return nil
+}
+
 func TestAccAWSELBAttachment_drift(t *testing.T) {
 	var conf elb.LoadBalancerDescription
 
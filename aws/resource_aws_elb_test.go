@@ -15,10 +15,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elb", &sweep.Sweeper{
 		Name: "aws_elb",
 		F:    testSweepELBs,
 	})
@@ -1156,6 +1158,28 @@ func testAccCheckAWSELBExists(n string, res *elb.LoadBalancerDescription) resour
 	}
 }
 
+func testAccCheckELBTags(
+	ts *[]*elb.Tag, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		m := keyvaluetags.ElbKeyValueTags(*ts).IgnoreAws().Map()
+		v, ok := m[key]
+		if value != "" && !ok {
+			return fmt.Errorf("Missing tag: %s", key)
+		} else if value == "" && ok {
+			return fmt.Errorf("Extra tag: %s", key)
+		}
+		if value == "" {
+			return nil
+		}
+
+		if v != value {
+			return fmt.Errorf("%s: bad value: %s", key, v)
+		}
+
+		return nil
+	}
+}
+
 const testAccAWSELBConfig = `
 data "aws_availability_zones" "available" {
   state = "available"
@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &sweep.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    testSweepEmrClusters,
 	})
@@ -58,6 +59,10 @@ func testSweepEmrClusters(region string) error {
 				log.Printf("[ERROR] Error terminating EMR Cluster (%s): %s", id, err)
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			if err := conn.WaitUntilClusterTerminated(describeClusterInput); err != nil {
 				log.Printf("[ERROR] Error waiting for EMR Cluster (%s) termination: %s", id, err)
 			}
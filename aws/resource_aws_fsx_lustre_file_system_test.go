@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_lustre_file_system", &sweep.Sweeper{
 		Name: "aws_fsx_lustre_file_system",
 		F:    testSweepFSXLustreFileSystems,
 	})
@@ -47,6 +48,10 @@ func testSweepFSXLustreFileSystems(region string) error {
 				continue
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			if err := waitForFsxFileSystemDeletion(conn, aws.StringValue(fs.FileSystemId), 30*time.Minute); err != nil {
 				log.Printf("[ERROR] Error waiting for filesystem (%s) to delete: %s", aws.StringValue(fs.FileSystemId), err)
 			}
@@ -19,6 +19,9 @@ func resourceAwsGameliftFleet() *schema.Resource {
 		Read:   resourceAwsGameliftFleetRead,
 		Update: resourceAwsGameliftFleetUpdate,
 		Delete: resourceAwsGameliftFleetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(70 * time.Minute),
@@ -283,6 +286,7 @@ func resourceAwsGameliftFleetRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("build_id", fleet.BuildId)
 	d.Set("description", fleet.Description)
 	d.Set("arn", fleet.FleetArn)
+	d.Set("ec2_instance_type", fleet.InstanceType)
 	d.Set("log_paths", aws.StringValueSlice(fleet.LogPaths))
 	d.Set("metric_groups", flattenStringList(fleet.MetricGroups))
 	d.Set("name", fleet.Name)
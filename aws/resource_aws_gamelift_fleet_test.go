@@ -12,12 +12,13 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 const testAccGameliftFleetPrefix = "tf_acc_fleet_"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_fleet", &sweep.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -68,6 +69,10 @@ func testSweepGameliftFleets(region string) error {
 					*attr.FleetId, err)
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			err = waitForGameliftFleetToBeDeleted(conn, *attr.FleetId, 5*time.Minute)
 			if err != nil {
 				return fmt.Errorf("Error waiting for Gamelift Fleet (%s) to be deleted: %s",
@@ -287,6 +292,12 @@ func TestAccAWSGameliftFleet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_gamelift_fleet.test", "runtime_configuration.0.server_process.0.launch_path", launchPath),
 				),
 			},
+			{
+				ResourceName:            "aws_gamelift_fleet.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ec2_inbound_permission", "runtime_configuration"},
+			},
 			{
 				Config: testAccAWSGameliftFleetBasicUpdatedConfig(desc, uFleetName, launchPath, params, buildName, bucketName, key, roleArn),
 				Check: resource.ComposeTestCheckFunc(
@@ -0,0 +1,207 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+)
+
+func resourceAwsGlueDataCatalogEncryptionSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueDataCatalogEncryptionSettingsPut,
+		Read:   resourceAwsGlueDataCatalogEncryptionSettingsRead,
+		Update: resourceAwsGlueDataCatalogEncryptionSettingsPut,
+		Delete: resourceAwsGlueDataCatalogEncryptionSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"data_catalog_encryption_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_password_encryption": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"return_connection_password_encrypted": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"encryption_at_rest": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_encryption_mode": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											glue.CatalogEncryptionModeDisabled,
+											glue.CatalogEncryptionModeSseKms,
+										}, false),
+									},
+									"sse_aws_kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsGlueDataCatalogEncryptionSettingsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+
+	input := &glue.PutDataCatalogEncryptionSettingsInput{
+		CatalogId:                     aws.String(catalogID),
+		DataCatalogEncryptionSettings: expandGlueDataCatalogEncryptionSettings(d.Get("data_catalog_encryption_settings").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Putting Glue Data Catalog Encryption Settings: %s", input)
+	_, err := conn.PutDataCatalogEncryptionSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Glue Data Catalog Encryption Settings (%s): %s", catalogID, err)
+	}
+
+	d.SetId(catalogID)
+
+	return resourceAwsGlueDataCatalogEncryptionSettingsRead(d, meta)
+}
+
+func resourceAwsGlueDataCatalogEncryptionSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	settings, err := finder.DataCatalogEncryptionSettingsByID(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Data Catalog Encryption Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", d.Id())
+
+	if err := d.Set("data_catalog_encryption_settings", flattenGlueDataCatalogEncryptionSettings(settings)); err != nil {
+		return fmt.Errorf("error setting data_catalog_encryption_settings: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueDataCatalogEncryptionSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	// Encryption settings cannot be deleted, so reset them to the defaults.
+	input := &glue.PutDataCatalogEncryptionSettingsInput{
+		CatalogId: aws.String(d.Id()),
+		DataCatalogEncryptionSettings: &glue.DataCatalogEncryptionSettings{
+			ConnectionPasswordEncryption: &glue.ConnectionPasswordEncryption{
+				ReturnConnectionPasswordEncrypted: aws.Bool(false),
+			},
+			EncryptionAtRest: &glue.EncryptionAtRest{
+				CatalogEncryptionMode: aws.String(glue.CatalogEncryptionModeDisabled),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Glue Data Catalog Encryption Settings: %s", input)
+	_, err := conn.PutDataCatalogEncryptionSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error resetting Glue Data Catalog Encryption Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueDataCatalogEncryptionSettings(l []interface{}) *glue.DataCatalogEncryptionSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	settings := &glue.DataCatalogEncryptionSettings{}
+
+	if v, ok := m["connection_password_encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cpe := v[0].(map[string]interface{})
+		settings.ConnectionPasswordEncryption = &glue.ConnectionPasswordEncryption{
+			ReturnConnectionPasswordEncrypted: aws.Bool(cpe["return_connection_password_encrypted"].(bool)),
+		}
+
+		if v, ok := cpe["aws_kms_key_id"].(string); ok && v != "" {
+			settings.ConnectionPasswordEncryption.AwsKmsKeyId = aws.String(v)
+		}
+	}
+
+	if v, ok := m["encryption_at_rest"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ear := v[0].(map[string]interface{})
+		settings.EncryptionAtRest = &glue.EncryptionAtRest{
+			CatalogEncryptionMode: aws.String(ear["catalog_encryption_mode"].(string)),
+		}
+
+		if v, ok := ear["sse_aws_kms_key_id"].(string); ok && v != "" {
+			settings.EncryptionAtRest.SseAwsKmsKeyId = aws.String(v)
+		}
+	}
+
+	return settings
+}
+
+func flattenGlueDataCatalogEncryptionSettings(settings *glue.DataCatalogEncryptionSettings) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if cpe := settings.ConnectionPasswordEncryption; cpe != nil {
+		m["connection_password_encryption"] = []interface{}{
+			map[string]interface{}{
+				"aws_kms_key_id":                       aws.StringValue(cpe.AwsKmsKeyId),
+				"return_connection_password_encrypted": aws.BoolValue(cpe.ReturnConnectionPasswordEncrypted),
+			},
+		}
+	}
+
+	if ear := settings.EncryptionAtRest; ear != nil {
+		m["encryption_at_rest"] = []interface{}{
+			map[string]interface{}{
+				"catalog_encryption_mode": aws.StringValue(ear.CatalogEncryptionMode),
+				"sse_aws_kms_key_id":      aws.StringValue(ear.SseAwsKmsKeyId),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
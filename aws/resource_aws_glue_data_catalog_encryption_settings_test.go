@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+)
+
+// Data Catalog encryption settings are global to the account and region, so these tests are not run in parallel.
+
+func TestAccAWSGlueDataCatalogEncryptionSettings_basic(t *testing.T) {
+	var settings glue.DataCatalogEncryptionSettings
+	resourceName := "aws_glue_data_catalog_encryption_settings.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueDataCatalogEncryptionSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueDataCatalogEncryptionSettingsConfigEncrypted(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(resourceName, &settings),
+					testAccCheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.connection_password_encryption.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_catalog_encryption_settings.0.connection_password_encryption.0.aws_kms_key_id", keyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.connection_password_encryption.0.return_connection_password_encrypted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.catalog_encryption_mode", "SSE-KMS"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.sse_aws_kms_key_id", keyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueDataCatalogEncryptionSettingsConfigNonEncrypted(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(resourceName, &settings),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.connection_password_encryption.0.aws_kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.connection_password_encryption.0.return_connection_password_encrypted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.catalog_encryption_mode", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.sse_aws_kms_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueDataCatalogEncryptionSettingsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_data_catalog_encryption_settings" {
+			continue
+		}
+
+		settings, err := finder.DataCatalogEncryptionSettingsByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if mode := settings.EncryptionAtRest; mode != nil && aws.StringValue(mode.CatalogEncryptionMode) != glue.CatalogEncryptionModeDisabled {
+			return fmt.Errorf("Glue Data Catalog Encryption Settings %s still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(n string, v *glue.DataCatalogEncryptionSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Data Catalog Encryption Settings ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		settings, err := finder.DataCatalogEncryptionSettingsByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *settings
+
+		return nil
+	}
+}
+
+func testAccAWSGlueDataCatalogEncryptionSettingsConfigEncrypted() string {
+	return `
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_glue_data_catalog_encryption_settings" "test" {
+  data_catalog_encryption_settings {
+    connection_password_encryption {
+      aws_kms_key_id                       = "${aws_kms_key.test.arn}"
+      return_connection_password_encrypted = true
+    }
+
+    encryption_at_rest {
+      catalog_encryption_mode = "SSE-KMS"
+      sse_aws_kms_key_id      = "${aws_kms_key.test.arn}"
+    }
+  }
+}
+`
+}
+
+func testAccAWSGlueDataCatalogEncryptionSettingsConfigNonEncrypted() string {
+	return `
+resource "aws_glue_data_catalog_encryption_settings" "test" {
+  data_catalog_encryption_settings {
+    connection_password_encryption {
+      return_connection_password_encrypted = false
+    }
+
+    encryption_at_rest {
+      catalog_encryption_mode = "DISABLED"
+    }
+  }
+}
+`
+}
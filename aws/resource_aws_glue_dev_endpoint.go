@@ -0,0 +1,424 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsGlueDevEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueDevEndpointCreate,
+		Read:   resourceAwsGlueDevEndpointRead,
+		Update: resourceAwsGlueDevEndpointUpdate,
+		Delete: resourceAwsGlueDevEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.DevEndpointReadyTimeout),
+			Delete: schema.DefaultTimeout(waiter.DevEndpointDeletedTimeout),
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arguments": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"extra_jars_s3_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extra_python_libs_s3_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"number_of_nodes": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"number_of_workers", "worker_type"},
+				ValidateFunc:  validation.IntAtLeast(2),
+			},
+			"number_of_workers": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"number_of_nodes"},
+				ValidateFunc:  validation.IntAtLeast(2),
+			},
+			"private_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"public_keys"},
+			},
+			"public_keys": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      5,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"public_key"},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"security_configuration": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"worker_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"number_of_nodes"},
+				ValidateFunc: validation.StringInSlice([]string{
+					glue.WorkerTypeG1x,
+					glue.WorkerTypeG2x,
+					glue.WorkerTypeStandard,
+				}, false),
+			},
+			"yarn_endpoint_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zeppelin_remote_spark_interpreter_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueDevEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	name := d.Get("name").(string)
+
+	input := &glue.CreateDevEndpointInput{
+		EndpointName: aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		Tags:         keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().GlueTags(),
+	}
+
+	if v, ok := d.GetOk("arguments"); ok {
+		input.Arguments = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("extra_jars_s3_path"); ok {
+		input.ExtraJarsS3Path = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("extra_python_libs_s3_path"); ok {
+		input.ExtraPythonLibsS3Path = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("number_of_nodes"); ok {
+		input.NumberOfNodes = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("number_of_workers"); ok {
+		input.NumberOfWorkers = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("public_key"); ok {
+		input.PublicKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("public_keys"); ok && v.(*schema.Set).Len() > 0 {
+		input.PublicKeys = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("security_configuration"); ok {
+		input.SecurityConfiguration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		input.SubnetId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("worker_type"); ok {
+		input.WorkerType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Development Endpoint: %s", input)
+	// Retry for IAM eventual consistency
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateDevEndpoint(input)
+
+		if isAWSErr(err, glue.ErrCodeInvalidInputException, "should be given assume role permissions for Glue Service") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, glue.ErrCodeInvalidInputException, "is not authorized to perform") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.CreateDevEndpoint(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Glue Development Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if endpoint, err := waiter.DevEndpointReady(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if endpoint != nil && aws.StringValue(endpoint.FailureReason) != "" {
+			return fmt.Errorf("error waiting for Glue Development Endpoint (%s) to become ready: %s: %s", d.Id(), err, aws.StringValue(endpoint.FailureReason))
+		}
+
+		return fmt.Errorf("error waiting for Glue Development Endpoint (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueDevEndpointRead(d, meta)
+}
+
+func resourceAwsGlueDevEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	endpoint, err := finder.DevEndpointByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Development Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Development Endpoint (%s): %s", d.Id(), err)
+	}
+
+	endpointArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "glue",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("devEndpoint/%s", d.Id()),
+	}.String()
+	d.Set("arn", endpointArn)
+	d.Set("availability_zone", endpoint.AvailabilityZone)
+	d.Set("extra_jars_s3_path", endpoint.ExtraJarsS3Path)
+	d.Set("extra_python_libs_s3_path", endpoint.ExtraPythonLibsS3Path)
+	d.Set("failure_reason", endpoint.FailureReason)
+	d.Set("name", endpoint.EndpointName)
+	d.Set("number_of_nodes", endpoint.NumberOfNodes)
+	d.Set("number_of_workers", endpoint.NumberOfWorkers)
+	d.Set("private_address", endpoint.PrivateAddress)
+	d.Set("public_address", endpoint.PublicAddress)
+	d.Set("public_key", endpoint.PublicKey)
+	d.Set("role_arn", endpoint.RoleArn)
+	d.Set("security_configuration", endpoint.SecurityConfiguration)
+	d.Set("status", endpoint.Status)
+	d.Set("subnet_id", endpoint.SubnetId)
+	d.Set("vpc_id", endpoint.VpcId)
+	d.Set("worker_type", endpoint.WorkerType)
+	d.Set("yarn_endpoint_address", endpoint.YarnEndpointAddress)
+	d.Set("zeppelin_remote_spark_interpreter_port", endpoint.ZeppelinRemoteSparkInterpreterPort)
+
+	if err := d.Set("arguments", aws.StringValueMap(endpoint.Arguments)); err != nil {
+		return fmt.Errorf("error setting arguments: %s", err)
+	}
+
+	if err := d.Set("public_keys", flattenStringSet(endpoint.PublicKeys)); err != nil {
+		return fmt.Errorf("error setting public_keys: %s", err)
+	}
+
+	if err := d.Set("security_group_ids", flattenStringSet(endpoint.SecurityGroupIds)); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %s", err)
+	}
+
+	tags, err := keyvaluetags.GlueListTags(conn, endpointArn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Glue Development Endpoint (%s): %s", endpointArn, err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueDevEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.UpdateDevEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	}
+	hasChanges := false
+
+	if d.HasChange("arguments") {
+		o, n := d.GetChange("arguments")
+		oldArgs := o.(map[string]interface{})
+		newArgs := n.(map[string]interface{})
+
+		addArgs := make(map[string]interface{})
+		for k, v := range newArgs {
+			if ov, ok := oldArgs[k]; !ok || ov != v {
+				addArgs[k] = v
+			}
+		}
+
+		var deleteArgs []*string
+		for k := range oldArgs {
+			if _, ok := newArgs[k]; !ok {
+				deleteArgs = append(deleteArgs, aws.String(k))
+			}
+		}
+
+		if len(addArgs) > 0 {
+			input.AddArguments = stringMapToPointers(addArgs)
+		}
+		if len(deleteArgs) > 0 {
+			input.DeleteArguments = deleteArgs
+		}
+		hasChanges = true
+	}
+
+	if d.HasChange("extra_jars_s3_path") || d.HasChange("extra_python_libs_s3_path") {
+		input.CustomLibraries = &glue.DevEndpointCustomLibraries{
+			ExtraJarsS3Path:       aws.String(d.Get("extra_jars_s3_path").(string)),
+			ExtraPythonLibsS3Path: aws.String(d.Get("extra_python_libs_s3_path").(string)),
+		}
+		input.UpdateEtlLibraries = aws.Bool(true)
+		hasChanges = true
+	}
+
+	if d.HasChange("public_key") {
+		input.PublicKey = aws.String(d.Get("public_key").(string))
+		hasChanges = true
+	}
+
+	if d.HasChange("public_keys") {
+		o, n := d.GetChange("public_keys")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.AddPublicKeys = expandStringSet(add)
+		}
+		if del := os.Difference(ns); del.Len() > 0 {
+			input.DeletePublicKeys = expandStringSet(del)
+		}
+		hasChanges = true
+	}
+
+	if hasChanges {
+		log.Printf("[DEBUG] Updating Glue Development Endpoint: %s", input)
+		_, err := conn.UpdateDevEndpoint(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Glue Development Endpoint (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.GlueUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Glue Development Endpoint (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGlueDevEndpointRead(d, meta)
+}
+
+func resourceAwsGlueDevEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Development Endpoint: %s", d.Id())
+	_, err := conn.DeleteDevEndpoint(&glue.DeleteDevEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Development Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.DevEndpointDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Glue Development Endpoint (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,475 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsGlueMLTransform() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueMLTransformCreate,
+		Read:   resourceAwsGlueMLTransformRead,
+		Update: resourceAwsGlueMLTransformUpdate,
+		Delete: resourceAwsGlueMLTransformDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"input_record_tables": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"connection_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"label_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_capacity": {
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"number_of_workers", "worker_type"},
+				ValidateFunc:  validation.FloatBetween(2, 100),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"number_of_workers": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"max_capacity"},
+				ValidateFunc:  validation.IntAtLeast(1),
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"find_matches_parameters": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"accuracy_cost_tradeoff": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatBetween(0.0, 1.0),
+									},
+									"enforce_provided_labels": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"precision_recall_tradeoff": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatBetween(0.0, 1.0),
+									},
+									"primary_key_column_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+						"transform_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.TransformTypeFindMatches,
+							}, false),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"schema": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"worker_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"max_capacity"},
+				ValidateFunc: validation.StringInSlice([]string{
+					glue.WorkerTypeG1x,
+					glue.WorkerTypeG2x,
+					glue.WorkerTypeStandard,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsGlueMLTransformCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	name := d.Get("name").(string)
+
+	input := &glue.CreateMLTransformInput{
+		InputRecordTables: expandGlueMLTransformInputRecordTables(d.Get("input_record_tables").([]interface{})),
+		Name:              aws.String(name),
+		Parameters:        expandGlueMLTransformParameters(d.Get("parameters").([]interface{})),
+		Role:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_capacity"); ok {
+		input.MaxCapacity = aws.Float64(v.(float64))
+	}
+
+	if v, ok := d.GetOk("max_retries"); ok {
+		input.MaxRetries = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("number_of_workers"); ok {
+		input.NumberOfWorkers = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("timeout"); ok {
+		input.Timeout = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("worker_type"); ok {
+		input.WorkerType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Glue ML Transform: %s", input)
+	var output *glue.CreateMLTransformOutput
+	// Retry for IAM eventual consistency
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateMLTransform(input)
+
+		if isAWSErr(err, glue.ErrCodeAccessDeniedException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, glue.ErrCodeInvalidInputException, "is not authorized to perform") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err = conn.CreateMLTransform(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Glue ML Transform (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TransformId))
+
+	return resourceAwsGlueMLTransformRead(d, meta)
+}
+
+func resourceAwsGlueMLTransformRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	output, err := finder.MLTransformByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue ML Transform (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue ML Transform (%s): %s", d.Id(), err)
+	}
+
+	transformArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "glue",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("mlTransform/%s", d.Id()),
+	}.String()
+	d.Set("arn", transformArn)
+	d.Set("description", output.Description)
+	d.Set("label_count", output.LabelCount)
+	d.Set("max_capacity", output.MaxCapacity)
+	d.Set("max_retries", output.MaxRetries)
+	d.Set("name", output.Name)
+	d.Set("number_of_workers", output.NumberOfWorkers)
+	d.Set("role_arn", output.Role)
+	d.Set("timeout", output.Timeout)
+	d.Set("worker_type", output.WorkerType)
+
+	if err := d.Set("input_record_tables", flattenGlueMLTransformInputRecordTables(output.InputRecordTables)); err != nil {
+		return fmt.Errorf("error setting input_record_tables: %s", err)
+	}
+
+	if err := d.Set("parameters", flattenGlueMLTransformParameters(output.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	if err := d.Set("schema", flattenGlueMLTransformSchemaColumns(output.Schema)); err != nil {
+		return fmt.Errorf("error setting schema: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueMLTransformUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.UpdateMLTransformInput{
+		Description: aws.String(d.Get("description").(string)),
+		MaxRetries:  aws.Int64(int64(d.Get("max_retries").(int))),
+		Name:        aws.String(d.Get("name").(string)),
+		Parameters:  expandGlueMLTransformParameters(d.Get("parameters").([]interface{})),
+		Role:        aws.String(d.Get("role_arn").(string)),
+		Timeout:     aws.Int64(int64(d.Get("timeout").(int))),
+		TransformId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("worker_type"); ok {
+		input.NumberOfWorkers = aws.Int64(int64(d.Get("number_of_workers").(int)))
+		input.WorkerType = aws.String(v.(string))
+	} else {
+		input.MaxCapacity = aws.Float64(d.Get("max_capacity").(float64))
+	}
+
+	log.Printf("[DEBUG] Updating Glue ML Transform: %s", input)
+	_, err := conn.UpdateMLTransform(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Glue ML Transform (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueMLTransformRead(d, meta)
+}
+
+func resourceAwsGlueMLTransformDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue ML Transform: %s", d.Id())
+	_, err := conn.DeleteMLTransform(&glue.DeleteMLTransformInput{
+		TransformId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue ML Transform (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.MLTransformDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Glue ML Transform (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueMLTransformInputRecordTables(l []interface{}) []*glue.Table {
+	var tables []*glue.Table
+
+	for _, mRaw := range l {
+		m, ok := mRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		table := &glue.Table{
+			DatabaseName: aws.String(m["database_name"].(string)),
+			TableName:    aws.String(m["table_name"].(string)),
+		}
+
+		if v, ok := m["catalog_id"].(string); ok && v != "" {
+			table.CatalogId = aws.String(v)
+		}
+
+		if v, ok := m["connection_name"].(string); ok && v != "" {
+			table.ConnectionName = aws.String(v)
+		}
+
+		tables = append(tables, table)
+	}
+
+	return tables
+}
+
+func expandGlueMLTransformParameters(l []interface{}) *glue.TransformParameters {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	parameters := &glue.TransformParameters{
+		TransformType: aws.String(m["transform_type"].(string)),
+	}
+
+	if v, ok := m["find_matches_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		fm := v[0].(map[string]interface{})
+		findMatchesParameters := &glue.FindMatchesParameters{
+			EnforceProvidedLabels: aws.Bool(fm["enforce_provided_labels"].(bool)),
+		}
+
+		if v, ok := fm["accuracy_cost_tradeoff"].(float64); ok && v != 0 {
+			findMatchesParameters.AccuracyCostTradeoff = aws.Float64(v)
+		}
+
+		if v, ok := fm["precision_recall_tradeoff"].(float64); ok && v != 0 {
+			findMatchesParameters.PrecisionRecallTradeoff = aws.Float64(v)
+		}
+
+		if v, ok := fm["primary_key_column_name"].(string); ok && v != "" {
+			findMatchesParameters.PrimaryKeyColumnName = aws.String(v)
+		}
+
+		parameters.FindMatchesParameters = findMatchesParameters
+	}
+
+	return parameters
+}
+
+func flattenGlueMLTransformInputRecordTables(tables []*glue.Table) []interface{} {
+	l := make([]interface{}, 0, len(tables))
+
+	for _, table := range tables {
+		if table == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"catalog_id":      aws.StringValue(table.CatalogId),
+			"connection_name": aws.StringValue(table.ConnectionName),
+			"database_name":   aws.StringValue(table.DatabaseName),
+			"table_name":      aws.StringValue(table.TableName),
+		})
+	}
+
+	return l
+}
+
+func flattenGlueMLTransformParameters(parameters *glue.TransformParameters) []interface{} {
+	if parameters == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"transform_type": aws.StringValue(parameters.TransformType),
+	}
+
+	if fm := parameters.FindMatchesParameters; fm != nil {
+		m["find_matches_parameters"] = []interface{}{
+			map[string]interface{}{
+				"accuracy_cost_tradeoff":    aws.Float64Value(fm.AccuracyCostTradeoff),
+				"enforce_provided_labels":   aws.BoolValue(fm.EnforceProvidedLabels),
+				"precision_recall_tradeoff": aws.Float64Value(fm.PrecisionRecallTradeoff),
+				"primary_key_column_name":   aws.StringValue(fm.PrimaryKeyColumnName),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenGlueMLTransformSchemaColumns(columns []*glue.SchemaColumn) []interface{} {
+	l := make([]interface{}, 0, len(columns))
+
+	for _, column := range columns {
+		if column == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"data_type": aws.StringValue(column.DataType),
+			"name":      aws.StringValue(column.Name),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,371 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_glue_ml_transform", &sweep.Sweeper{
+		Name: "aws_glue_ml_transform",
+		F:    testSweepGlueMLTransforms,
+	})
+}
+
+func testSweepGlueMLTransforms(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).glueconn
+	input := &glue.GetMLTransformsInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.GetMLTransformsPages(input, func(page *glue.GetMLTransformsOutput, lastPage bool) bool {
+		for _, transform := range page.Transforms {
+			id := aws.StringValue(transform.TransformId)
+
+			log.Printf("[INFO] Deleting Glue ML Transform: %s", id)
+			_, err := conn.DeleteMLTransform(&glue.DeleteMLTransformInput{
+				TransformId: aws.String(id),
+			})
+
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting Glue ML Transform (%s): %s", id, err))
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Glue ML Transform sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Glue ML Transforms: %s", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSGlueMLTransform_basic(t *testing.T) {
+	var transform glue.GetMLTransformOutput
+	resourceName := "aws_glue_ml_transform.test"
+	roleResourceName := "aws_iam_role.test"
+	tableResourceName := "aws_glue_catalog_table.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueMLTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueMLTransformConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "glue", regexp.MustCompile(`mlTransform/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "input_record_tables.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_record_tables.0.database_name", tableResourceName, "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_record_tables.0.table_name", tableResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "label_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.transform_type", "FIND_MATCHES"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.find_matches_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.find_matches_parameters.0.primary_key_column_name", "my_column_1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schema.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueMLTransform_disappears(t *testing.T) {
+	var transform glue.GetMLTransformOutput
+	resourceName := "aws_glue_ml_transform.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueMLTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueMLTransformConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					testAccCheckAWSGlueMLTransformDisappears(&transform),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueMLTransform_Update(t *testing.T) {
+	var transform glue.GetMLTransformOutput
+	resourceName := "aws_glue_ml_transform.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueMLTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueMLTransformConfigUpdate(rName, "description1", 0.5, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "max_retries", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.find_matches_parameters.0.accuracy_cost_tradeoff", "0.5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueMLTransformConfigUpdate(rName, "description2", 0.8, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "max_retries", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.find_matches_parameters.0.accuracy_cost_tradeoff", "0.8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueMLTransform_WorkerType(t *testing.T) {
+	var transform glue.GetMLTransformOutput
+	resourceName := "aws_glue_ml_transform.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueMLTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueMLTransformConfigWorkerType(rName, "Standard", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "number_of_workers", "2"),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "Standard"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueMLTransformConfigWorkerType(rName, "G.1X", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "number_of_workers", "3"),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "G.1X"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueMLTransformDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_ml_transform" {
+			continue
+		}
+
+		_, err := finder.MLTransformByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue ML Transform %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSGlueMLTransformExists(n string, v *glue.GetMLTransformOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue ML Transform ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := finder.MLTransformByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueMLTransformDisappears(transform *glue.GetMLTransformOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		_, err := conn.DeleteMLTransform(&glue.DeleteMLTransformInput{
+			TransformId: transform.TransformId,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = waiter.MLTransformDeleted(conn, aws.StringValue(transform.TransformId))
+
+		return err
+	}
+}
+
+func testAccAWSGlueMLTransformConfigBase(rName string) string {
+	return testAccAWSGlueJobConfig_Base(rName) + fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = "${aws_glue_catalog_database.test.name}"
+
+  storage_descriptor {
+    columns {
+      name = "my_column_1"
+      type = "int"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "string"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSGlueMLTransformConfig(rName string) string {
+	return testAccAWSGlueMLTransformConfigBase(rName) + fmt.Sprintf(`
+resource "aws_glue_ml_transform" "test" {
+  name     = %[1]q
+  role_arn = "${aws_iam_role.test.arn}"
+
+  input_record_tables {
+    database_name = "${aws_glue_catalog_table.test.database_name}"
+    table_name    = "${aws_glue_catalog_table.test.name}"
+  }
+
+  parameters {
+    transform_type = "FIND_MATCHES"
+
+    find_matches_parameters {
+      primary_key_column_name = "my_column_1"
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName)
+}
+
+func testAccAWSGlueMLTransformConfigUpdate(rName, description string, accuracyCostTradeoff float64, maxRetries int) string {
+	return testAccAWSGlueMLTransformConfigBase(rName) + fmt.Sprintf(`
+resource "aws_glue_ml_transform" "test" {
+  name        = %[1]q
+  description = %[2]q
+  max_retries = %[4]d
+  role_arn    = "${aws_iam_role.test.arn}"
+
+  input_record_tables {
+    database_name = "${aws_glue_catalog_table.test.database_name}"
+    table_name    = "${aws_glue_catalog_table.test.name}"
+  }
+
+  parameters {
+    transform_type = "FIND_MATCHES"
+
+    find_matches_parameters {
+      accuracy_cost_tradeoff  = %[3]g
+      primary_key_column_name = "my_column_1"
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, description, accuracyCostTradeoff, maxRetries)
+}
+
+func testAccAWSGlueMLTransformConfigWorkerType(rName, workerType string, numberOfWorkers int) string {
+	return testAccAWSGlueMLTransformConfigBase(rName) + fmt.Sprintf(`
+resource "aws_glue_ml_transform" "test" {
+  name              = %[1]q
+  number_of_workers = %[3]d
+  role_arn          = "${aws_iam_role.test.arn}"
+  worker_type       = %[2]q
+
+  input_record_tables {
+    database_name = "${aws_glue_catalog_table.test.database_name}"
+    table_name    = "${aws_glue_catalog_table.test.name}"
+  }
+
+  parameters {
+    transform_type = "FIND_MATCHES"
+
+    find_matches_parameters {
+      primary_key_column_name = "my_column_1"
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName, workerType, numberOfWorkers)
+}
@@ -0,0 +1,363 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsGluePartition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGluePartitionCreate,
+		Read:   resourceAwsGluePartitionRead,
+		Update: resourceAwsGluePartitionUpdate,
+		Delete: resourceAwsGluePartitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"last_accessed_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_analyzed_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partition_values": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"storage_descriptor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comment": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"compressed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"number_of_buckets": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"output_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ser_de_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"parameters": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"serialization_library": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"skewed_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"skewed_column_names": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"skewed_column_values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"skewed_column_value_location_maps": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"sort_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"sort_order": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+						"stored_as_sub_directories": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGluePartitionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+	dbName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	values := expandStringList(d.Get("partition_values").([]interface{}))
+
+	input := &glue.CreatePartitionInput{
+		CatalogId:      aws.String(catalogID),
+		DatabaseName:   aws.String(dbName),
+		PartitionInput: expandGluePartitionInput(d),
+		TableName:      aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Creating Glue Partition: %s", input)
+	_, err := conn.CreatePartition(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Glue Partition: %s", err)
+	}
+
+	d.SetId(createAwsGluePartitionID(catalogID, dbName, tableName, aws.StringValueSlice(values)))
+
+	return resourceAwsGluePartitionRead(d, meta)
+}
+
+func resourceAwsGluePartitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, tableName, values, err := decodeAwsGluePartitionID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	partition, err := finder.PartitionByValues(conn, catalogID, dbName, tableName, values)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Partition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Partition (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", catalogID)
+	d.Set("database_name", partition.DatabaseName)
+	d.Set("table_name", partition.TableName)
+
+	if partition.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(partition.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set("creation_time", nil)
+	}
+
+	if partition.LastAccessTime != nil {
+		d.Set("last_accessed_time", aws.TimeValue(partition.LastAccessTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_accessed_time", nil)
+	}
+
+	if partition.LastAnalyzedTime != nil {
+		d.Set("last_analyzed_time", aws.TimeValue(partition.LastAnalyzedTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_analyzed_time", nil)
+	}
+
+	if err := d.Set("parameters", aws.StringValueMap(partition.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	if err := d.Set("partition_values", aws.StringValueSlice(partition.Values)); err != nil {
+		return fmt.Errorf("error setting partition_values: %s", err)
+	}
+
+	if err := d.Set("storage_descriptor", flattenGlueStorageDescriptor(partition.StorageDescriptor)); err != nil {
+		return fmt.Errorf("error setting storage_descriptor: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGluePartitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, tableName, values, err := decodeAwsGluePartitionID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdatePartitionInput{
+		CatalogId:          aws.String(catalogID),
+		DatabaseName:       aws.String(dbName),
+		PartitionInput:     expandGluePartitionInput(d),
+		PartitionValueList: aws.StringSlice(values),
+		TableName:          aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Updating Glue Partition: %s", input)
+	_, err = conn.UpdatePartition(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Glue Partition (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGluePartitionRead(d, meta)
+}
+
+func resourceAwsGluePartitionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, tableName, values, err := decodeAwsGluePartitionID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Partition: %s", d.Id())
+	_, err = conn.DeletePartition(&glue.DeletePartitionInput{
+		CatalogId:       aws.String(catalogID),
+		DatabaseName:    aws.String(dbName),
+		PartitionValues: aws.StringSlice(values),
+		TableName:       aws.String(tableName),
+	})
+
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Partition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func createAwsGluePartitionID(catalogID, dbName, tableName string, values []string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", catalogID, dbName, tableName, strings.Join(values, "#"))
+}
+
+func decodeAwsGluePartitionID(id string) (string, string, string, []string, error) {
+	parts := strings.SplitN(id, ":", 4)
+
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", nil, fmt.Errorf("expected ID in format catalog-id:database-name:table-name:partition-values, received: %s", id)
+	}
+
+	return parts[0], parts[1], parts[2], strings.Split(parts[3], "#"), nil
+}
+
+func expandGluePartitionInput(d *schema.ResourceData) *glue.PartitionInput {
+	input := &glue.PartitionInput{
+		Values: expandStringList(d.Get("partition_values").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage_descriptor"); ok {
+		input.StorageDescriptor = expandGlueStorageDescriptor(v.([]interface{}))
+	}
+
+	return input
+}
@@ -0,0 +1,302 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestDecodeAwsGluePartitionID(t *testing.T) {
+	testCases := []struct {
+		Input             string
+		ExpectedCatalogID string
+		ExpectedDBName    string
+		ExpectedTableName string
+		ExpectedValues    []string
+		ErrCount          int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "123456789012:db:table",
+			ErrCount: 1,
+		},
+		{
+			Input:    "123456789012:db:table:",
+			ErrCount: 1,
+		},
+		{
+			Input:    ":db:table:value",
+			ErrCount: 1,
+		},
+		{
+			Input:             "123456789012:db:table:value",
+			ExpectedCatalogID: "123456789012",
+			ExpectedDBName:    "db",
+			ExpectedTableName: "table",
+			ExpectedValues:    []string{"value"},
+			ErrCount:          0,
+		},
+		{
+			Input:             "123456789012:db:table:2019#10#01",
+			ExpectedCatalogID: "123456789012",
+			ExpectedDBName:    "db",
+			ExpectedTableName: "table",
+			ExpectedValues:    []string{"2019", "10", "01"},
+			ErrCount:          0,
+		},
+		{
+			Input:             "123456789012:db:table:12:00#value",
+			ExpectedCatalogID: "123456789012",
+			ExpectedDBName:    "db",
+			ExpectedTableName: "table",
+			ExpectedValues:    []string{"12:00", "value"},
+			ErrCount:          0,
+		},
+	}
+
+	for _, tc := range testCases {
+		catalogID, dbName, tableName, values, err := decodeAwsGluePartitionID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if catalogID != tc.ExpectedCatalogID {
+			t.Fatalf("expected %q to return catalog ID %q, received: %q", tc.Input, tc.ExpectedCatalogID, catalogID)
+		}
+		if dbName != tc.ExpectedDBName {
+			t.Fatalf("expected %q to return database name %q, received: %q", tc.Input, tc.ExpectedDBName, dbName)
+		}
+		if tableName != tc.ExpectedTableName {
+			t.Fatalf("expected %q to return table name %q, received: %q", tc.Input, tc.ExpectedTableName, tableName)
+		}
+		if !reflect.DeepEqual(values, tc.ExpectedValues) {
+			t.Fatalf("expected %q to return partition values %v, received: %v", tc.Input, tc.ExpectedValues, values)
+		}
+	}
+}
+
+func TestAccAWSGluePartition_basic(t *testing.T) {
+	var partition glue.Partition
+	resourceName := "aws_glue_partition.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGluePartitionConfig(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					testAccCheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "partition_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_values.0", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGluePartition_disappears(t *testing.T) {
+	var partition glue.Partition
+	resourceName := "aws_glue_partition.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGluePartitionConfig(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					testAccCheckAWSGluePartitionDisappears(&partition),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGluePartition_Update(t *testing.T) {
+	var partition glue.Partition
+	resourceName := "aws_glue_partition.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGluePartitionConfigParameters(rName, "value1", "location1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.param1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.location", "location1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGluePartitionConfigParameters(rName, "value2", "location2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.param1", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.location", "location2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGluePartitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_partition" {
+			continue
+		}
+
+		catalogID, dbName, tableName, values, err := decodeAwsGluePartitionID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.PartitionByValues(conn, catalogID, dbName, tableName, values)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Partition %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSGluePartitionExists(n string, v *glue.Partition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Partition ID is set")
+		}
+
+		catalogID, dbName, tableName, values, err := decodeAwsGluePartitionID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		partition, err := finder.PartitionByValues(conn, catalogID, dbName, tableName, values)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *partition
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGluePartitionDisappears(partition *glue.Partition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		_, err := conn.DeletePartition(&glue.DeletePartitionInput{
+			DatabaseName:    partition.DatabaseName,
+			PartitionValues: partition.Values,
+			TableName:       partition.TableName,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSGluePartitionConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = "${aws_glue_catalog_database.test.name}"
+
+  partition_keys {
+    name = "my_column_1"
+    type = "string"
+  }
+}
+`, rName)
+}
+
+func testAccAWSGluePartitionConfig(rName, value string) string {
+	return testAccAWSGluePartitionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_glue_partition" "test" {
+  database_name    = "${aws_glue_catalog_database.test.name}"
+  table_name       = "${aws_glue_catalog_table.test.name}"
+  partition_values = [%[1]q]
+}
+`, value)
+}
+
+func testAccAWSGluePartitionConfigParameters(rName, parameterValue, location string) string {
+	return testAccAWSGluePartitionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_glue_partition" "test" {
+  database_name    = "${aws_glue_catalog_database.test.name}"
+  table_name       = "${aws_glue_catalog_table.test.name}"
+  partition_values = ["value1"]
+
+  parameters = {
+    param1 = %[1]q
+  }
+
+  storage_descriptor {
+    location = %[2]q
+  }
+}
+`, parameterValue, location)
+}
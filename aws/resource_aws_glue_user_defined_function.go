@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsGlueUserDefinedFunction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueUserDefinedFunctionCreate,
+		Read:   resourceAwsGlueUserDefinedFunctionRead,
+		Update: resourceAwsGlueUserDefinedFunctionUpdate,
+		Delete: resourceAwsGlueUserDefinedFunctionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"class_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"owner_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"owner_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					glue.PrincipalTypeGroup,
+					glue.PrincipalTypeRole,
+					glue.PrincipalTypeUser,
+				}, false),
+			},
+			"resource_uris": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.ResourceTypeArchive,
+								glue.ResourceTypeFile,
+								glue.ResourceTypeJar,
+							}, false),
+						},
+						"uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsGlueUserDefinedFunctionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+	dbName := d.Get("database_name").(string)
+	name := d.Get("name").(string)
+
+	input := &glue.CreateUserDefinedFunctionInput{
+		CatalogId:     aws.String(catalogID),
+		DatabaseName:  aws.String(dbName),
+		FunctionInput: expandGlueUserDefinedFunctionInput(d),
+	}
+
+	log.Printf("[DEBUG] Creating Glue User Defined Function: %s", input)
+	_, err := conn.CreateUserDefinedFunction(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Glue User Defined Function (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", catalogID, dbName, name))
+
+	return resourceAwsGlueUserDefinedFunctionRead(d, meta)
+}
+
+func resourceAwsGlueUserDefinedFunctionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, name, err := decodeAwsGlueUserDefinedFunctionID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	udf, err := finder.UserDefinedFunctionByName(conn, catalogID, dbName, name)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue User Defined Function (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue User Defined Function (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", catalogID)
+	d.Set("class_name", udf.ClassName)
+	d.Set("create_time", aws.TimeValue(udf.CreateTime).Format(time.RFC3339))
+	d.Set("database_name", dbName)
+	d.Set("name", udf.FunctionName)
+	d.Set("owner_name", udf.OwnerName)
+	d.Set("owner_type", udf.OwnerType)
+
+	if err := d.Set("resource_uris", flattenGlueResourceUris(udf.ResourceUris)); err != nil {
+		return fmt.Errorf("error setting resource_uris: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueUserDefinedFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, name, err := decodeAwsGlueUserDefinedFunctionID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdateUserDefinedFunctionInput{
+		CatalogId:     aws.String(catalogID),
+		DatabaseName:  aws.String(dbName),
+		FunctionInput: expandGlueUserDefinedFunctionInput(d),
+		FunctionName:  aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Updating Glue User Defined Function: %s", input)
+	_, err = conn.UpdateUserDefinedFunction(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Glue User Defined Function (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueUserDefinedFunctionRead(d, meta)
+}
+
+func resourceAwsGlueUserDefinedFunctionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, name, err := decodeAwsGlueUserDefinedFunctionID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Glue User Defined Function: %s", d.Id())
+	_, err = conn.DeleteUserDefinedFunction(&glue.DeleteUserDefinedFunctionInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		FunctionName: aws.String(name),
+	})
+
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue User Defined Function (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAwsGlueUserDefinedFunctionID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("expected ID in format catalog-id:database-name:function-name, received: %s", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func expandGlueUserDefinedFunctionInput(d *schema.ResourceData) *glue.UserDefinedFunctionInput {
+	input := &glue.UserDefinedFunctionInput{
+		ClassName:    aws.String(d.Get("class_name").(string)),
+		FunctionName: aws.String(d.Get("name").(string)),
+		OwnerName:    aws.String(d.Get("owner_name").(string)),
+		OwnerType:    aws.String(d.Get("owner_type").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_uris"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceUris = expandGlueResourceUris(v.(*schema.Set).List())
+	}
+
+	return input
+}
+
+func expandGlueResourceUris(l []interface{}) []*glue.ResourceUri {
+	var uris []*glue.ResourceUri
+
+	for _, mRaw := range l {
+		m, ok := mRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		uris = append(uris, &glue.ResourceUri{
+			ResourceType: aws.String(m["resource_type"].(string)),
+			Uri:          aws.String(m["uri"].(string)),
+		})
+	}
+
+	return uris
+}
+
+func flattenGlueResourceUris(uris []*glue.ResourceUri) []interface{} {
+	l := make([]interface{}, 0, len(uris))
+
+	for _, uri := range uris {
+		if uri == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"resource_type": aws.StringValue(uri.ResourceType),
+			"uri":           aws.StringValue(uri.Uri),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestDecodeAwsGlueUserDefinedFunctionID(t *testing.T) {
+	testCases := []struct {
+		Input             string
+		ExpectedCatalogID string
+		ExpectedDBName    string
+		ExpectedName      string
+		ErrCount          int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "123456789012:db",
+			ErrCount: 1,
+		},
+		{
+			Input:    "123456789012:db:",
+			ErrCount: 1,
+		},
+		{
+			Input:    "123456789012:db:name:extra",
+			ErrCount: 1,
+		},
+		{
+			Input:             "123456789012:db:name",
+			ExpectedCatalogID: "123456789012",
+			ExpectedDBName:    "db",
+			ExpectedName:      "name",
+			ErrCount:          0,
+		},
+	}
+
+	for _, tc := range testCases {
+		catalogID, dbName, name, err := decodeAwsGlueUserDefinedFunctionID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if catalogID != tc.ExpectedCatalogID {
+			t.Fatalf("expected %q to return catalog ID %q, received: %q", tc.Input, tc.ExpectedCatalogID, catalogID)
+		}
+		if dbName != tc.ExpectedDBName {
+			t.Fatalf("expected %q to return database name %q, received: %q", tc.Input, tc.ExpectedDBName, dbName)
+		}
+		if name != tc.ExpectedName {
+			t.Fatalf("expected %q to return function name %q, received: %q", tc.Input, tc.ExpectedName, name)
+		}
+	}
+}
+
+func TestAccAWSGlueUserDefinedFunction_basic(t *testing.T) {
+	var udf glue.UserDefinedFunction
+	resourceName := "aws_glue_user_defined_function.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueUserDefinedFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueUserDefinedFunctionConfig(rName, "org.example.Function1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueUserDefinedFunctionExists(resourceName, &udf),
+					testAccCheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "class_name", "org.example.Function1"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "owner_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "owner_type", "GROUP"),
+					resource.TestCheckResourceAttr(resourceName, "resource_uris.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueUserDefinedFunctionConfig(rName, "org.example.Function2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueUserDefinedFunctionExists(resourceName, &udf),
+					resource.TestCheckResourceAttr(resourceName, "class_name", "org.example.Function2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueUserDefinedFunction_disappears(t *testing.T) {
+	var udf glue.UserDefinedFunction
+	resourceName := "aws_glue_user_defined_function.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueUserDefinedFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueUserDefinedFunctionConfig(rName, "org.example.Function1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueUserDefinedFunctionExists(resourceName, &udf),
+					testAccCheckAWSGlueUserDefinedFunctionDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueUserDefinedFunction_ResourceUris(t *testing.T) {
+	var udf glue.UserDefinedFunction
+	resourceName := "aws_glue_user_defined_function.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueUserDefinedFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueUserDefinedFunctionConfigResourceUris1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueUserDefinedFunctionExists(resourceName, &udf),
+					resource.TestCheckResourceAttr(resourceName, "resource_uris.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueUserDefinedFunctionConfigResourceUris2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueUserDefinedFunctionExists(resourceName, &udf),
+					resource.TestCheckResourceAttr(resourceName, "resource_uris.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueUserDefinedFunctionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_user_defined_function" {
+			continue
+		}
+
+		catalogID, dbName, name, err := decodeAwsGlueUserDefinedFunctionID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.UserDefinedFunctionByName(conn, catalogID, dbName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue User Defined Function %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSGlueUserDefinedFunctionExists(n string, v *glue.UserDefinedFunction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue User Defined Function ID is set")
+		}
+
+		catalogID, dbName, name, err := decodeAwsGlueUserDefinedFunctionID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		udf, err := finder.UserDefinedFunctionByName(conn, catalogID, dbName, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *udf
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueUserDefinedFunctionDisappears(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		catalogID, dbName, name, err := decodeAwsGlueUserDefinedFunctionID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		_, err = conn.DeleteUserDefinedFunction(&glue.DeleteUserDefinedFunctionInput{
+			CatalogId:    &catalogID,
+			DatabaseName: &dbName,
+			FunctionName: &name,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSGlueUserDefinedFunctionConfig(rName, className string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_user_defined_function" "test" {
+  name          = %[1]q
+  database_name = "${aws_glue_catalog_database.test.name}"
+  class_name    = %[2]q
+  owner_name    = %[1]q
+  owner_type    = "GROUP"
+}
+`, rName, className)
+}
+
+func testAccAWSGlueUserDefinedFunctionConfigResourceUris1(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_user_defined_function" "test" {
+  name          = %[1]q
+  database_name = "${aws_glue_catalog_database.test.name}"
+  class_name    = "org.example.Function"
+  owner_name    = %[1]q
+  owner_type    = "GROUP"
+
+  resource_uris {
+    resource_type = "ARCHIVE"
+    uri           = "s3://example-bucket/function.zip"
+  }
+}
+`, rName)
+}
+
+func testAccAWSGlueUserDefinedFunctionConfigResourceUris2(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_user_defined_function" "test" {
+  name          = %[1]q
+  database_name = "${aws_glue_catalog_database.test.name}"
+  class_name    = "org.example.Function"
+  owner_name    = %[1]q
+  owner_type    = "GROUP"
+
+  resource_uris {
+    resource_type = "ARCHIVE"
+    uri           = "s3://example-bucket/function.zip"
+  }
+
+  resource_uris {
+    resource_type = "JAR"
+    uri           = "s3://example-bucket/function.jar"
+  }
+}
+`, rName)
+}
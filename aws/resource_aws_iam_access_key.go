@@ -21,6 +21,9 @@ func resourceAwsIamAccessKey() *schema.Resource {
 		Read:   resourceAwsIamAccessKeyRead,
 		Update: resourceAwsIamAccessKeyUpdate,
 		Delete: resourceAwsIamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamAccessKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": {
@@ -213,3 +216,23 @@ func sesSmtpPasswordFromSecretKey(key *string) (string, error) {
 	versionedSig = append(versionedSig, rawSig...)
 	return base64.StdEncoding.EncodeToString(versionedSig), nil
 }
+
+func resourceAwsIamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	// The access key ID is unique, so look up the user the key belongs to
+	resp, err := iamconn.GetAccessKeyLastUsed(&iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading IAM access key (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(resp.UserName) == "" {
+		return nil, fmt.Errorf("error reading IAM access key (%s): user not found", d.Id())
+	}
+
+	d.Set("user", resp.UserName)
+
+	return []*schema.ResourceData{d}, nil
+}
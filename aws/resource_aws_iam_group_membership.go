@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,6 +16,20 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 		Read:   resourceAwsIamGroupMembershipRead,
 		Update: resourceAwsIamGroupMembershipUpdate,
 		Delete: resourceAwsIamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.SplitN(d.Id(), "/", 2)
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected GROUP-NAME/NAME", d.Id())
+				}
+				group := idParts[0]
+				name := idParts[1]
+				d.Set("group", group)
+				d.Set("name", name)
+				d.SetId(name)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -87,6 +102,9 @@ func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{})
 		marker = resp.Marker
 	}
 
+	d.Set("group", group)
+	d.Set("name", d.Id())
+
 	if err := d.Set("users", ul); err != nil {
 		return fmt.Errorf("Error setting user list from IAM Group Membership (%s), error: %s", group, err)
 	}
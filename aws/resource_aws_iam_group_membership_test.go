@@ -35,6 +35,12 @@ func TestAccAWSGroupMembership_basic(t *testing.T) {
 					testAccCheckAWSGroupMembershipAttributes(&group, groupName, []string{userName}),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_group_membership.team",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSGroupMembershipImportStateIdFunc("aws_iam_group_membership.team"),
+				ImportStateVerify: true,
+			},
 
 			{
 				Config: testAccAWSGroupMemberConfigUpdate(groupName, userName, userName2, userName3, membershipName),
@@ -80,6 +86,17 @@ func TestAccAWSGroupMembership_paginatedUserList(t *testing.T) {
 	})
 }
 
+func testAccAWSGroupMembershipImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["group"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccCheckAWSGroupMembershipDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).iamconn
 
@@ -18,6 +18,21 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamPolicyAttachmentRead,
 		Update: resourceAwsIamPolicyAttachmentUpdate,
 		Delete: resourceAwsIamPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The policy ARN may contain slashes, so only split on the first one
+				idParts := strings.SplitN(d.Id(), "/", 2)
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected NAME/POLICY-ARN", d.Id())
+				}
+				name := idParts[0]
+				policyArn := idParts[1]
+				d.Set("name", name)
+				d.Set("policy_arn", policyArn)
+				d.SetId(name)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -128,6 +143,8 @@ func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	d.Set("name", d.Id())
+
 	userErr := d.Set("users", ul)
 	roleErr := d.Set("roles", rl)
 	groupErr := d.Set("groups", gl)
@@ -39,6 +39,12 @@ func TestAccAWSIAMPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSPolicyAttachmentAttributes([]string{userName}, []string{roleName}, []string{groupName}, &out),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSIAMPolicyAttachmentImportStateIdFunc("aws_iam_policy_attachment.test-attach"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSPolicyAttachConfigUpdate(userName, userName2, userName3,
 					roleName, roleName2, roleName3,
@@ -54,6 +60,17 @@ func TestAccAWSIAMPolicyAttachment_basic(t *testing.T) {
 	})
 }
 
+func testAccAWSIAMPolicyAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["name"], rs.Primary.Attributes["policy_arn"]), nil
+	}
+}
+
 func TestAccAWSIAMPolicyAttachment_paginatedEntities(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
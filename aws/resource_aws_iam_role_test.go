@@ -13,10 +13,12 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &sweep.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -909,3 +911,76 @@ EOF
 }
 `, rName)
 }
+
+func TestOfflineAWSIAMRole_basic(t *testing.T) {
+	s := testOfflineServer()
+	defer s.Close()
+	fake := fakeaws.RegisterIAM(s)
+	resourceName := "aws_iam_role.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testOfflineProviderFactories(),
+		CheckDestroy:      testOfflineCheckDestroyed("IAM Roles", fake.RoleNames),
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(s) + testOfflineAWSIAMRoleConfig("original", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "arn", "arn:aws:iam::123456789012:role/tf-offline-test"),
+					resource.TestCheckResourceAttr(resourceName, "description", "original"),
+					resource.TestCheckResourceAttr(resourceName, "max_session_duration", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-offline-test"),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "original"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "unique_id"),
+				),
+			},
+			{
+				Config:                  testOfflineProviderConfig(s) + testOfflineAWSIAMRoleConfig("original", 3600),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_detach_policies"},
+			},
+			{
+				Config: testOfflineProviderConfig(s) + testOfflineAWSIAMRoleConfig("changed", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "changed"),
+					resource.TestCheckResourceAttr(resourceName, "max_session_duration", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "changed"),
+				),
+			},
+		},
+	})
+}
+
+func testOfflineAWSIAMRoleConfig(value string, maxSessionDuration int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name                 = "tf-offline-test"
+  description          = %[1]q
+  max_session_duration = %[2]d
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+
+  tags = {
+    Usage = %[1]q
+  }
+}
+`, value, maxSessionDuration)
+}
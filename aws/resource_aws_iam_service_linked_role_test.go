@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_service_linked_role", &sweep.Sweeper{
 		Name: "aws_iam_service_linked_role",
 		F:    testSweepIamServiceLinkedRoles,
 	})
@@ -54,6 +55,10 @@ func testSweepIamServiceLinkedRoles(region string) error {
 				continue
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			log.Printf("[INFO] Waiting for deletion of IAM Service Role: %s", roleName)
 			err = deleteIamServiceLinkedRoleWaiter(conn, deletionTaskID)
 			if err != nil {
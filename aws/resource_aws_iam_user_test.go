@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/pquerna/otp/totp"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func TestValidateIamUserName(t *testing.T) {
@@ -55,7 +56,7 @@ func TestValidateIamUserName(t *testing.T) {
 }
 
 func init() {
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &sweep.Sweeper{
 		Name: "aws_iam_user",
 		F:    testSweepIamUsers,
 	})
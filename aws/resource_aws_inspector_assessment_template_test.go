@@ -26,6 +26,11 @@ func TestAccAWSInspectorTemplate_basic(t *testing.T) {
 					testAccCheckAWSInspectorTemplateExists("aws_inspector_assessment_template.foo"),
 				),
 			},
+			{
+				ResourceName:      "aws_inspector_assessment_template.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccCheckAWSInspectorTemplatetModified(rInt),
 				Check: resource.ComposeTestCheckFunc(
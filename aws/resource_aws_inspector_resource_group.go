@@ -1,12 +1,14 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAWSInspectorResourceGroup() *schema.Resource {
@@ -14,6 +16,9 @@ func resourceAWSInspectorResourceGroup() *schema.Resource {
 		Create: resourceAwsInspectorResourceGroupCreate,
 		Read:   resourceAwsInspectorResourceGroupRead,
 		Delete: resourceAwsInspectorResourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"tags": {
@@ -33,7 +38,7 @@ func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interf
 	conn := meta.(*AWSClient).inspectorconn
 
 	resp, err := conn.CreateResourceGroup(&inspector.CreateResourceGroupInput{
-		ResourceGroupTags: tagsFromMapInspector(d.Get("tags").(map[string]interface{})),
+		ResourceGroupTags: expandInspectorResourceGroupTags(d.Get("tags").(map[string]interface{})),
 	})
 
 	if err != nil {
@@ -50,7 +55,7 @@ func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interf
 func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).inspectorconn
 
-	_, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
+	resp, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
 		ResourceGroupArns: []*string{
 			aws.String(d.Id()),
 		},
@@ -65,9 +70,45 @@ func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if len(resp.ResourceGroups) == 0 {
+		log.Printf("[WARN] Inspector resource group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	resourceGroup := resp.ResourceGroups[0]
+	d.Set("arn", resourceGroup.Arn)
+
+	if err := d.Set("tags", flattenInspectorResourceGroupTags(resourceGroup.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsInspectorResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
+
+func expandInspectorResourceGroupTags(m map[string]interface{}) []*inspector.ResourceGroupTag {
+	var result []*inspector.ResourceGroupTag
+
+	for k, v := range keyvaluetags.New(m).IgnoreAws() {
+		result = append(result, &inspector.ResourceGroupTag{
+			Key:   aws.String(k),
+			Value: v,
+		})
+	}
+
+	return result
+}
+
+func flattenInspectorResourceGroupTags(tags []*inspector.ResourceGroupTag) map[string]interface{} {
+	m := make(map[string]interface{}, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
@@ -18,10 +18,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &sweep.Sweeper{
 		Name: "aws_instance",
 		F:    testSweepInstances,
 	})
@@ -50,6 +51,13 @@ func testSweepInstances(region string) error {
 				}
 
 				log.Printf("[INFO] Terminating EC2 Instance: %s", id)
+				if testSweepDryRun() {
+					sweepRunner.RecordDryRunRequest(ec2.ServiceName, "TerminateInstances", &ec2.TerminateInstancesInput{
+						InstanceIds: aws.StringSlice([]string{id}),
+					})
+					continue
+				}
+
 				err := awsTerminateInstance(conn, id, 5*time.Minute)
 				if err != nil {
 					log.Printf("[ERROR] Error terminating EC2 Instance (%s): %s", id, err)
@@ -15,6 +15,10 @@ func resourceAwsIotCertificate() *schema.Resource {
 		Read:   resourceAwsIotCertificateRead,
 		Update: resourceAwsIotCertificateUpdate,
 		Delete: resourceAwsIotCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"csr": {
 				Type:     schema.TypeString,
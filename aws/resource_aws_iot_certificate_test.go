@@ -49,6 +49,12 @@ func TestAccAWSIoTCertificate_keys_certificate(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_iot_certificate.foo_cert", "active", "true"),
 				),
 			},
+			{
+				ResourceName:            "aws_iot_certificate.foo_cert",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key", "public_key"},
+			},
 		},
 	})
 }
@@ -14,14 +14,19 @@ func resourceAwsIotPolicy() *schema.Resource {
 		Read:   resourceAwsIotPolicyRead,
 		Update: resourceAwsIotPolicyUpdate,
 		Delete: resourceAwsIotPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"policy": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -61,6 +66,12 @@ func resourceAwsIotPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		PolicyName: aws.String(d.Id()),
 	})
 
+	if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] IoT Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
 		log.Printf("[ERROR] %s", err)
 		return err
@@ -68,6 +79,8 @@ func resourceAwsIotPolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("arn", out.PolicyArn)
 	d.Set("default_version_id", out.DefaultVersionId)
+	d.Set("name", out.PolicyName)
+	d.Set("policy", out.PolicyDocument)
 
 	return nil
 }
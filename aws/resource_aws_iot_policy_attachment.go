@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -14,6 +15,19 @@ func resourceAwsIotPolicyAttachment() *schema.Resource {
 		Create: resourceAwsIotPolicyAttachmentCreate,
 		Read:   resourceAwsIotPolicyAttachmentRead,
 		Delete: resourceAwsIotPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.SplitN(d.Id(), "|", 2)
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected POLICY-NAME|TARGET", d.Id())
+				}
+				policy := idParts[0]
+				target := idParts[1]
+				d.Set("policy", policy)
+				d.Set("target", target)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"policy": {
 				Type:     schema.TypeString,
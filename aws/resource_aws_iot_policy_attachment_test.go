@@ -27,6 +27,11 @@ func TestAccAWSIotPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSIotPolicyAttachmentCertStatus("aws_iot_certificate.cert", []string{policyName}),
 				),
 			},
+			{
+				ResourceName:      "aws_iot_policy_attachment.att",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSIotPolicyAttachmentConfigUpdate1(policyName, policyName2),
 				Check: resource.ComposeTestCheckFunc(
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -14,6 +15,19 @@ func resourceAwsIotThingPrincipalAttachment() *schema.Resource {
 		Create: resourceAwsIotThingPrincipalAttachmentCreate,
 		Read:   resourceAwsIotThingPrincipalAttachmentRead,
 		Delete: resourceAwsIotThingPrincipalAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.SplitN(d.Id(), "|", 2)
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected THING-NAME|PRINCIPAL", d.Id())
+				}
+				thing := idParts[0]
+				principal := idParts[1]
+				d.Set("thing", thing)
+				d.Set("principal", principal)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"principal": {
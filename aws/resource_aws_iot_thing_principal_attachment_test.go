@@ -27,6 +27,11 @@ func TestAccAWSIotThingPrincipalAttachment_basic(t *testing.T) {
 					testAccCheckAWSIotThingPrincipalAttachmentStatus(thingName, true, []string{"aws_iot_certificate.cert"}),
 				),
 			},
+			{
+				ResourceName:      "aws_iot_thing_principal_attachment.att",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSIotThingPrincipalAttachmentConfigUpdate1(thingName, thingName2),
 				Check: resource.ComposeTestCheckFunc(
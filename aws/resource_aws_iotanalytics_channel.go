@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotAnalyticsChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsChannelCreate,
+		Read:   resourceAwsIotAnalyticsChannelRead,
+		Update: resourceAwsIotAnalyticsChannelUpdate,
+		Delete: resourceAwsIotAnalyticsChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_s3": iotAnalyticsCustomerManagedS3Schema(),
+			"name":                iotAnalyticsNameSchema(),
+			"retention_period":    iotAnalyticsRetentionPeriodSchema(),
+			"tags":                tagsSchema(),
+			"tags_all":            tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func iotAnalyticsNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(1, 128),
+			validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+$`), "must contain only alphanumeric characters and underscores"),
+		),
+	}
+}
+
+func iotAnalyticsCustomerManagedS3Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 255),
+				},
+				"key_prefix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`/$`), "must end with a slash (/)"),
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+			},
+		},
+	}
+}
+
+func iotAnalyticsRetentionPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"number_of_days": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"retention_period.0.unlimited"},
+				},
+				"unlimited": {
+					Type:          schema.TypeBool,
+					Optional:      true,
+					ConflictsWith: []string{"retention_period.0.number_of_days"},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	name := d.Get("name").(string)
+
+	input := &iotanalytics.CreateChannelInput{
+		ChannelName:     aws.String(name),
+		ChannelStorage:  expandIotAnalyticsChannelStorage(d.Get("customer_managed_s3").([]interface{})),
+		RetentionPeriod: expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Channel: %s", input)
+	_, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Channel (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	channel, err := finder.ChannelByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(channel.Arn)
+	d.Set("arn", arn)
+	d.Set("name", channel.Name)
+
+	var customerManagedS3 []interface{}
+	if channel.Storage != nil && channel.Storage.CustomerManagedS3 != nil {
+		v := channel.Storage.CustomerManagedS3
+		customerManagedS3 = flattenIotAnalyticsCustomerManagedS3(v.Bucket, v.KeyPrefix, v.RoleArn)
+	}
+
+	if err := d.Set("customer_managed_s3", customerManagedS3); err != nil {
+		return fmt.Errorf("error setting customer_managed_s3: %s", err)
+	}
+
+	if err := d.Set("retention_period", flattenIotAnalyticsRetentionPeriod(channel.RetentionPeriod)); err != nil {
+		return fmt.Errorf("error setting retention_period: %s", err)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChange("customer_managed_s3") || d.HasChange("retention_period") {
+		input := &iotanalytics.UpdateChannelInput{
+			ChannelName:     aws.String(d.Id()),
+			ChannelStorage:  expandIotAnalyticsChannelStorage(d.Get("customer_managed_s3").([]interface{})),
+			RetentionPeriod: expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Channel: %s", input)
+		_, err := conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(&iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, iotanalytics.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandIotAnalyticsChannelStorage returns service-managed storage when no customer-managed bucket is configured,
+// so that removing the bucket from configuration reverts the channel to the default storage.
+func expandIotAnalyticsChannelStorage(tfList []interface{}) *iotanalytics.ChannelStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &iotanalytics.ChannelStorage{
+			ServiceManagedS3: &iotanalytics.ServiceManagedChannelS3Storage{},
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &iotanalytics.CustomerManagedChannelS3Storage{
+		Bucket:  aws.String(tfMap["bucket"].(string)),
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	return &iotanalytics.ChannelStorage{
+		CustomerManagedS3: apiObject,
+	}
+}
+
+func flattenIotAnalyticsCustomerManagedS3(bucket, keyPrefix, roleArn *string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"bucket":     aws.StringValue(bucket),
+			"key_prefix": aws.StringValue(keyPrefix),
+			"role_arn":   aws.StringValue(roleArn),
+		},
+	}
+}
+
+func expandIotAnalyticsRetentionPeriod(tfList []interface{}) *iotanalytics.RetentionPeriod {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.RetentionPeriod{}
+
+	if v, ok := tfMap["number_of_days"].(int); ok && v > 0 {
+		apiObject.NumberOfDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenIotAnalyticsRetentionPeriod(apiObject *iotanalytics.RetentionPeriod) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"number_of_days": int(aws.Int64Value(apiObject.NumberOfDays)),
+			"unlimited":      aws.BoolValue(apiObject.Unlimited),
+		},
+	}
+}
@@ -0,0 +1,881 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotAnalyticsDataset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsDatasetCreate,
+		Read:   resourceAwsIotAnalyticsDatasetRead,
+		Update: resourceAwsIotAnalyticsDatasetUpdate,
+		Delete: resourceAwsIotAnalyticsDatasetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_action": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"action.0.query_action"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"execution_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"resource_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														iotanalytics.ComputeTypeAcu1,
+														iotanalytics.ComputeTypeAcu2,
+													}, false),
+												},
+												"volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 50),
+												},
+											},
+										},
+									},
+									"variable": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dataset_content_version_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"dataset_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+														},
+													},
+												},
+												"double_value": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"output_file_uri_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"file_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.NoZeroValues,
+															},
+														},
+													},
+												},
+												"string_value": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"query_action": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"action.0.container_action"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delta_time": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"offset_seconds": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"time_expression": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.NoZeroValues,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"sql_query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iot_events": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateArn,
+												},
+											},
+										},
+									},
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 255),
+												},
+												"glue_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"database_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+															"table_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+														},
+													},
+												},
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateArn,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"entry_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"name":             iotAnalyticsNameSchema(),
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+			"tags":             tagsSchema(),
+			"tags_all":         tagsSchemaTrulyComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"trigger.0.schedule"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+						"schedule": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"trigger.0.dataset"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_versions": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 1000),
+							ConflictsWith: []string{"versioning_configuration.0.unlimited"},
+						},
+						"unlimited": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"versioning_configuration.0.max_versions"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsDatasetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	name := d.Get("name").(string)
+
+	input := &iotanalytics.CreateDatasetInput{
+		Actions:                 expandIotAnalyticsDatasetActions(d.Get("action").([]interface{})),
+		ContentDeliveryRules:    expandIotAnalyticsDatasetContentDeliveryRules(d.Get("content_delivery_rule").([]interface{})),
+		DatasetName:             aws.String(name),
+		RetentionPeriod:         expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+		Triggers:                expandIotAnalyticsDatasetTriggers(d.Get("trigger").([]interface{})),
+		VersioningConfiguration: expandIotAnalyticsDatasetVersioningConfiguration(d.Get("versioning_configuration").([]interface{})),
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Dataset: %s", input)
+	_, err := conn.CreateDataset(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Dataset (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsDatasetRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatasetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	dataset, err := finder.DatasetByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(dataset.Arn)
+	d.Set("arn", arn)
+	d.Set("name", dataset.Name)
+
+	if err := d.Set("action", flattenIotAnalyticsDatasetActions(dataset.Actions)); err != nil {
+		return fmt.Errorf("error setting action: %s", err)
+	}
+
+	if err := d.Set("content_delivery_rule", flattenIotAnalyticsDatasetContentDeliveryRules(dataset.ContentDeliveryRules)); err != nil {
+		return fmt.Errorf("error setting content_delivery_rule: %s", err)
+	}
+
+	if err := d.Set("retention_period", flattenIotAnalyticsRetentionPeriod(dataset.RetentionPeriod)); err != nil {
+		return fmt.Errorf("error setting retention_period: %s", err)
+	}
+
+	if err := d.Set("trigger", flattenIotAnalyticsDatasetTriggers(dataset.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %s", err)
+	}
+
+	if err := d.Set("versioning_configuration", flattenIotAnalyticsDatasetVersioningConfiguration(dataset.VersioningConfiguration)); err != nil {
+		return fmt.Errorf("error setting versioning_configuration: %s", err)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsDatasetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChange("action") || d.HasChange("content_delivery_rule") || d.HasChange("retention_period") || d.HasChange("trigger") || d.HasChange("versioning_configuration") {
+		input := &iotanalytics.UpdateDatasetInput{
+			Actions:                 expandIotAnalyticsDatasetActions(d.Get("action").([]interface{})),
+			ContentDeliveryRules:    expandIotAnalyticsDatasetContentDeliveryRules(d.Get("content_delivery_rule").([]interface{})),
+			DatasetName:             aws.String(d.Id()),
+			RetentionPeriod:         expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+			Triggers:                expandIotAnalyticsDatasetTriggers(d.Get("trigger").([]interface{})),
+			VersioningConfiguration: expandIotAnalyticsDatasetVersioningConfiguration(d.Get("versioning_configuration").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Dataset: %s", input)
+		_, err := conn.UpdateDataset(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Dataset (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Dataset (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsDatasetRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatasetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Dataset: %s", d.Id())
+	_, err := conn.DeleteDataset(&iotanalytics.DeleteDatasetInput{
+		DatasetName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, iotanalytics.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsDatasetActions(tfList []interface{}) []*iotanalytics.DatasetAction {
+	var apiObjects []*iotanalytics.DatasetAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetAction{
+			ActionName: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["container_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ContainerAction = expandIotAnalyticsDatasetContainerAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["query_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.QueryAction = expandIotAnalyticsDatasetQueryAction(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotAnalyticsDatasetContainerAction(tfMap map[string]interface{}) *iotanalytics.ContainerDatasetAction {
+	apiObject := &iotanalytics.ContainerDatasetAction{
+		ExecutionRoleArn: aws.String(tfMap["execution_role_arn"].(string)),
+		Image:            aws.String(tfMap["image"].(string)),
+	}
+
+	if v, ok := tfMap["resource_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.ResourceConfiguration = &iotanalytics.ResourceConfiguration{
+			ComputeType:    aws.String(m["compute_type"].(string)),
+			VolumeSizeInGB: aws.Int64(int64(m["volume_size_in_gb"].(int))),
+		}
+	}
+
+	for _, tfMapRaw := range tfMap["variable"].([]interface{}) {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		variable := &iotanalytics.Variable{
+			Name: aws.String(m["name"].(string)),
+		}
+
+		if v, ok := m["dataset_content_version_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			variable.DatasetContentVersionValue = &iotanalytics.DatasetContentVersionValue{
+				DatasetName: aws.String(v[0].(map[string]interface{})["dataset_name"].(string)),
+			}
+		}
+
+		if v, ok := m["double_value"].(float64); ok && v != 0 {
+			variable.DoubleValue = aws.Float64(v)
+		}
+
+		if v, ok := m["output_file_uri_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			variable.OutputFileUriValue = &iotanalytics.OutputFileUriValue{
+				FileName: aws.String(v[0].(map[string]interface{})["file_name"].(string)),
+			}
+		}
+
+		if v, ok := m["string_value"].(string); ok && v != "" {
+			variable.StringValue = aws.String(v)
+		}
+
+		apiObject.Variables = append(apiObject.Variables, variable)
+	}
+
+	return apiObject
+}
+
+func expandIotAnalyticsDatasetQueryAction(tfMap map[string]interface{}) *iotanalytics.SqlQueryDatasetAction {
+	apiObject := &iotanalytics.SqlQueryDatasetAction{
+		SqlQuery: aws.String(tfMap["sql_query"].(string)),
+	}
+
+	for _, tfMapRaw := range tfMap["filter"].([]interface{}) {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filter := &iotanalytics.QueryFilter{}
+
+		if v, ok := m["delta_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			deltaTime := v[0].(map[string]interface{})
+			filter.DeltaTime = &iotanalytics.DeltaTime{
+				OffsetSeconds:  aws.Int64(int64(deltaTime["offset_seconds"].(int))),
+				TimeExpression: aws.String(deltaTime["time_expression"].(string)),
+			}
+		}
+
+		apiObject.Filters = append(apiObject.Filters, filter)
+	}
+
+	return apiObject
+}
+
+func expandIotAnalyticsDatasetContentDeliveryRules(tfList []interface{}) []*iotanalytics.DatasetContentDeliveryRule {
+	var apiObjects []*iotanalytics.DatasetContentDeliveryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetContentDeliveryRule{
+			Destination: &iotanalytics.DatasetContentDeliveryDestination{},
+		}
+
+		if v, ok := tfMap["entry_name"].(string); ok && v != "" {
+			apiObject.EntryName = aws.String(v)
+		}
+
+		if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			destination := v[0].(map[string]interface{})
+
+			if v, ok := destination["iot_events"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				m := v[0].(map[string]interface{})
+				apiObject.Destination.IotEventsDestinationConfiguration = &iotanalytics.IotEventsDestinationConfiguration{
+					InputName: aws.String(m["input_name"].(string)),
+					RoleArn:   aws.String(m["role_arn"].(string)),
+				}
+			}
+
+			if v, ok := destination["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				m := v[0].(map[string]interface{})
+				s3 := &iotanalytics.S3DestinationConfiguration{
+					Bucket:  aws.String(m["bucket"].(string)),
+					Key:     aws.String(m["key"].(string)),
+					RoleArn: aws.String(m["role_arn"].(string)),
+				}
+
+				if v, ok := m["glue_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					glue := v[0].(map[string]interface{})
+					s3.GlueConfiguration = &iotanalytics.GlueConfiguration{
+						DatabaseName: aws.String(glue["database_name"].(string)),
+						TableName:    aws.String(glue["table_name"].(string)),
+					}
+				}
+
+				apiObject.Destination.S3DestinationConfiguration = s3
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotAnalyticsDatasetTriggers(tfList []interface{}) []*iotanalytics.DatasetTrigger {
+	var apiObjects []*iotanalytics.DatasetTrigger
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetTrigger{}
+
+		if v, ok := tfMap["dataset"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Dataset = &iotanalytics.TriggeringDataset{
+				Name: aws.String(v[0].(map[string]interface{})["name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["schedule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Schedule = &iotanalytics.Schedule{
+				Expression: aws.String(v[0].(map[string]interface{})["expression"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotAnalyticsDatasetVersioningConfiguration(tfList []interface{}) *iotanalytics.VersioningConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.VersioningConfiguration{}
+
+	if v, ok := tfMap["max_versions"].(int); ok && v > 0 {
+		apiObject.MaxVersions = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenIotAnalyticsDatasetActions(apiObjects []*iotanalytics.DatasetAction) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"container_action": []interface{}{},
+			"name":             aws.StringValue(apiObject.ActionName),
+			"query_action":     []interface{}{},
+		}
+
+		if v := apiObject.ContainerAction; v != nil {
+			tfMap["container_action"] = []interface{}{flattenIotAnalyticsDatasetContainerAction(v)}
+		}
+
+		if v := apiObject.QueryAction; v != nil {
+			filters := []interface{}{}
+
+			for _, filter := range v.Filters {
+				if filter == nil || filter.DeltaTime == nil {
+					continue
+				}
+
+				filters = append(filters, map[string]interface{}{
+					"delta_time": []interface{}{
+						map[string]interface{}{
+							"offset_seconds":  int(aws.Int64Value(filter.DeltaTime.OffsetSeconds)),
+							"time_expression": aws.StringValue(filter.DeltaTime.TimeExpression),
+						},
+					},
+				})
+			}
+
+			tfMap["query_action"] = []interface{}{
+				map[string]interface{}{
+					"filter":    filters,
+					"sql_query": aws.StringValue(v.SqlQuery),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotAnalyticsDatasetContainerAction(apiObject *iotanalytics.ContainerDatasetAction) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"execution_role_arn":     aws.StringValue(apiObject.ExecutionRoleArn),
+		"image":                  aws.StringValue(apiObject.Image),
+		"resource_configuration": []interface{}{},
+	}
+
+	if v := apiObject.ResourceConfiguration; v != nil {
+		tfMap["resource_configuration"] = []interface{}{
+			map[string]interface{}{
+				"compute_type":      aws.StringValue(v.ComputeType),
+				"volume_size_in_gb": int(aws.Int64Value(v.VolumeSizeInGB)),
+			},
+		}
+	}
+
+	variables := []interface{}{}
+
+	for _, variable := range apiObject.Variables {
+		if variable == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"dataset_content_version_value": []interface{}{},
+			"double_value":                  aws.Float64Value(variable.DoubleValue),
+			"name":                          aws.StringValue(variable.Name),
+			"output_file_uri_value":         []interface{}{},
+			"string_value":                  aws.StringValue(variable.StringValue),
+		}
+
+		if v := variable.DatasetContentVersionValue; v != nil {
+			m["dataset_content_version_value"] = []interface{}{
+				map[string]interface{}{
+					"dataset_name": aws.StringValue(v.DatasetName),
+				},
+			}
+		}
+
+		if v := variable.OutputFileUriValue; v != nil {
+			m["output_file_uri_value"] = []interface{}{
+				map[string]interface{}{
+					"file_name": aws.StringValue(v.FileName),
+				},
+			}
+		}
+
+		variables = append(variables, m)
+	}
+
+	tfMap["variable"] = variables
+
+	return tfMap
+}
+
+func flattenIotAnalyticsDatasetContentDeliveryRules(apiObjects []*iotanalytics.DatasetContentDeliveryRule) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		destination := map[string]interface{}{
+			"iot_events": []interface{}{},
+			"s3":         []interface{}{},
+		}
+
+		if apiObject.Destination != nil {
+			if v := apiObject.Destination.IotEventsDestinationConfiguration; v != nil {
+				destination["iot_events"] = []interface{}{
+					map[string]interface{}{
+						"input_name": aws.StringValue(v.InputName),
+						"role_arn":   aws.StringValue(v.RoleArn),
+					},
+				}
+			}
+
+			if v := apiObject.Destination.S3DestinationConfiguration; v != nil {
+				glueConfiguration := []interface{}{}
+
+				if glue := v.GlueConfiguration; glue != nil {
+					glueConfiguration = append(glueConfiguration, map[string]interface{}{
+						"database_name": aws.StringValue(glue.DatabaseName),
+						"table_name":    aws.StringValue(glue.TableName),
+					})
+				}
+
+				destination["s3"] = []interface{}{
+					map[string]interface{}{
+						"bucket":             aws.StringValue(v.Bucket),
+						"glue_configuration": glueConfiguration,
+						"key":                aws.StringValue(v.Key),
+						"role_arn":           aws.StringValue(v.RoleArn),
+					},
+				}
+			}
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"destination": []interface{}{destination},
+			"entry_name":  aws.StringValue(apiObject.EntryName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenIotAnalyticsDatasetTriggers(apiObjects []*iotanalytics.DatasetTrigger) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"dataset":  []interface{}{},
+			"schedule": []interface{}{},
+		}
+
+		if v := apiObject.Dataset; v != nil {
+			tfMap["dataset"] = []interface{}{
+				map[string]interface{}{
+					"name": aws.StringValue(v.Name),
+				},
+			}
+		}
+
+		if v := apiObject.Schedule; v != nil {
+			tfMap["schedule"] = []interface{}{
+				map[string]interface{}{
+					"expression": aws.StringValue(v.Expression),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotAnalyticsDatasetVersioningConfiguration(apiObject *iotanalytics.VersioningConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_versions": int(aws.Int64Value(apiObject.MaxVersions)),
+			"unlimited":    aws.BoolValue(apiObject.Unlimited),
+		},
+	}
+}
@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotAnalyticsDatastore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsDatastoreCreate,
+		Read:   resourceAwsIotAnalyticsDatastoreRead,
+		Update: resourceAwsIotAnalyticsDatastoreUpdate,
+		Delete: resourceAwsIotAnalyticsDatastoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_s3": iotAnalyticsCustomerManagedS3Schema(),
+			"name":                iotAnalyticsNameSchema(),
+			"retention_period":    iotAnalyticsRetentionPeriodSchema(),
+			"tags":                tagsSchema(),
+			"tags_all":            tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsDatastoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	name := d.Get("name").(string)
+
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName:    aws.String(name),
+		DatastoreStorage: expandIotAnalyticsDatastoreStorage(d.Get("customer_managed_s3").([]interface{})),
+		RetentionPeriod:  expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Datastore: %s", input)
+	_, err := conn.CreateDatastore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Datastore (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	datastore, err := finder.DatastoreByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(datastore.Arn)
+	d.Set("arn", arn)
+	d.Set("name", datastore.Name)
+
+	var customerManagedS3 []interface{}
+	if datastore.Storage != nil && datastore.Storage.CustomerManagedS3 != nil {
+		v := datastore.Storage.CustomerManagedS3
+		customerManagedS3 = flattenIotAnalyticsCustomerManagedS3(v.Bucket, v.KeyPrefix, v.RoleArn)
+	}
+
+	if err := d.Set("customer_managed_s3", customerManagedS3); err != nil {
+		return fmt.Errorf("error setting customer_managed_s3: %s", err)
+	}
+
+	if err := d.Set("retention_period", flattenIotAnalyticsRetentionPeriod(datastore.RetentionPeriod)); err != nil {
+		return fmt.Errorf("error setting retention_period: %s", err)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsDatastoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChange("customer_managed_s3") || d.HasChange("retention_period") {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName:    aws.String(d.Id()),
+			DatastoreStorage: expandIotAnalyticsDatastoreStorage(d.Get("customer_managed_s3").([]interface{})),
+			RetentionPeriod:  expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Datastore: %s", input)
+		_, err := conn.UpdateDatastore(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Datastore: %s", d.Id())
+	_, err := conn.DeleteDatastore(&iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, iotanalytics.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandIotAnalyticsDatastoreStorage returns service-managed storage when no customer-managed bucket is configured,
+// so that removing the bucket from configuration reverts the data store to the default storage.
+func expandIotAnalyticsDatastoreStorage(tfList []interface{}) *iotanalytics.DatastoreStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &iotanalytics.DatastoreStorage{
+			ServiceManagedS3: &iotanalytics.ServiceManagedDatastoreS3Storage{},
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &iotanalytics.CustomerManagedDatastoreS3Storage{
+		Bucket:  aws.String(tfMap["bucket"].(string)),
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	return &iotanalytics.DatastoreStorage{
+		CustomerManagedS3: apiObject,
+	}
+}
@@ -0,0 +1,401 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_iotanalytics_pipeline", &sweep.Sweeper{
+		Name: "aws_iotanalytics_pipeline",
+		F:    testSweepIotAnalyticsPipelines,
+	})
+}
+
+func testSweepIotAnalyticsPipelines(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).iotanalyticsconn
+	input := &iotanalytics.ListPipelinesInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListPipelines(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Analytics Pipeline sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Analytics Pipelines: %s", err))
+			return sweeperErrs
+		}
+
+		for _, pipeline := range output.PipelineSummaries {
+			name := aws.StringValue(pipeline.PipelineName)
+
+			log.Printf("[INFO] Deleting IoT Analytics Pipeline: %s", name)
+			_, err := conn.DeletePipeline(&iotanalytics.DeletePipelineInput{
+				PipelineName: aws.String(name),
+			})
+
+			if isAWSErr(err, iotanalytics.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting IoT Analytics Pipeline (%s): %s", name, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotAnalyticsPipeline_basic(t *testing.T) {
+	var pipeline iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	channelResourceName := "aws_iotanalytics_channel.test"
+	datastoreResourceName := "aws_iotanalytics_datastore.test"
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &pipeline),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("pipeline/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.0.channel.0.channel_name", channelResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.name", "channel"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "datastore"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.1.datastore.0.datastore_name", datastoreResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.0.name", "datastore"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigActivities(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "filter"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.filter", "temperature > 0"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.next", "math"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.attribute", "fahrenheit"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.math", "(temperature * 9 / 5) + 32"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.select_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.select_attributes.0.attributes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.select_attributes.0.attributes.0", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.select_attributes.0.attributes.1", "fahrenheit"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.datastore.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_disappears(t *testing.T) {
+	var pipeline iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &pipeline),
+					testAccCheckAWSIotAnalyticsPipelineDisappears(&pipeline),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_tags(t *testing.T) {
+	var pipeline iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsPipelineDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_pipeline" {
+			continue
+		}
+
+		_, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Pipeline %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIotAnalyticsPipelineDisappears(pipeline *iotanalytics.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		_, err := conn.DeletePipeline(&iotanalytics.DeletePipelineInput{
+			PipelineName: pipeline.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSIotAnalyticsPipelineExists(n string, v *iotanalytics.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Pipeline ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		pipeline, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *pipeline
+
+		return nil
+	}
+}
+
+func testAccAWSIotAnalyticsPipelineConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsPipelineConfigBasic(rName string) string {
+	return testAccAWSIotAnalyticsPipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel"
+      channel_name = "${aws_iotanalytics_channel.test.name}"
+      next         = "datastore"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore"
+      datastore_name = "${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsPipelineConfigActivities(rName string) string {
+	return testAccAWSIotAnalyticsPipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel"
+      channel_name = "${aws_iotanalytics_channel.test.name}"
+      next         = "filter"
+    }
+  }
+
+  activity {
+    filter {
+      name   = "filter"
+      filter = "temperature > 0"
+      next   = "math"
+    }
+  }
+
+  activity {
+    math {
+      name      = "math"
+      attribute = "fahrenheit"
+      math      = "(temperature * 9 / 5) + 32"
+      next      = "select"
+    }
+  }
+
+  activity {
+    select_attributes {
+      name       = "select"
+      attributes = ["device_id", "fahrenheit"]
+      next       = "datastore"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore"
+      datastore_name = "${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSIotAnalyticsPipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel"
+      channel_name = "${aws_iotanalytics_channel.test.name}"
+      next         = "datastore"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore"
+      datastore_name = "${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSIotAnalyticsPipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel"
+      channel_name = "${aws_iotanalytics_channel.test.name}"
+      next         = "datastore"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore"
+      datastore_name = "${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,866 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotEventsDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotEventsDetectorModelCreate,
+		Read:   resourceAwsIotEventsDetectorModelRead,
+		Update: resourceAwsIotEventsDetectorModelUpdate,
+		Delete: resourceAwsIotEventsDetectorModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_state_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"state": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_enter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsDetectorModelEventSchema(),
+											},
+										},
+									},
+									"on_exit": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsDetectorModelEventSchema(),
+											},
+										},
+									},
+									"on_input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsDetectorModelEventSchema(),
+												"transition_event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"action": iotEventsDetectorModelActionSchema(),
+															"condition": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 512),
+															},
+															"event_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+															"next_state": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"state_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func iotEventsDetectorModelEventSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": iotEventsDetectorModelActionSchema(),
+				"condition": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 512),
+				},
+				"event_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+func iotEventsDetectorModelActionSchema() *schema.Schema {
+	timerNameSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"clear_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timer_name": timerNameSchema(),
+						},
+					},
+				},
+				"firehose": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delivery_stream_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							"separator": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"\n",
+									"\t",
+									"\r\n",
+									",",
+								}, false),
+							},
+						},
+					},
+				},
+				"iot_events": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"input_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"iot_topic_publish": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mqtt_topic": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"lambda": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"function_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateArn,
+							},
+						},
+					},
+				},
+				"reset_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timer_name": timerNameSchema(),
+						},
+					},
+				},
+				"set_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"seconds": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(60),
+							},
+							"timer_name": timerNameSchema(),
+						},
+					},
+				},
+				"set_variable": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							"variable_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"sns": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"target_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateArn,
+							},
+						},
+					},
+				},
+				"sqs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"queue_url": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							"use_base64": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsIotEventsDetectorModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	name := d.Get("name").(string)
+
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: expandIotEventsDetectorModelDefinition(d.Get("definition").([]interface{})),
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IoteventsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Detector Model: %s", input)
+	_, err := conn.CreateDetectorModel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Events Detector Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Detector Model (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	detectorModel, err := finder.DetectorModelByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	configuration := detectorModel.DetectorModelConfiguration
+	arn := aws.StringValue(configuration.DetectorModelArn)
+	d.Set("arn", arn)
+	d.Set("description", configuration.DetectorModelDescription)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("version", configuration.DetectorModelVersion)
+
+	if err := d.Set("definition", flattenIotEventsDetectorModelDefinition(detectorModel.DetectorModelDefinition)); err != nil {
+		return fmt.Errorf("error setting definition: %s", err)
+	}
+
+	tags, err := keyvaluetags.IoteventsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotEventsDetectorModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	if d.HasChange("definition") || d.HasChange("description") || d.HasChange("role_arn") {
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition: expandIotEventsDetectorModelDefinition(d.Get("definition").([]interface{})),
+			DetectorModelName:       aws.String(d.Id()),
+			RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.DetectorModelDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Events Detector Model: %s", input)
+		_, err := conn.UpdateDetectorModel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for IoT Events Detector Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.IoteventsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	log.Printf("[DEBUG] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := conn.DeleteDetectorModel(&iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, iotevents.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.DetectorModelDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Detector Model (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotEventsDetectorModelDefinition(tfList []interface{}) *iotevents.DetectorModelDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &iotevents.DetectorModelDefinition{
+		InitialStateName: aws.String(tfMap["initial_state_name"].(string)),
+	}
+
+	for _, tfMapRaw := range tfMap["state"].([]interface{}) {
+		state, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.States = append(apiObject.States, expandIotEventsDetectorModelState(state))
+	}
+
+	return apiObject
+}
+
+func expandIotEventsDetectorModelState(tfMap map[string]interface{}) *iotevents.State {
+	apiObject := &iotevents.State{
+		StateName: aws.String(tfMap["state_name"].(string)),
+	}
+
+	if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnEnter = &iotevents.OnEnterLifecycle{
+			Events: expandIotEventsDetectorModelEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnExit = &iotevents.OnExitLifecycle{
+			Events: expandIotEventsDetectorModelEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		onInput := v[0].(map[string]interface{})
+
+		apiObject.OnInput = &iotevents.OnInputLifecycle{
+			Events:           expandIotEventsDetectorModelEvents(onInput["event"].([]interface{})),
+			TransitionEvents: expandIotEventsDetectorModelTransitionEvents(onInput["transition_event"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandIotEventsDetectorModelEvents(tfList []interface{}) []*iotevents.Event {
+	var apiObjects []*iotevents.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.Event{
+			Actions:   expandIotEventsDetectorModelActions(tfMap["action"].([]interface{})),
+			EventName: aws.String(tfMap["event_name"].(string)),
+		}
+
+		if v, ok := tfMap["condition"].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsDetectorModelTransitionEvents(tfList []interface{}) []*iotevents.TransitionEvent {
+	var apiObjects []*iotevents.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iotevents.TransitionEvent{
+			Actions:   expandIotEventsDetectorModelActions(tfMap["action"].([]interface{})),
+			Condition: aws.String(tfMap["condition"].(string)),
+			EventName: aws.String(tfMap["event_name"].(string)),
+			NextState: aws.String(tfMap["next_state"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsDetectorModelActions(tfList []interface{}) []*iotevents.ActionData {
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.ActionData{}
+
+		if v, ok := tfMap["clear_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ClearTimer = &iotevents.ClearTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.Firehose = &iotevents.FirehoseAction{
+				DeliveryStreamName: aws.String(m["delivery_stream_name"].(string)),
+			}
+
+			if v, ok := m["separator"].(string); ok && v != "" {
+				apiObject.Firehose.Separator = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["iot_events"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotEvents = &iotevents.Action{
+				InputName: aws.String(v[0].(map[string]interface{})["input_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotTopicPublish = &iotevents.IotTopicPublishAction{
+				MqttTopic: aws.String(v[0].(map[string]interface{})["mqtt_topic"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Lambda = &iotevents.LambdaAction{
+				FunctionArn: aws.String(v[0].(map[string]interface{})["function_arn"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["reset_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ResetTimer = &iotevents.ResetTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.SetTimer = &iotevents.SetTimerAction{
+				Seconds:   aws.Int64(int64(m["seconds"].(int))),
+				TimerName: aws.String(m["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_variable"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.SetVariable = &iotevents.SetVariableAction{
+				Value:        aws.String(m["value"].(string)),
+				VariableName: aws.String(m["variable_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Sns = &iotevents.SNSTopicPublishAction{
+				TargetArn: aws.String(v[0].(map[string]interface{})["target_arn"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sqs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.Sqs = &iotevents.SqsAction{
+				QueueUrl:  aws.String(m["queue_url"].(string)),
+				UseBase64: aws.Bool(m["use_base64"].(bool)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIotEventsDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	states := []interface{}{}
+
+	for _, state := range apiObject.States {
+		if state == nil {
+			continue
+		}
+
+		states = append(states, flattenIotEventsDetectorModelState(state))
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"initial_state_name": aws.StringValue(apiObject.InitialStateName),
+			"state":              states,
+		},
+	}
+}
+
+func flattenIotEventsDetectorModelState(apiObject *iotevents.State) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"on_enter":   []interface{}{},
+		"on_exit":    []interface{}{},
+		"on_input":   []interface{}{},
+		"state_name": aws.StringValue(apiObject.StateName),
+	}
+
+	// Lifecycles without any events are equivalent to the block being omitted.
+	if v := apiObject.OnEnter; v != nil && len(v.Events) > 0 {
+		tfMap["on_enter"] = []interface{}{
+			map[string]interface{}{
+				"event": flattenIotEventsDetectorModelEvents(v.Events),
+			},
+		}
+	}
+
+	if v := apiObject.OnExit; v != nil && len(v.Events) > 0 {
+		tfMap["on_exit"] = []interface{}{
+			map[string]interface{}{
+				"event": flattenIotEventsDetectorModelEvents(v.Events),
+			},
+		}
+	}
+
+	if v := apiObject.OnInput; v != nil && (len(v.Events) > 0 || len(v.TransitionEvents) > 0) {
+		tfMap["on_input"] = []interface{}{
+			map[string]interface{}{
+				"event":            flattenIotEventsDetectorModelEvents(v.Events),
+				"transition_event": flattenIotEventsDetectorModelTransitionEvents(v.TransitionEvents),
+			},
+		}
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsDetectorModelEvents(apiObjects []*iotevents.Event) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     flattenIotEventsDetectorModelActions(apiObject.Actions),
+			"condition":  aws.StringValue(apiObject.Condition),
+			"event_name": aws.StringValue(apiObject.EventName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenIotEventsDetectorModelTransitionEvents(apiObjects []*iotevents.TransitionEvent) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     flattenIotEventsDetectorModelActions(apiObject.Actions),
+			"condition":  aws.StringValue(apiObject.Condition),
+			"event_name": aws.StringValue(apiObject.EventName),
+			"next_state": aws.StringValue(apiObject.NextState),
+		})
+	}
+
+	return tfList
+}
+
+func flattenIotEventsDetectorModelActions(apiObjects []*iotevents.ActionData) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ClearTimer; v != nil {
+			tfMap["clear_timer"] = []interface{}{
+				map[string]interface{}{
+					"timer_name": aws.StringValue(v.TimerName),
+				},
+			}
+		}
+
+		if v := apiObject.Firehose; v != nil {
+			tfMap["firehose"] = []interface{}{
+				map[string]interface{}{
+					"delivery_stream_name": aws.StringValue(v.DeliveryStreamName),
+					"separator":            aws.StringValue(v.Separator),
+				},
+			}
+		}
+
+		if v := apiObject.IotEvents; v != nil {
+			tfMap["iot_events"] = []interface{}{
+				map[string]interface{}{
+					"input_name": aws.StringValue(v.InputName),
+				},
+			}
+		}
+
+		if v := apiObject.IotTopicPublish; v != nil {
+			tfMap["iot_topic_publish"] = []interface{}{
+				map[string]interface{}{
+					"mqtt_topic": aws.StringValue(v.MqttTopic),
+				},
+			}
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = []interface{}{
+				map[string]interface{}{
+					"function_arn": aws.StringValue(v.FunctionArn),
+				},
+			}
+		}
+
+		if v := apiObject.ResetTimer; v != nil {
+			tfMap["reset_timer"] = []interface{}{
+				map[string]interface{}{
+					"timer_name": aws.StringValue(v.TimerName),
+				},
+			}
+		}
+
+		if v := apiObject.SetTimer; v != nil {
+			tfMap["set_timer"] = []interface{}{
+				map[string]interface{}{
+					"seconds":    int(aws.Int64Value(v.Seconds)),
+					"timer_name": aws.StringValue(v.TimerName),
+				},
+			}
+		}
+
+		if v := apiObject.SetVariable; v != nil {
+			tfMap["set_variable"] = []interface{}{
+				map[string]interface{}{
+					"value":         aws.StringValue(v.Value),
+					"variable_name": aws.StringValue(v.VariableName),
+				},
+			}
+		}
+
+		if v := apiObject.Sns; v != nil {
+			tfMap["sns"] = []interface{}{
+				map[string]interface{}{
+					"target_arn": aws.StringValue(v.TargetArn),
+				},
+			}
+		}
+
+		if v := apiObject.Sqs; v != nil {
+			tfMap["sqs"] = []interface{}{
+				map[string]interface{}{
+					"queue_url":  aws.StringValue(v.QueueUrl),
+					"use_base64": aws.BoolValue(v.UseBase64),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
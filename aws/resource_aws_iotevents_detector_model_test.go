@@ -0,0 +1,545 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_iotevents_detector_model", &sweep.Sweeper{
+		Name: "aws_iotevents_detector_model",
+		F:    testSweepIotEventsDetectorModels,
+	})
+}
+
+func testSweepIotEventsDetectorModels(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ioteventsconn
+	input := &iotevents.ListDetectorModelsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListDetectorModels(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Detector Model sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Events Detector Models: %s", err))
+			return sweeperErrs
+		}
+
+		for _, detectorModelSummary := range output.DetectorModelSummaries {
+			name := aws.StringValue(detectorModelSummary.DetectorModelName)
+
+			log.Printf("[INFO] Deleting IoT Events Detector Model: %s", name)
+			_, err := conn.DeleteDetectorModel(&iotevents.DeleteDetectorModelInput{
+				DetectorModelName: aws.String(name),
+			})
+
+			if isAWSErr(err, iotevents.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting IoT Events Detector Model (%s): %s", name, err))
+				continue
+			}
+
+			if testSweepDryRun() {
+				continue
+			}
+
+			if _, err := waiter.DetectorModelDeleted(conn, name); err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error waiting for IoT Events Detector Model (%s) deletion: %s", name, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotEventsDetectorModel_basic(t *testing.T) {
+	var detectorModel iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &detectorModel),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("detectorModel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.initial_state_name", "Normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.state_name", "Normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.transition_event.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.transition_event.0.next_state", "Overheated"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.state_name", "Overheated"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.0.sns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &detectorModel),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.1.iot_topic_publish.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.1.iot_topic_publish.0.mqtt_topic", "alerts/overheated"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_input.0.transition_event.0.next_state", "Normal"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Temperature alarm"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_disappears(t *testing.T) {
+	var detectorModel iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &detectorModel),
+					testAccCheckAWSIotEventsDetectorModelDisappears(&detectorModel),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_Key(t *testing.T) {
+	var detectorModel iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &detectorModel),
+					resource.TestCheckResourceAttr(resourceName, "key", "sensorId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_tags(t *testing.T) {
+	var detectorModel iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &detectorModel),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &detectorModel),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &detectorModel),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotEventsDetectorModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_detector_model" {
+			continue
+		}
+
+		_, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIotEventsDetectorModelDisappears(detectorModel *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+		name := aws.StringValue(detectorModel.DetectorModelConfiguration.DetectorModelName)
+
+		_, err := conn.DeleteDetectorModel(&iotevents.DeleteDetectorModelInput{
+			DetectorModelName: aws.String(name),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = waiter.DetectorModelDeleted(conn, name)
+
+		return err
+	}
+}
+
+func testAccCheckAWSIotEventsDetectorModelExists(n string, v *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+		detectorModel, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *detectorModel
+
+		return nil
+	}
+}
+
+func testAccAWSIotEventsDetectorModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "iotevents.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "iot:Publish",
+        "sns:Publish"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "sensorId"
+    }
+
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsDetectorModelConfigBasic(rName string) string {
+	return testAccAWSIotEventsDetectorModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = "${aws_iam_role.test.arn}"
+
+  definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+
+      on_input {
+        transition_event {
+          event_name = "TooHot"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > 80"
+          next_state = "Overheated"
+        }
+      }
+    }
+
+    state {
+      state_name = "Overheated"
+
+      on_enter {
+        event {
+          event_name = "Alert"
+
+          action {
+            sns {
+              target_arn = "${aws_sns_topic.test.arn}"
+            }
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccAWSIotEventsDetectorModelConfigUpdated(rName string) string {
+	return testAccAWSIotEventsDetectorModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name        = %[1]q
+  description = "Temperature alarm"
+  role_arn    = "${aws_iam_role.test.arn}"
+
+  definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+
+      on_input {
+        transition_event {
+          event_name = "TooHot"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > 90"
+          next_state = "Overheated"
+        }
+      }
+    }
+
+    state {
+      state_name = "Overheated"
+
+      on_enter {
+        event {
+          event_name = "Alert"
+
+          action {
+            sns {
+              target_arn = "${aws_sns_topic.test.arn}"
+            }
+          }
+
+          action {
+            iot_topic_publish {
+              mqtt_topic = "alerts/overheated"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "CooledDown"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature < 70"
+          next_state = "Normal"
+        }
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccAWSIotEventsDetectorModelConfigKey(rName string) string {
+	return testAccAWSIotEventsDetectorModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  key      = "sensorId"
+  role_arn = "${aws_iam_role.test.arn}"
+
+  definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+
+      on_input {
+        event {
+          event_name = "RecordTemperature"
+          condition  = "true"
+
+          action {
+            set_variable {
+              variable_name = "lastTemperature"
+              value         = "$input.${aws_iotevents_input.test.name}.temperature"
+            }
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSIotEventsDetectorModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = "${aws_iam_role.test.arn}"
+
+  definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSIotEventsDetectorModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = "${aws_iam_role.test.arn}"
+
+  definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
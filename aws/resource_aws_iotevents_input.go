@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIotEventsInput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotEventsInputCreate,
+		Read:   resourceAwsIotEventsInputRead,
+		Update: resourceAwsIotEventsInputUpdate,
+		Delete: resourceAwsIotEventsInputDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"json_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`), "must begin with a letter and contain only alphanumeric characters and underscores"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsIotEventsInputCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	name := d.Get("name").(string)
+
+	input := &iotevents.CreateInputInput{
+		InputDefinition: expandIotEventsInputDefinition(d.Get("definition").([]interface{})),
+		InputName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.InputDescription = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IoteventsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Input: %s", input)
+	_, err := conn.CreateInput(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Events Input (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.InputActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Input (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsIotEventsInputRead(d, meta)
+}
+
+func resourceAwsIotEventsInputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	input, err := finder.InputByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(input.InputConfiguration.InputArn)
+	d.Set("arn", arn)
+	d.Set("description", input.InputConfiguration.InputDescription)
+	d.Set("name", input.InputConfiguration.InputName)
+
+	if err := d.Set("definition", flattenIotEventsInputDefinition(input.InputDefinition)); err != nil {
+		return fmt.Errorf("error setting definition: %s", err)
+	}
+
+	tags, err := keyvaluetags.IoteventsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotEventsInputUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	if d.HasChange("definition") || d.HasChange("description") {
+		input := &iotevents.UpdateInputInput{
+			InputDefinition: expandIotEventsInputDefinition(d.Get("definition").([]interface{})),
+			InputName:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.InputDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Events Input: %s", input)
+		_, err := conn.UpdateInput(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Events Input (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waiter.InputActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for IoT Events Input (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.IoteventsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Events Input (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotEventsInputRead(d, meta)
+}
+
+func resourceAwsIotEventsInputDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	log.Printf("[DEBUG] Deleting IoT Events Input: %s", d.Id())
+	_, err := conn.DeleteInput(&iotevents.DeleteInputInput{
+		InputName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, iotevents.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.InputDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Input (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotEventsInputDefinition(tfList []interface{}) *iotevents.InputDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.InputDefinition{}
+
+	for _, tfMapRaw := range tfMap["attribute"].([]interface{}) {
+		attribute, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.Attributes = append(apiObject.Attributes, &iotevents.Attribute{
+			JsonPath: aws.String(attribute["json_path"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenIotEventsInputDefinition(apiObject *iotevents.InputDefinition) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	attributes := []interface{}{}
+
+	for _, attribute := range apiObject.Attributes {
+		if attribute == nil {
+			continue
+		}
+
+		attributes = append(attributes, map[string]interface{}{
+			"json_path": aws.StringValue(attribute.JsonPath),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"attribute": attributes,
+		},
+	}
+}
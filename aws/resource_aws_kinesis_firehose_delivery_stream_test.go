@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_firehose_delivery_stream", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kinesis_firehose_delivery_stream", &sweep.Sweeper{
 		Name: "aws_kinesis_firehose_delivery_stream",
 		F:    testSweepKinesisFirehoseDeliveryStreams,
 	})
@@ -65,6 +66,10 @@ func testSweepKinesisFirehoseDeliveryStreams(region string) error {
 				return fmt.Errorf("error deleting Kinesis Firehose Delivery Stream (%s): %s", name, err)
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			if err := waitForKinesisFirehoseDeliveryStreamDeletion(conn, name); err != nil {
 				return fmt.Errorf("error waiting for Kinesis Firehose Delivery Stream (%s) deletion: %s", name, err)
 			}
@@ -6,6 +6,9 @@ import (
 
 func resourceAwsKinesisStreamResourceV0() *schema.Resource {
 	return &schema.Resource{
+
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -47,7 +50,8 @@ func resourceAwsKinesisStreamResourceV0() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
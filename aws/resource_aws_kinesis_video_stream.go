@@ -0,0 +1,256 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisvideo/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisvideo/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsKinesisVideoStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisVideoStreamCreate,
+		Read:   resourceAwsKinesisVideoStreamRead,
+		Update: resourceAwsKinesisVideoStreamUpdate,
+		Delete: resourceAwsKinesisVideoStreamDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_retention_in_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"device_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"media_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must contain only alphanumeric characters, underscores, periods and hyphens"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisVideoStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	name := d.Get("name").(string)
+	input := &kinesisvideo.CreateStreamInput{
+		DataRetentionInHours: aws.Int64(int64(d.Get("data_retention_in_hours").(int))),
+		StreamName:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk("device_name"); ok {
+		input.DeviceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("media_type"); ok {
+		input.MediaType = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().KinesisvideoTags()
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Video Stream: %s", input)
+	output, err := conn.CreateStream(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Video Stream (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.StreamARN))
+
+	if _, err := waiter.StreamCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Video Stream (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsKinesisVideoStreamRead(d, meta)
+}
+
+func resourceAwsKinesisVideoStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	stream, err := finder.StreamByARN(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Video Stream (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(stream.StreamARN)
+	d.Set("arn", arn)
+	d.Set("creation_time", aws.TimeValue(stream.CreationTime).Format(time.RFC3339))
+	d.Set("data_retention_in_hours", stream.DataRetentionInHours)
+	d.Set("device_name", stream.DeviceName)
+	d.Set("kms_key_id", stream.KmsKeyId)
+	d.Set("media_type", stream.MediaType)
+	d.Set("name", stream.StreamName)
+	d.Set("version", stream.Version)
+
+	tags, err := keyvaluetags.KinesisvideoListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisVideoStreamUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	// Every stream update increments the version, which must be passed to the next update.
+	version := d.Get("version").(string)
+
+	if d.HasChange("device_name") || d.HasChange("media_type") {
+		input := &kinesisvideo.UpdateStreamInput{
+			CurrentVersion: aws.String(version),
+			StreamARN:      aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("device_name"); ok {
+			input.DeviceName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("media_type"); ok {
+			input.MediaType = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Kinesis Video Stream: %s", input)
+		_, err := conn.UpdateStream(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Kinesis Video Stream (%s): %s", d.Id(), err)
+		}
+
+		stream, err := waiter.StreamUpdated(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error waiting for Kinesis Video Stream (%s) update: %s", d.Id(), err)
+		}
+
+		version = aws.StringValue(stream.Version)
+	}
+
+	if d.HasChange("data_retention_in_hours") {
+		o, n := d.GetChange("data_retention_in_hours")
+		change := n.(int) - o.(int)
+		input := &kinesisvideo.UpdateDataRetentionInput{
+			CurrentVersion: aws.String(version),
+			StreamARN:      aws.String(d.Id()),
+		}
+
+		if change > 0 {
+			input.DataRetentionChangeInHours = aws.Int64(int64(change))
+			input.Operation = aws.String(kinesisvideo.UpdateDataRetentionOperationIncreaseDataRetention)
+		} else {
+			input.DataRetentionChangeInHours = aws.Int64(int64(-change))
+			input.Operation = aws.String(kinesisvideo.UpdateDataRetentionOperationDecreaseDataRetention)
+		}
+
+		log.Printf("[DEBUG] Updating Kinesis Video Stream data retention: %s", input)
+		_, err := conn.UpdateDataRetention(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Kinesis Video Stream (%s) data retention: %s", d.Id(), err)
+		}
+
+		if _, err := waiter.StreamUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Video Stream (%s) data retention update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.KinesisvideoUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Kinesis Video Stream (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKinesisVideoStreamRead(d, meta)
+}
+
+func resourceAwsKinesisVideoStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	log.Printf("[DEBUG] Deleting Kinesis Video Stream: %s", d.Id())
+	_, err := conn.DeleteStream(&kinesisvideo.DeleteStreamInput{
+		StreamARN: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.StreamDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Video Stream (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
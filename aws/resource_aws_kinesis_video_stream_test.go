@@ -0,0 +1,394 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisvideo/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisvideo/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_kinesis_video_stream", &sweep.Sweeper{
+		Name: "aws_kinesis_video_stream",
+		F:    testSweepKinesisVideoStreams,
+	})
+}
+
+func testSweepKinesisVideoStreams(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).kinesisvideoconn
+	input := &kinesisvideo.ListStreamsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListStreams(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping Kinesis Video Stream sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Kinesis Video Streams: %s", err))
+			return sweeperErrs
+		}
+
+		for _, stream := range output.StreamInfoList {
+			arn := aws.StringValue(stream.StreamARN)
+
+			log.Printf("[INFO] Deleting Kinesis Video Stream: %s", arn)
+			_, err := conn.DeleteStream(&kinesisvideo.DeleteStreamInput{
+				StreamARN: aws.String(arn),
+			})
+
+			if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting Kinesis Video Stream (%s): %s", arn, err))
+				continue
+			}
+
+			if testSweepDryRun() {
+				continue
+			}
+
+			if _, err := waiter.StreamDeleted(conn, arn); err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error waiting for Kinesis Video Stream (%s) to be deleted: %s", arn, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSKinesisVideoStream_basic(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisVideoStreamConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisVideoStreamExists(resourceName, &stream),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kinesisvideo", regexp.MustCompile(fmt.Sprintf(`stream/%s/.+`, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "0"),
+					resource.TestCheckResourceAttr(resourceName, "device_name", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "media_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_disappears(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisVideoStreamConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisVideoStreamExists(resourceName, &stream),
+					testAccCheckAWSKinesisVideoStreamDisappears(&stream),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_KmsKeyId(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisVideoStreamConfigKmsKeyId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_Update(t *testing.T) {
+	var stream1, stream2, stream3 kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisVideoStreamConfigSettings(rName, 24, "camera-1", "video/h264"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisVideoStreamExists(resourceName, &stream1),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "device_name", "camera-1"),
+					resource.TestCheckResourceAttr(resourceName, "media_type", "video/h264"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKinesisVideoStreamConfigSettings(rName, 48, "camera-2", "video/h265"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisVideoStreamExists(resourceName, &stream2),
+					testAccCheckAWSKinesisVideoStreamNotRecreated(&stream1, &stream2),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "48"),
+					resource.TestCheckResourceAttr(resourceName, "device_name", "camera-2"),
+					resource.TestCheckResourceAttr(resourceName, "media_type", "video/h265"),
+				),
+			},
+			{
+				Config: testAccAWSKinesisVideoStreamConfigSettings(rName, 12, "camera-2", "video/h265"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisVideoStreamExists(resourceName, &stream3),
+					testAccCheckAWSKinesisVideoStreamNotRecreated(&stream2, &stream3),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "12"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_tags(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisVideoStreamConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKinesisVideoStreamConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSKinesisVideoStreamConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKinesisVideoStreamDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesis_video_stream" {
+			continue
+		}
+
+		_, err := finder.StreamByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kinesis Video Stream %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSKinesisVideoStreamDisappears(stream *kinesisvideo.StreamInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+		arn := aws.StringValue(stream.StreamARN)
+
+		_, err := conn.DeleteStream(&kinesisvideo.DeleteStreamInput{
+			StreamARN: aws.String(arn),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = waiter.StreamDeleted(conn, arn)
+
+		return err
+	}
+}
+
+func testAccCheckAWSKinesisVideoStreamExists(n string, v *kinesisvideo.StreamInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Video Stream ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+
+		stream, err := finder.StreamByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *stream
+
+		return nil
+	}
+}
+
+func testAccCheckAWSKinesisVideoStreamNotRecreated(i, j *kinesisvideo.StreamInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreationTime).Equal(aws.TimeValue(j.CreationTime)) {
+			return fmt.Errorf("Kinesis Video Stream was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSKinesisVideoStreamConfigName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSKinesisVideoStreamConfigKmsKeyId(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kinesis_video_stream" "test" {
+  name       = %[1]q
+  kms_key_id = "${aws_kms_key.test.arn}"
+}
+`, rName)
+}
+
+func testAccAWSKinesisVideoStreamConfigSettings(rName string, dataRetentionInHours int, deviceName, mediaType string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = %[2]d
+  device_name             = %[3]q
+  media_type              = %[4]q
+}
+`, rName, dataRetentionInHours, deviceName, mediaType)
+}
+
+func testAccAWSKinesisVideoStreamConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSKinesisVideoStreamConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
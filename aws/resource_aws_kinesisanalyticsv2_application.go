@@ -0,0 +1,1260 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisAnalyticsV2ApplicationCreate,
+		Read:   resourceAwsKinesisAnalyticsV2ApplicationRead,
+		Update: resourceAwsKinesisAnalyticsV2ApplicationUpdate,
+		Delete: resourceAwsKinesisAnalyticsV2ApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsKinesisAnalyticsV2ApplicationImport,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ApplicationStartedTimeout),
+			Update: schema.DefaultTimeout(waiter.ApplicationUpdatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.ApplicationDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_code_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code_content": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_content_location": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"file_key": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+															"object_version": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"text_content": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 102400),
+												},
+											},
+										},
+									},
+									"code_content_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											kinesisanalyticsv2.CodeContentTypePlaintext,
+											kinesisanalyticsv2.CodeContentTypeZipfile,
+										}, false),
+									},
+								},
+							},
+						},
+						"application_snapshot_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"snapshots_enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"environment_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"property_group": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"property_group_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+												"property_map": {
+													Type:     schema.TypeMap,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"flink_application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"checkpoint_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"checkpoint_interval": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"checkpointing_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ConfigurationTypeCustom,
+														kinesisanalyticsv2.ConfigurationTypeDefault,
+													}, false),
+												},
+												"min_pause_between_checkpoints": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"configuration_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ConfigurationTypeCustom,
+														kinesisanalyticsv2.ConfigurationTypeDefault,
+													}, false),
+												},
+												"log_level": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.LogLevelDebug,
+														kinesisanalyticsv2.LogLevelError,
+														kinesisanalyticsv2.LogLevelInfo,
+														kinesisanalyticsv2.LogLevelWarn,
+													}, false),
+												},
+												"metrics_level": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.MetricsLevelApplication,
+														kinesisanalyticsv2.MetricsLevelOperator,
+														kinesisanalyticsv2.MetricsLevelParallelism,
+														kinesisanalyticsv2.MetricsLevelTask,
+													}, false),
+												},
+											},
+										},
+									},
+									"parallelism_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"auto_scaling_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ConfigurationTypeCustom,
+														kinesisanalyticsv2.ConfigurationTypeDefault,
+													}, false),
+												},
+												"parallelism": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"parallelism_per_kpu": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"run_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_restore_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"application_restore_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ApplicationRestoreTypeRestoreFromCustomSnapshot,
+														kinesisanalyticsv2.ApplicationRestoreTypeRestoreFromLatestSnapshot,
+														kinesisanalyticsv2.ApplicationRestoreTypeSkipRestoreFromSnapshot,
+													}, false),
+												},
+												"snapshot_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"vpc_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"security_group_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 5,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 16,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+									"vpc_configuration_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"vpc_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_logging_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logging_option_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"last_update_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"runtime_environment": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					kinesisanalyticsv2.RuntimeEnvironmentFlink16,
+				}, false),
+			},
+			"service_execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"start_application": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	name := d.Get("name").(string)
+
+	input := &kinesisanalyticsv2.CreateApplicationInput{
+		ApplicationConfiguration: expandKinesisAnalyticsV2ApplicationConfiguration(d.Get("application_configuration").([]interface{})),
+		ApplicationName:          aws.String(name),
+		CloudWatchLoggingOptions: expandKinesisAnalyticsV2CloudWatchLoggingOptions(d.Get("cloudwatch_logging_options").([]interface{})),
+		RuntimeEnvironment:       aws.String(d.Get("runtime_environment").(string)),
+		ServiceExecutionRole:     aws.String(d.Get("service_execution_role").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ApplicationDescription = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().Kinesisanalyticsv2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics v2 Application: %s", input)
+
+	var output *kinesisanalyticsv2.CreateApplicationOutput
+	// Retry for IAM eventual consistency
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateApplication(input)
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Please check the role provided or validity of S3 location you provided") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err = conn.CreateApplication(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationDetail.ApplicationARN))
+
+	if d.Get("start_application").(bool) {
+		if err := kinesisAnalyticsV2StartApplication(conn, name, d.Get("application_configuration").([]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	application, err := finder.ApplicationDetailByName(conn, d.Get("name").(string))
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Analytics v2 Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(application.ApplicationARN)
+	d.Set("arn", arn)
+	d.Set("create_timestamp", aws.TimeValue(application.CreateTimestamp).Format(time.RFC3339))
+	d.Set("description", application.ApplicationDescription)
+	d.Set("last_update_timestamp", aws.TimeValue(application.LastUpdateTimestamp).Format(time.RFC3339))
+	d.Set("name", application.ApplicationName)
+	d.Set("runtime_environment", application.RuntimeEnvironment)
+	d.Set("service_execution_role", application.ServiceExecutionRole)
+	d.Set("status", application.ApplicationStatus)
+	d.Set("version_id", int(aws.Int64Value(application.ApplicationVersionId)))
+
+	// Only settled states are reflected so that an interrupted start or stop is retried.
+	switch aws.StringValue(application.ApplicationStatus) {
+	case kinesisanalyticsv2.ApplicationStatusReady:
+		d.Set("start_application", false)
+	case kinesisanalyticsv2.ApplicationStatusRunning:
+		d.Set("start_application", true)
+	}
+
+	if err := d.Set("application_configuration", flattenKinesisAnalyticsV2ApplicationConfigurationDescription(application.ApplicationConfigurationDescription)); err != nil {
+		return fmt.Errorf("error setting application_configuration: %s", err)
+	}
+
+	if err := d.Set("cloudwatch_logging_options", flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(application.CloudWatchLoggingOptionDescriptions)); err != nil {
+		return fmt.Errorf("error setting cloudwatch_logging_options: %s", err)
+	}
+
+	tags, err := keyvaluetags.Kinesisanalyticsv2ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Analytics v2 Application (%s): %s", arn, err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	name := d.Get("name").(string)
+	currentApplicationVersionID := int64(d.Get("version_id").(int))
+	startApplication := d.Get("start_application").(bool)
+
+	// Stop a running application before applying any other changes.
+	if d.HasChange("start_application") && !startApplication {
+		if err := kinesisAnalyticsV2StopApplication(conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("cloudwatch_logging_options") {
+		o, n := d.GetChange("cloudwatch_logging_options")
+		oldOptions, newOptions := o.([]interface{}), n.([]interface{})
+
+		if len(oldOptions) == 0 && len(newOptions) > 0 {
+			input := &kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(name),
+				CloudWatchLoggingOption:     expandKinesisAnalyticsV2CloudWatchLoggingOptions(newOptions)[0],
+				CurrentApplicationVersionId: aws.Int64(currentApplicationVersionID),
+			}
+
+			log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), input)
+			output, err := conn.AddApplicationCloudWatchLoggingOption(input)
+
+			if err != nil {
+				return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), err)
+			}
+
+			currentApplicationVersionID = aws.Int64Value(output.ApplicationVersionId)
+		} else if len(oldOptions) > 0 && len(newOptions) == 0 {
+			input := &kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(name),
+				CloudWatchLoggingOptionId:   aws.String(oldOptions[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+				CurrentApplicationVersionId: aws.Int64(currentApplicationVersionID),
+			}
+
+			log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), input)
+			output, err := conn.DeleteApplicationCloudWatchLoggingOption(input)
+
+			if err != nil {
+				return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), err)
+			}
+
+			currentApplicationVersionID = aws.Int64Value(output.ApplicationVersionId)
+		}
+
+		if _, err := waiter.ApplicationUpdated(conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
+		}
+	}
+
+	// VPC configurations are added and removed outside of UpdateApplication.
+	if d.HasChange("application_configuration.0.vpc_configuration") {
+		o, n := d.GetChange("application_configuration.0.vpc_configuration")
+		oldConfigurations, newConfigurations := o.([]interface{}), n.([]interface{})
+
+		if len(oldConfigurations) == 0 && len(newConfigurations) > 0 {
+			input := &kinesisanalyticsv2.AddApplicationVpcConfigurationInput{
+				ApplicationName:             aws.String(name),
+				CurrentApplicationVersionId: aws.Int64(currentApplicationVersionID),
+				VpcConfiguration:            expandKinesisAnalyticsV2VpcConfigurations(newConfigurations)[0],
+			}
+
+			log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) VPC configuration: %s", d.Id(), input)
+			output, err := conn.AddApplicationVpcConfiguration(input)
+
+			if err != nil {
+				return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) VPC configuration: %s", d.Id(), err)
+			}
+
+			currentApplicationVersionID = aws.Int64Value(output.ApplicationVersionId)
+		} else if len(oldConfigurations) > 0 && len(newConfigurations) == 0 {
+			input := &kinesisanalyticsv2.DeleteApplicationVpcConfigurationInput{
+				ApplicationName:             aws.String(name),
+				CurrentApplicationVersionId: aws.Int64(currentApplicationVersionID),
+				VpcConfigurationId:          aws.String(oldConfigurations[0].(map[string]interface{})["vpc_configuration_id"].(string)),
+			}
+
+			log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s) VPC configuration: %s", d.Id(), input)
+			output, err := conn.DeleteApplicationVpcConfiguration(input)
+
+			if err != nil {
+				return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) VPC configuration: %s", d.Id(), err)
+			}
+
+			currentApplicationVersionID = aws.Int64Value(output.ApplicationVersionId)
+		}
+
+		if _, err := waiter.ApplicationUpdated(conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
+		}
+	}
+
+	input := &kinesisanalyticsv2.UpdateApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+	updateApplication := false
+
+	if d.HasChange("application_configuration") {
+		input.ApplicationConfigurationUpdate = expandKinesisAnalyticsV2ApplicationConfigurationUpdate(d)
+		updateApplication = input.ApplicationConfigurationUpdate != nil
+
+		// The run configuration can only be updated whilst the application is running.
+		// Otherwise it is applied the next time the application is started.
+		if d.HasChange("application_configuration.0.run_configuration") && !d.HasChange("start_application") && startApplication {
+			input.RunConfigurationUpdate = &kinesisanalyticsv2.RunConfigurationUpdate{
+				ApplicationRestoreConfiguration: expandKinesisAnalyticsV2ApplicationRestoreConfiguration(d.Get("application_configuration").([]interface{})),
+			}
+			updateApplication = true
+		}
+	}
+
+	if d.HasChange("cloudwatch_logging_options") {
+		o, n := d.GetChange("cloudwatch_logging_options")
+		oldOptions, newOptions := o.([]interface{}), n.([]interface{})
+
+		if len(oldOptions) > 0 && len(newOptions) > 0 {
+			input.CloudWatchLoggingOptionUpdates = []*kinesisanalyticsv2.CloudWatchLoggingOptionUpdate{
+				{
+					CloudWatchLoggingOptionId: aws.String(oldOptions[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+					LogStreamARNUpdate:        aws.String(newOptions[0].(map[string]interface{})["log_stream_arn"].(string)),
+				},
+			}
+			updateApplication = true
+		}
+	}
+
+	if d.HasChange("service_execution_role") {
+		input.ServiceExecutionRoleUpdate = aws.String(d.Get("service_execution_role").(string))
+		updateApplication = true
+	}
+
+	if updateApplication {
+		input.CurrentApplicationVersionId = aws.Int64(currentApplicationVersionID)
+
+		log.Printf("[DEBUG] Updating Kinesis Analytics v2 Application (%s): %s", d.Id(), input)
+		_, err := conn.UpdateApplication(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waiter.ApplicationUpdated(conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.Kinesisanalyticsv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("start_application") && startApplication {
+		if err := kinesisAnalyticsV2StartApplication(conn, name, d.Get("application_configuration").([]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	name := d.Get("name").(string)
+
+	application, err := finder.ApplicationDetailByName(conn, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application: %s", d.Id())
+	_, err = conn.DeleteApplication(&kinesisanalyticsv2.DeleteApplicationInput{
+		ApplicationName: aws.String(name),
+		CreateTimestamp: application.CreateTimestamp,
+	})
+
+	if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.ApplicationDeleted(conn, name, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	applicationARN, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing ARN (%s): %s", d.Id(), err)
+	}
+
+	// application/<name>
+	parts := strings.Split(applicationARN.Resource, "/")
+	if len(parts) != 2 || parts[0] != "application" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ARN (%s), expected arn:PARTITION:kinesisanalytics:REGION:ACCOUNT-ID:application/NAME", d.Id())
+	}
+
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func kinesisAnalyticsV2StartApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, vApplicationConfiguration []interface{}, timeout time.Duration) error {
+	input := &kinesisanalyticsv2.StartApplicationInput{
+		ApplicationName: aws.String(name),
+		RunConfiguration: &kinesisanalyticsv2.RunConfiguration{
+			ApplicationRestoreConfiguration: expandKinesisAnalyticsV2ApplicationRestoreConfiguration(vApplicationConfiguration),
+		},
+	}
+
+	log.Printf("[DEBUG] Starting Kinesis Analytics v2 Application: %s", input)
+	if _, err := conn.StartApplication(input); err != nil {
+		return fmt.Errorf("error starting Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	if _, err := waiter.ApplicationStarted(conn, name, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to start: %s", name, err)
+	}
+
+	return nil
+}
+
+func kinesisAnalyticsV2StopApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) error {
+	input := &kinesisanalyticsv2.StopApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Stopping Kinesis Analytics v2 Application: %s", input)
+	if _, err := conn.StopApplication(input); err != nil {
+		return fmt.Errorf("error stopping Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	if _, err := waiter.ApplicationStopped(conn, name, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to stop: %s", name, err)
+	}
+
+	return nil
+}
+
+func expandKinesisAnalyticsV2ApplicationConfiguration(vApplicationConfiguration []interface{}) *kinesisanalyticsv2.ApplicationConfiguration {
+	if len(vApplicationConfiguration) == 0 || vApplicationConfiguration[0] == nil {
+		return nil
+	}
+
+	mApplicationConfiguration := vApplicationConfiguration[0].(map[string]interface{})
+	applicationConfiguration := &kinesisanalyticsv2.ApplicationConfiguration{}
+
+	if vApplicationCodeConfiguration, ok := mApplicationConfiguration["application_code_configuration"].([]interface{}); ok && len(vApplicationCodeConfiguration) > 0 && vApplicationCodeConfiguration[0] != nil {
+		mApplicationCodeConfiguration := vApplicationCodeConfiguration[0].(map[string]interface{})
+		applicationCodeConfiguration := &kinesisanalyticsv2.ApplicationCodeConfiguration{
+			CodeContentType: aws.String(mApplicationCodeConfiguration["code_content_type"].(string)),
+		}
+
+		if vCodeContent, ok := mApplicationCodeConfiguration["code_content"].([]interface{}); ok && len(vCodeContent) > 0 && vCodeContent[0] != nil {
+			mCodeContent := vCodeContent[0].(map[string]interface{})
+			codeContent := &kinesisanalyticsv2.CodeContent{}
+
+			if vS3ContentLocation, ok := mCodeContent["s3_content_location"].([]interface{}); ok && len(vS3ContentLocation) > 0 && vS3ContentLocation[0] != nil {
+				mS3ContentLocation := vS3ContentLocation[0].(map[string]interface{})
+				s3ContentLocation := &kinesisanalyticsv2.S3ContentLocation{
+					BucketARN: aws.String(mS3ContentLocation["bucket_arn"].(string)),
+					FileKey:   aws.String(mS3ContentLocation["file_key"].(string)),
+				}
+
+				if v, ok := mS3ContentLocation["object_version"].(string); ok && v != "" {
+					s3ContentLocation.ObjectVersion = aws.String(v)
+				}
+
+				codeContent.S3ContentLocation = s3ContentLocation
+			}
+
+			if v, ok := mCodeContent["text_content"].(string); ok && v != "" {
+				codeContent.TextContent = aws.String(v)
+			}
+
+			applicationCodeConfiguration.CodeContent = codeContent
+		}
+
+		applicationConfiguration.ApplicationCodeConfiguration = applicationCodeConfiguration
+	}
+
+	if vApplicationSnapshotConfiguration, ok := mApplicationConfiguration["application_snapshot_configuration"].([]interface{}); ok && len(vApplicationSnapshotConfiguration) > 0 && vApplicationSnapshotConfiguration[0] != nil {
+		mApplicationSnapshotConfiguration := vApplicationSnapshotConfiguration[0].(map[string]interface{})
+
+		applicationConfiguration.ApplicationSnapshotConfiguration = &kinesisanalyticsv2.ApplicationSnapshotConfiguration{
+			SnapshotsEnabled: aws.Bool(mApplicationSnapshotConfiguration["snapshots_enabled"].(bool)),
+		}
+	}
+
+	if propertyGroups := expandKinesisAnalyticsV2PropertyGroups(mApplicationConfiguration["environment_properties"].([]interface{})); len(propertyGroups) > 0 {
+		applicationConfiguration.EnvironmentProperties = &kinesisanalyticsv2.EnvironmentProperties{
+			PropertyGroups: propertyGroups,
+		}
+	}
+
+	if vFlinkApplicationConfiguration, ok := mApplicationConfiguration["flink_application_configuration"].([]interface{}); ok && len(vFlinkApplicationConfiguration) > 0 && vFlinkApplicationConfiguration[0] != nil {
+		mFlinkApplicationConfiguration := vFlinkApplicationConfiguration[0].(map[string]interface{})
+		flinkApplicationConfiguration := &kinesisanalyticsv2.FlinkApplicationConfiguration{}
+
+		if vCheckpointConfiguration, ok := mFlinkApplicationConfiguration["checkpoint_configuration"].([]interface{}); ok && len(vCheckpointConfiguration) > 0 && vCheckpointConfiguration[0] != nil {
+			mCheckpointConfiguration := vCheckpointConfiguration[0].(map[string]interface{})
+			checkpointConfiguration := &kinesisanalyticsv2.CheckpointConfiguration{
+				ConfigurationType: aws.String(mCheckpointConfiguration["configuration_type"].(string)),
+			}
+
+			if aws.StringValue(checkpointConfiguration.ConfigurationType) == kinesisanalyticsv2.ConfigurationTypeCustom {
+				if v, ok := mCheckpointConfiguration["checkpoint_interval"].(int); ok && v > 0 {
+					checkpointConfiguration.CheckpointInterval = aws.Int64(int64(v))
+				}
+
+				if v, ok := mCheckpointConfiguration["checkpointing_enabled"].(bool); ok {
+					checkpointConfiguration.CheckpointingEnabled = aws.Bool(v)
+				}
+
+				if v, ok := mCheckpointConfiguration["min_pause_between_checkpoints"].(int); ok {
+					checkpointConfiguration.MinPauseBetweenCheckpoints = aws.Int64(int64(v))
+				}
+			}
+
+			flinkApplicationConfiguration.CheckpointConfiguration = checkpointConfiguration
+		}
+
+		if vMonitoringConfiguration, ok := mFlinkApplicationConfiguration["monitoring_configuration"].([]interface{}); ok && len(vMonitoringConfiguration) > 0 && vMonitoringConfiguration[0] != nil {
+			mMonitoringConfiguration := vMonitoringConfiguration[0].(map[string]interface{})
+			monitoringConfiguration := &kinesisanalyticsv2.MonitoringConfiguration{
+				ConfigurationType: aws.String(mMonitoringConfiguration["configuration_type"].(string)),
+			}
+
+			if aws.StringValue(monitoringConfiguration.ConfigurationType) == kinesisanalyticsv2.ConfigurationTypeCustom {
+				if v, ok := mMonitoringConfiguration["log_level"].(string); ok && v != "" {
+					monitoringConfiguration.LogLevel = aws.String(v)
+				}
+
+				if v, ok := mMonitoringConfiguration["metrics_level"].(string); ok && v != "" {
+					monitoringConfiguration.MetricsLevel = aws.String(v)
+				}
+			}
+
+			flinkApplicationConfiguration.MonitoringConfiguration = monitoringConfiguration
+		}
+
+		if vParallelismConfiguration, ok := mFlinkApplicationConfiguration["parallelism_configuration"].([]interface{}); ok && len(vParallelismConfiguration) > 0 && vParallelismConfiguration[0] != nil {
+			mParallelismConfiguration := vParallelismConfiguration[0].(map[string]interface{})
+			parallelismConfiguration := &kinesisanalyticsv2.ParallelismConfiguration{
+				ConfigurationType: aws.String(mParallelismConfiguration["configuration_type"].(string)),
+			}
+
+			if aws.StringValue(parallelismConfiguration.ConfigurationType) == kinesisanalyticsv2.ConfigurationTypeCustom {
+				if v, ok := mParallelismConfiguration["auto_scaling_enabled"].(bool); ok {
+					parallelismConfiguration.AutoScalingEnabled = aws.Bool(v)
+				}
+
+				if v, ok := mParallelismConfiguration["parallelism"].(int); ok && v > 0 {
+					parallelismConfiguration.Parallelism = aws.Int64(int64(v))
+				}
+
+				if v, ok := mParallelismConfiguration["parallelism_per_kpu"].(int); ok && v > 0 {
+					parallelismConfiguration.ParallelismPerKPU = aws.Int64(int64(v))
+				}
+			}
+
+			flinkApplicationConfiguration.ParallelismConfiguration = parallelismConfiguration
+		}
+
+		applicationConfiguration.FlinkApplicationConfiguration = flinkApplicationConfiguration
+	}
+
+	if vpcConfigurations := expandKinesisAnalyticsV2VpcConfigurations(mApplicationConfiguration["vpc_configuration"].([]interface{})); len(vpcConfigurations) > 0 {
+		applicationConfiguration.VpcConfigurations = vpcConfigurations
+	}
+
+	return applicationConfiguration
+}
+
+func expandKinesisAnalyticsV2ApplicationConfigurationUpdate(d *schema.ResourceData) *kinesisanalyticsv2.ApplicationConfigurationUpdate {
+	applicationConfiguration := expandKinesisAnalyticsV2ApplicationConfiguration(d.Get("application_configuration").([]interface{}))
+
+	if applicationConfiguration == nil {
+		return nil
+	}
+
+	applicationConfigurationUpdate := &kinesisanalyticsv2.ApplicationConfigurationUpdate{}
+	updated := false
+
+	if d.HasChange("application_configuration.0.application_code_configuration") && applicationConfiguration.ApplicationCodeConfiguration != nil {
+		applicationCodeConfigurationUpdate := &kinesisanalyticsv2.ApplicationCodeConfigurationUpdate{
+			CodeContentTypeUpdate: applicationConfiguration.ApplicationCodeConfiguration.CodeContentType,
+		}
+
+		if codeContent := applicationConfiguration.ApplicationCodeConfiguration.CodeContent; codeContent != nil {
+			codeContentUpdate := &kinesisanalyticsv2.CodeContentUpdate{
+				TextContentUpdate: codeContent.TextContent,
+			}
+
+			if s3ContentLocation := codeContent.S3ContentLocation; s3ContentLocation != nil {
+				codeContentUpdate.S3ContentLocationUpdate = &kinesisanalyticsv2.S3ContentLocationUpdate{
+					BucketARNUpdate:     s3ContentLocation.BucketARN,
+					FileKeyUpdate:       s3ContentLocation.FileKey,
+					ObjectVersionUpdate: s3ContentLocation.ObjectVersion,
+				}
+			}
+
+			applicationCodeConfigurationUpdate.CodeContentUpdate = codeContentUpdate
+		}
+
+		applicationConfigurationUpdate.ApplicationCodeConfigurationUpdate = applicationCodeConfigurationUpdate
+		updated = true
+	}
+
+	if d.HasChange("application_configuration.0.application_snapshot_configuration") && applicationConfiguration.ApplicationSnapshotConfiguration != nil {
+		applicationConfigurationUpdate.ApplicationSnapshotConfigurationUpdate = &kinesisanalyticsv2.ApplicationSnapshotConfigurationUpdate{
+			SnapshotsEnabledUpdate: applicationConfiguration.ApplicationSnapshotConfiguration.SnapshotsEnabled,
+		}
+		updated = true
+	}
+
+	if d.HasChange("application_configuration.0.environment_properties") {
+		propertyGroups := []*kinesisanalyticsv2.PropertyGroup{}
+
+		if applicationConfiguration.EnvironmentProperties != nil {
+			propertyGroups = applicationConfiguration.EnvironmentProperties.PropertyGroups
+		}
+
+		applicationConfigurationUpdate.EnvironmentPropertyUpdates = &kinesisanalyticsv2.EnvironmentPropertyUpdates{
+			PropertyGroups: propertyGroups,
+		}
+		updated = true
+	}
+
+	if d.HasChange("application_configuration.0.flink_application_configuration") && applicationConfiguration.FlinkApplicationConfiguration != nil {
+		flinkApplicationConfiguration := applicationConfiguration.FlinkApplicationConfiguration
+		flinkApplicationConfigurationUpdate := &kinesisanalyticsv2.FlinkApplicationConfigurationUpdate{}
+
+		if checkpointConfiguration := flinkApplicationConfiguration.CheckpointConfiguration; checkpointConfiguration != nil {
+			flinkApplicationConfigurationUpdate.CheckpointConfigurationUpdate = &kinesisanalyticsv2.CheckpointConfigurationUpdate{
+				CheckpointIntervalUpdate:         checkpointConfiguration.CheckpointInterval,
+				CheckpointingEnabledUpdate:       checkpointConfiguration.CheckpointingEnabled,
+				ConfigurationTypeUpdate:          checkpointConfiguration.ConfigurationType,
+				MinPauseBetweenCheckpointsUpdate: checkpointConfiguration.MinPauseBetweenCheckpoints,
+			}
+		}
+
+		if monitoringConfiguration := flinkApplicationConfiguration.MonitoringConfiguration; monitoringConfiguration != nil {
+			flinkApplicationConfigurationUpdate.MonitoringConfigurationUpdate = &kinesisanalyticsv2.MonitoringConfigurationUpdate{
+				ConfigurationTypeUpdate: monitoringConfiguration.ConfigurationType,
+				LogLevelUpdate:          monitoringConfiguration.LogLevel,
+				MetricsLevelUpdate:      monitoringConfiguration.MetricsLevel,
+			}
+		}
+
+		if parallelismConfiguration := flinkApplicationConfiguration.ParallelismConfiguration; parallelismConfiguration != nil {
+			flinkApplicationConfigurationUpdate.ParallelismConfigurationUpdate = &kinesisanalyticsv2.ParallelismConfigurationUpdate{
+				AutoScalingEnabledUpdate: parallelismConfiguration.AutoScalingEnabled,
+				ConfigurationTypeUpdate:  parallelismConfiguration.ConfigurationType,
+				ParallelismPerKPUUpdate:  parallelismConfiguration.ParallelismPerKPU,
+				ParallelismUpdate:        parallelismConfiguration.Parallelism,
+			}
+		}
+
+		applicationConfigurationUpdate.FlinkApplicationConfigurationUpdate = flinkApplicationConfigurationUpdate
+		updated = true
+	}
+
+	if d.HasChange("application_configuration.0.vpc_configuration") {
+		o, n := d.GetChange("application_configuration.0.vpc_configuration")
+		oldConfigurations, newConfigurations := o.([]interface{}), n.([]interface{})
+
+		// Additions and removals are handled by AddApplicationVpcConfiguration and DeleteApplicationVpcConfiguration.
+		if len(oldConfigurations) > 0 && len(newConfigurations) > 0 {
+			vpcConfiguration := expandKinesisAnalyticsV2VpcConfigurations(newConfigurations)[0]
+
+			applicationConfigurationUpdate.VpcConfigurationUpdates = []*kinesisanalyticsv2.VpcConfigurationUpdate{
+				{
+					SecurityGroupIdUpdates: vpcConfiguration.SecurityGroupIds,
+					SubnetIdUpdates:        vpcConfiguration.SubnetIds,
+					VpcConfigurationId:     aws.String(oldConfigurations[0].(map[string]interface{})["vpc_configuration_id"].(string)),
+				},
+			}
+			updated = true
+		}
+	}
+
+	if !updated {
+		return nil
+	}
+
+	return applicationConfigurationUpdate
+}
+
+func expandKinesisAnalyticsV2ApplicationRestoreConfiguration(vApplicationConfiguration []interface{}) *kinesisanalyticsv2.ApplicationRestoreConfiguration {
+	if len(vApplicationConfiguration) == 0 || vApplicationConfiguration[0] == nil {
+		return nil
+	}
+
+	vRunConfiguration, ok := vApplicationConfiguration[0].(map[string]interface{})["run_configuration"].([]interface{})
+	if !ok || len(vRunConfiguration) == 0 || vRunConfiguration[0] == nil {
+		return nil
+	}
+
+	vApplicationRestoreConfiguration, ok := vRunConfiguration[0].(map[string]interface{})["application_restore_configuration"].([]interface{})
+	if !ok || len(vApplicationRestoreConfiguration) == 0 || vApplicationRestoreConfiguration[0] == nil {
+		return nil
+	}
+
+	mApplicationRestoreConfiguration := vApplicationRestoreConfiguration[0].(map[string]interface{})
+
+	v, ok := mApplicationRestoreConfiguration["application_restore_type"].(string)
+	if !ok || v == "" {
+		return nil
+	}
+
+	applicationRestoreConfiguration := &kinesisanalyticsv2.ApplicationRestoreConfiguration{
+		ApplicationRestoreType: aws.String(v),
+	}
+
+	if v, ok := mApplicationRestoreConfiguration["snapshot_name"].(string); ok && v != "" {
+		applicationRestoreConfiguration.SnapshotName = aws.String(v)
+	}
+
+	return applicationRestoreConfiguration
+}
+
+func expandKinesisAnalyticsV2CloudWatchLoggingOptions(vCloudWatchLoggingOptions []interface{}) []*kinesisanalyticsv2.CloudWatchLoggingOption {
+	if len(vCloudWatchLoggingOptions) == 0 || vCloudWatchLoggingOptions[0] == nil {
+		return nil
+	}
+
+	mCloudWatchLoggingOption := vCloudWatchLoggingOptions[0].(map[string]interface{})
+
+	return []*kinesisanalyticsv2.CloudWatchLoggingOption{
+		{
+			LogStreamARN: aws.String(mCloudWatchLoggingOption["log_stream_arn"].(string)),
+		},
+	}
+}
+
+func expandKinesisAnalyticsV2PropertyGroups(vEnvironmentProperties []interface{}) []*kinesisanalyticsv2.PropertyGroup {
+	if len(vEnvironmentProperties) == 0 || vEnvironmentProperties[0] == nil {
+		return nil
+	}
+
+	propertyGroups := []*kinesisanalyticsv2.PropertyGroup{}
+
+	for _, vPropertyGroup := range vEnvironmentProperties[0].(map[string]interface{})["property_group"].(*schema.Set).List() {
+		mPropertyGroup := vPropertyGroup.(map[string]interface{})
+
+		propertyGroups = append(propertyGroups, &kinesisanalyticsv2.PropertyGroup{
+			PropertyGroupId: aws.String(mPropertyGroup["property_group_id"].(string)),
+			PropertyMap:     stringMapToPointers(mPropertyGroup["property_map"].(map[string]interface{})),
+		})
+	}
+
+	return propertyGroups
+}
+
+func expandKinesisAnalyticsV2VpcConfigurations(vVpcConfigurations []interface{}) []*kinesisanalyticsv2.VpcConfiguration {
+	vpcConfigurations := []*kinesisanalyticsv2.VpcConfiguration{}
+
+	for _, vVpcConfiguration := range vVpcConfigurations {
+		mVpcConfiguration, ok := vVpcConfiguration.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		vpcConfigurations = append(vpcConfigurations, &kinesisanalyticsv2.VpcConfiguration{
+			SecurityGroupIds: expandStringSet(mVpcConfiguration["security_group_ids"].(*schema.Set)),
+			SubnetIds:        expandStringSet(mVpcConfiguration["subnet_ids"].(*schema.Set)),
+		})
+	}
+
+	return vpcConfigurations
+}
+
+func flattenKinesisAnalyticsV2ApplicationConfigurationDescription(applicationConfigurationDescription *kinesisanalyticsv2.ApplicationConfigurationDescription) []interface{} {
+	if applicationConfigurationDescription == nil {
+		return []interface{}{}
+	}
+
+	mApplicationConfiguration := map[string]interface{}{}
+
+	if applicationCodeConfigurationDescription := applicationConfigurationDescription.ApplicationCodeConfigurationDescription; applicationCodeConfigurationDescription != nil {
+		mApplicationCodeConfiguration := map[string]interface{}{
+			"code_content_type": aws.StringValue(applicationCodeConfigurationDescription.CodeContentType),
+		}
+
+		if codeContentDescription := applicationCodeConfigurationDescription.CodeContentDescription; codeContentDescription != nil {
+			mCodeContent := map[string]interface{}{
+				"text_content": aws.StringValue(codeContentDescription.TextContent),
+			}
+
+			if s3ApplicationCodeLocationDescription := codeContentDescription.S3ApplicationCodeLocationDescription; s3ApplicationCodeLocationDescription != nil {
+				mCodeContent["s3_content_location"] = []interface{}{
+					map[string]interface{}{
+						"bucket_arn":     aws.StringValue(s3ApplicationCodeLocationDescription.BucketARN),
+						"file_key":       aws.StringValue(s3ApplicationCodeLocationDescription.FileKey),
+						"object_version": aws.StringValue(s3ApplicationCodeLocationDescription.ObjectVersion),
+					},
+				}
+			}
+
+			mApplicationCodeConfiguration["code_content"] = []interface{}{mCodeContent}
+		}
+
+		mApplicationConfiguration["application_code_configuration"] = []interface{}{mApplicationCodeConfiguration}
+	}
+
+	if applicationSnapshotConfigurationDescription := applicationConfigurationDescription.ApplicationSnapshotConfigurationDescription; applicationSnapshotConfigurationDescription != nil {
+		mApplicationConfiguration["application_snapshot_configuration"] = []interface{}{
+			map[string]interface{}{
+				"snapshots_enabled": aws.BoolValue(applicationSnapshotConfigurationDescription.SnapshotsEnabled),
+			},
+		}
+	}
+
+	if environmentPropertyDescriptions := applicationConfigurationDescription.EnvironmentPropertyDescriptions; environmentPropertyDescriptions != nil && len(environmentPropertyDescriptions.PropertyGroupDescriptions) > 0 {
+		vPropertyGroups := []interface{}{}
+
+		for _, propertyGroup := range environmentPropertyDescriptions.PropertyGroupDescriptions {
+			if propertyGroup == nil {
+				continue
+			}
+
+			vPropertyGroups = append(vPropertyGroups, map[string]interface{}{
+				"property_group_id": aws.StringValue(propertyGroup.PropertyGroupId),
+				"property_map":      pointersMapToStringList(propertyGroup.PropertyMap),
+			})
+		}
+
+		mApplicationConfiguration["environment_properties"] = []interface{}{
+			map[string]interface{}{
+				"property_group": vPropertyGroups,
+			},
+		}
+	}
+
+	if flinkApplicationConfigurationDescription := applicationConfigurationDescription.FlinkApplicationConfigurationDescription; flinkApplicationConfigurationDescription != nil {
+		mFlinkApplicationConfiguration := map[string]interface{}{}
+
+		if checkpointConfigurationDescription := flinkApplicationConfigurationDescription.CheckpointConfigurationDescription; checkpointConfigurationDescription != nil {
+			mFlinkApplicationConfiguration["checkpoint_configuration"] = []interface{}{
+				map[string]interface{}{
+					"checkpoint_interval":           int(aws.Int64Value(checkpointConfigurationDescription.CheckpointInterval)),
+					"checkpointing_enabled":         aws.BoolValue(checkpointConfigurationDescription.CheckpointingEnabled),
+					"configuration_type":            aws.StringValue(checkpointConfigurationDescription.ConfigurationType),
+					"min_pause_between_checkpoints": int(aws.Int64Value(checkpointConfigurationDescription.MinPauseBetweenCheckpoints)),
+				},
+			}
+		}
+
+		if monitoringConfigurationDescription := flinkApplicationConfigurationDescription.MonitoringConfigurationDescription; monitoringConfigurationDescription != nil {
+			mFlinkApplicationConfiguration["monitoring_configuration"] = []interface{}{
+				map[string]interface{}{
+					"configuration_type": aws.StringValue(monitoringConfigurationDescription.ConfigurationType),
+					"log_level":          aws.StringValue(monitoringConfigurationDescription.LogLevel),
+					"metrics_level":      aws.StringValue(monitoringConfigurationDescription.MetricsLevel),
+				},
+			}
+		}
+
+		if parallelismConfigurationDescription := flinkApplicationConfigurationDescription.ParallelismConfigurationDescription; parallelismConfigurationDescription != nil {
+			mFlinkApplicationConfiguration["parallelism_configuration"] = []interface{}{
+				map[string]interface{}{
+					"auto_scaling_enabled": aws.BoolValue(parallelismConfigurationDescription.AutoScalingEnabled),
+					"configuration_type":   aws.StringValue(parallelismConfigurationDescription.ConfigurationType),
+					"parallelism":          int(aws.Int64Value(parallelismConfigurationDescription.Parallelism)),
+					"parallelism_per_kpu":  int(aws.Int64Value(parallelismConfigurationDescription.ParallelismPerKPU)),
+				},
+			}
+		}
+
+		mApplicationConfiguration["flink_application_configuration"] = []interface{}{mFlinkApplicationConfiguration}
+	}
+
+	if runConfigurationDescription := applicationConfigurationDescription.RunConfigurationDescription; runConfigurationDescription != nil {
+		mRunConfiguration := map[string]interface{}{}
+
+		if applicationRestoreConfigurationDescription := runConfigurationDescription.ApplicationRestoreConfigurationDescription; applicationRestoreConfigurationDescription != nil {
+			mRunConfiguration["application_restore_configuration"] = []interface{}{
+				map[string]interface{}{
+					"application_restore_type": aws.StringValue(applicationRestoreConfigurationDescription.ApplicationRestoreType),
+					"snapshot_name":            aws.StringValue(applicationRestoreConfigurationDescription.SnapshotName),
+				},
+			}
+		}
+
+		mApplicationConfiguration["run_configuration"] = []interface{}{mRunConfiguration}
+	}
+
+	if vpcConfigurationDescriptions := applicationConfigurationDescription.VpcConfigurationDescriptions; len(vpcConfigurationDescriptions) > 0 && vpcConfigurationDescriptions[0] != nil {
+		mApplicationConfiguration["vpc_configuration"] = []interface{}{
+			map[string]interface{}{
+				"security_group_ids":   flattenStringSet(vpcConfigurationDescriptions[0].SecurityGroupIds),
+				"subnet_ids":           flattenStringSet(vpcConfigurationDescriptions[0].SubnetIds),
+				"vpc_configuration_id": aws.StringValue(vpcConfigurationDescriptions[0].VpcConfigurationId),
+				"vpc_id":               aws.StringValue(vpcConfigurationDescriptions[0].VpcId),
+			},
+		}
+	}
+
+	return []interface{}{mApplicationConfiguration}
+}
+
+func flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(cloudWatchLoggingOptionDescriptions []*kinesisanalyticsv2.CloudWatchLoggingOptionDescription) []interface{} {
+	if len(cloudWatchLoggingOptionDescriptions) == 0 || cloudWatchLoggingOptionDescriptions[0] == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cloudwatch_logging_option_id": aws.StringValue(cloudWatchLoggingOptionDescriptions[0].CloudWatchLoggingOptionId),
+			"log_stream_arn":               aws.StringValue(cloudWatchLoggingOptionDescriptions[0].LogStreamARN),
+		},
+	}
+}
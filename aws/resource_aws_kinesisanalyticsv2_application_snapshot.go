@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsKinesisAnalyticsV2ApplicationSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisAnalyticsV2ApplicationSnapshotCreate,
+		Read:   resourceAwsKinesisAnalyticsV2ApplicationSnapshotRead,
+		Delete: resourceAwsKinesisAnalyticsV2ApplicationSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.SnapshotCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.SnapshotDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"application_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"snapshot_creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	applicationName := d.Get("application_name").(string)
+	snapshotName := d.Get("snapshot_name").(string)
+
+	input := &kinesisanalyticsv2.CreateApplicationSnapshotInput{
+		ApplicationName: aws.String(applicationName),
+		SnapshotName:    aws.String(snapshotName),
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics v2 Application Snapshot: %s", input)
+	_, err := conn.CreateApplicationSnapshot(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Analytics v2 Application Snapshot (%s/%s): %s", applicationName, snapshotName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", applicationName, snapshotName))
+
+	if _, err := waiter.SnapshotCreated(conn, applicationName, snapshotName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application Snapshot (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationSnapshotRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	applicationName, snapshotName, err := decodeKinesisAnalyticsV2ApplicationSnapshotID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := finder.SnapshotDetailsByApplicationAndSnapshotNames(conn, applicationName, snapshotName)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Analytics v2 Application Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application Snapshot (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_name", applicationName)
+	d.Set("application_version_id", int(aws.Int64Value(snapshot.ApplicationVersionId)))
+	d.Set("snapshot_creation_timestamp", aws.TimeValue(snapshot.SnapshotCreationTimestamp).Format(time.RFC3339))
+	d.Set("snapshot_name", snapshot.SnapshotName)
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	applicationName, snapshotName, err := decodeKinesisAnalyticsV2ApplicationSnapshotID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// The exact creation timestamp is required, so it is not taken from state.
+	snapshot, err := finder.SnapshotDetailsByApplicationAndSnapshotNames(conn, applicationName, snapshotName)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application Snapshot (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application Snapshot: %s", d.Id())
+	_, err = conn.DeleteApplicationSnapshot(&kinesisanalyticsv2.DeleteApplicationSnapshotInput{
+		ApplicationName:           aws.String(applicationName),
+		SnapshotCreationTimestamp: snapshot.SnapshotCreationTimestamp,
+		SnapshotName:              aws.String(snapshotName),
+	})
+
+	if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Analytics v2 Application Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.SnapshotDeleted(conn, applicationName, snapshotName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application Snapshot (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeKinesisAnalyticsV2ApplicationSnapshotID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected APPLICATION-NAME/SNAPSHOT-NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
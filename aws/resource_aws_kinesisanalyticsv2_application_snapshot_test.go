@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestDecodeKinesisAnalyticsV2ApplicationSnapshotID(t *testing.T) {
+	var testCases = []struct {
+		Input                   string
+		ExpectedApplicationName string
+		ExpectedSnapshotName    string
+		ErrCount                int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "application",
+			ErrCount: 1,
+		},
+		{
+			Input:    "application/",
+			ErrCount: 1,
+		},
+		{
+			Input:    "/snapshot",
+			ErrCount: 1,
+		},
+		{
+			Input:    "application/snapshot/extra",
+			ErrCount: 1,
+		},
+		{
+			Input:                   "application/snapshot",
+			ExpectedApplicationName: "application",
+			ExpectedSnapshotName:    "snapshot",
+			ErrCount:                0,
+		},
+	}
+
+	for _, tc := range testCases {
+		applicationName, snapshotName, err := decodeKinesisAnalyticsV2ApplicationSnapshotID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if applicationName != tc.ExpectedApplicationName {
+			t.Fatalf("expected %q to return application name %q, received: %q", tc.Input, tc.ExpectedApplicationName, applicationName)
+		}
+		if snapshotName != tc.ExpectedSnapshotName {
+			t.Fatalf("expected %q to return snapshot name %q, received: %q", tc.Input, tc.ExpectedSnapshotName, snapshotName)
+		}
+	}
+}
+
+func TestAccAWSKinesisAnalyticsV2ApplicationSnapshot_basic(t *testing.T) {
+	var snapshot kinesisanalyticsv2.SnapshotDetails
+	resourceName := "aws_kinesisanalyticsv2_application_snapshot.test"
+	applicationResourceName := "aws_kinesisanalyticsv2_application.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	codePath := testAccKinesisAnalyticsV2FlinkApplicationCodePath(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisAnalyticsV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationSnapshotConfig(rName, codePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationSnapshotExists(resourceName, &snapshot),
+					resource.TestCheckResourceAttrPair(resourceName, "application_name", applicationResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_version_id", applicationResourceName, "version_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_creation_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKinesisAnalyticsV2ApplicationSnapshotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesisanalyticsv2_application_snapshot" {
+			continue
+		}
+
+		_, err := finder.SnapshotDetailsByApplicationAndSnapshotNames(conn, rs.Primary.Attributes["application_name"], rs.Primary.Attributes["snapshot_name"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kinesis Analytics v2 Application Snapshot %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKinesisAnalyticsV2ApplicationSnapshotExists(n string, v *kinesisanalyticsv2.SnapshotDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Analytics v2 Application Snapshot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+
+		snapshot, err := finder.SnapshotDetailsByApplicationAndSnapshotNames(conn, rs.Primary.Attributes["application_name"], rs.Primary.Attributes["snapshot_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *snapshot
+
+		return nil
+	}
+}
+
+func testAccKinesisAnalyticsV2ApplicationSnapshotConfig(rName, codePath string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigStartApplication(rName, codePath, true) + fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application_snapshot" "test" {
+  application_name = "${aws_kinesisanalyticsv2_application.test.name}"
+  snapshot_name    = %[1]q
+}
+`, rName)
+}
@@ -24,6 +24,9 @@ func resourceAwsKmsGrant() *schema.Resource {
 		Read:   resourceAwsKmsGrantRead,
 		Delete: resourceAwsKmsGrantDelete,
 		Exists: resourceAwsKmsGrantExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsKmsGrantImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -205,6 +208,9 @@ func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	d.Set("grant_id", grantId)
+	d.Set("key_id", keyId)
+
 	// The grant sometimes contains principals that identified by their unique id: "AROAJYCVIVUZIMTXXXXX"
 	// instead of "arn:aws:...", in this case don't update the state file
 	if strings.HasPrefix(*grant.GranteePrincipal, "arn:aws") {
@@ -531,6 +537,19 @@ func flattenKmsGrantConstraints(constraint *kms.GrantConstraints) *schema.Set {
 	return constraints
 }
 
+func resourceAwsKmsGrantImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keyId, grantId, err := decodeKmsGrantId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("grant_id", grantId)
+	d.Set("key_id", keyId)
+	d.Set("retire_on_delete", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func decodeKmsGrantId(id string) (string, string, error) {
 	if strings.HasPrefix(id, "arn:aws") {
 		arn_parts := strings.Split(id, "/")
@@ -30,6 +30,12 @@ func TestAccAWSKmsGrant_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_kms_grant.basic", "key_id"),
 				),
 			},
+			{
+				ResourceName:            "aws_kms_grant.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"grant_creation_tokens", "grant_token", "retire_on_delete"},
+			},
 		},
 	})
 }
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLakeFormationDataLakeSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationDataLakeSettingsPut,
+		Read:   resourceAwsLakeFormationDataLakeSettingsRead,
+		Update: resourceAwsLakeFormationDataLakeSettingsPut,
+		Delete: resourceAwsLakeFormationDataLakeSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admins": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"create_database_default_permissions": lakeFormationPrincipalPermissionsSchema(),
+			"create_table_default_permissions":    lakeFormationPrincipalPermissionsSchema(),
+		},
+	}
+}
+
+func lakeFormationPrincipalPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:       schema.TypeList,
+		ConfigMode: schema.SchemaConfigModeAttr,
+		Optional:   true,
+		Computed:   true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"permissions": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(lakeFormationPermissionValues(), false),
+					},
+				},
+				"principal": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+		},
+	}
+}
+
+func lakeFormationPermissionValues() []string {
+	return []string{
+		lakeformation.PermissionAll,
+		lakeformation.PermissionAlter,
+		lakeformation.PermissionCreateDatabase,
+		lakeformation.PermissionCreateTable,
+		lakeformation.PermissionDataLocationAccess,
+		lakeformation.PermissionDelete,
+		lakeformation.PermissionDrop,
+		lakeformation.PermissionInsert,
+		lakeformation.PermissionSelect,
+	}
+}
+
+func resourceAwsLakeFormationDataLakeSettingsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+
+	input := &lakeformation.PutDataLakeSettingsInput{
+		CatalogId: aws.String(catalogID),
+		DataLakeSettings: &lakeformation.DataLakeSettings{
+			CreateDatabaseDefaultPermissions: expandLakeFormationPrincipalPermissions(d.Get("create_database_default_permissions").([]interface{})),
+			CreateTableDefaultPermissions:    expandLakeFormationPrincipalPermissions(d.Get("create_table_default_permissions").([]interface{})),
+			DataLakeAdmins:                   expandLakeFormationDataLakePrincipals(d.Get("admins").(*schema.Set)),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting Lake Formation Data Lake Settings: %s", input)
+	_, err := conn.PutDataLakeSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Lake Formation Data Lake Settings (%s): %s", catalogID, err)
+	}
+
+	d.SetId(catalogID)
+
+	return resourceAwsLakeFormationDataLakeSettingsRead(d, meta)
+}
+
+func resourceAwsLakeFormationDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	settings, err := finder.DataLakeSettingsByCatalogID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Data Lake Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", d.Id())
+
+	if err := d.Set("admins", flattenLakeFormationDataLakePrincipals(settings.DataLakeAdmins)); err != nil {
+		return fmt.Errorf("error setting admins: %s", err)
+	}
+
+	if err := d.Set("create_database_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateDatabaseDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_database_default_permissions: %s", err)
+	}
+
+	if err := d.Set("create_table_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateTableDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_table_default_permissions: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationDataLakeSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.PutDataLakeSettingsInput{
+		CatalogId: aws.String(d.Id()),
+		DataLakeSettings: &lakeformation.DataLakeSettings{
+			CreateDatabaseDefaultPermissions: []*lakeformation.PrincipalPermissions{},
+			CreateTableDefaultPermissions:    []*lakeformation.PrincipalPermissions{},
+			DataLakeAdmins:                   []*lakeformation.DataLakePrincipal{},
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Lake Formation Data Lake Settings: %s", input)
+	_, err := conn.PutDataLakeSettings(input)
+
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resetting Lake Formation Data Lake Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLakeFormationDataLakePrincipals(tfSet *schema.Set) []*lakeformation.DataLakePrincipal {
+	apiObjects := []*lakeformation.DataLakePrincipal{}
+
+	for _, v := range tfSet.List() {
+		apiObjects = append(apiObjects, &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLakeFormationDataLakePrincipals(apiObjects []*lakeformation.DataLakePrincipal) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.DataLakePrincipalIdentifier))
+	}
+
+	return tfList
+}
+
+func expandLakeFormationPrincipalPermissions(tfList []interface{}) []*lakeformation.PrincipalPermissions {
+	apiObjects := []*lakeformation.PrincipalPermissions{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lakeformation.PrincipalPermissions{
+			Permissions: expandStringSet(tfMap["permissions"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["principal"].(string); ok && v != "" {
+			apiObject.Principal = &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(v),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLakeFormationPrincipalPermissions(apiObjects []*lakeformation.PrincipalPermissions) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"permissions": flattenStringSet(apiObject.Permissions),
+		}
+
+		if apiObject.Principal != nil {
+			tfMap["principal"] = aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,348 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLakeFormationPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationPermissionsCreate,
+		Read:   resourceAwsLakeFormationPermissionsRead,
+		Delete: resourceAwsLakeFormationPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"catalog_resource": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"data_location", "database", "table"},
+			},
+			"data_location": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"catalog_resource", "database", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"catalog_resource", "data_location", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeFormationPermissionValues(), false),
+				},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeFormationPermissionValues(), false),
+				},
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"catalog_resource", "data_location", "database"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+
+	lfResource := expandLakeFormationPermissionsResource(d)
+
+	if lfResource == nil {
+		return fmt.Errorf("one of catalog_resource, data_location, database or table must be specified")
+	}
+
+	input := &lakeformation.GrantPermissionsInput{
+		CatalogId:   aws.String(catalogID),
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: lfResource,
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok && v.(*schema.Set).Len() > 0 {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Granting Lake Formation Permissions: %s", input)
+	// Newly created IAM principals may not be visible to Lake Formation immediately.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.GrantPermissions(input)
+
+		if isAWSErr(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, lakeformation.ErrCodeConcurrentModificationException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.GrantPermissions(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error granting Lake Formation Permissions: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+	d.Set("catalog_id", catalogID)
+
+	return resourceAwsLakeFormationPermissionsRead(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+	principal := d.Get("principal").(string)
+
+	input := &lakeformation.ListPermissionsInput{
+		CatalogId: aws.String(d.Get("catalog_id").(string)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+		Resource: expandLakeFormationPermissionsResource(d),
+	}
+
+	var filter func(*lakeformation.Resource) bool
+
+	if d.Get("catalog_resource").(bool) {
+		input.Resource = nil
+		input.ResourceType = aws.String(lakeformation.DataLakeResourceTypeCatalog)
+		filter = func(r *lakeformation.Resource) bool {
+			return r != nil && r.Catalog != nil
+		}
+	}
+
+	permissions, err := finder.PrincipalResourcePermissions(conn, input, principal, filter)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Permissions (%s): %s", d.Id(), err)
+	}
+
+	var grants, grantsWithGrantOption []*string
+
+	for _, permission := range permissions {
+		grants = append(grants, permission.Permissions...)
+		grantsWithGrantOption = append(grantsWithGrantOption, permission.PermissionsWithGrantOption...)
+	}
+
+	// The principal may hold other permissions on the resource, granted outside of
+	// this resource. Only track the permissions that this resource manages.
+	grantSet := lakeFormationPermissionsFilter(d.Get("permissions").(*schema.Set), grants)
+
+	if grantSet.Len() == 0 {
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("permissions", grantSet); err != nil {
+		return fmt.Errorf("error setting permissions: %s", err)
+	}
+
+	grantWithGrantOptionSet := lakeFormationPermissionsFilter(d.Get("permissions_with_grant_option").(*schema.Set), grantsWithGrantOption)
+
+	if err := d.Set("permissions_with_grant_option", grantWithGrantOptionSet); err != nil {
+		return fmt.Errorf("error setting permissions_with_grant_option: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.RevokePermissionsInput{
+		CatalogId:   aws.String(d.Get("catalog_id").(string)),
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: expandLakeFormationPermissionsResource(d),
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok && v.(*schema.Set).Len() > 0 {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Revoking Lake Formation Permissions: %s", input)
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.RevokePermissions(input)
+
+		if isAWSErr(err, lakeformation.ErrCodeConcurrentModificationException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.RevokePermissions(input)
+	}
+
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking Lake Formation Permissions (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// lakeFormationPermissionsFilter returns the configured permissions that are present in the granted permissions.
+func lakeFormationPermissionsFilter(configured *schema.Set, granted []*string) *schema.Set {
+	result := schema.NewSet(configured.F, nil)
+
+	for _, permission := range granted {
+		if v := aws.StringValue(permission); configured.Contains(v) {
+			result.Add(v)
+		}
+	}
+
+	return result
+}
+
+func expandLakeFormationPermissionsResource(d *schema.ResourceData) *lakeformation.Resource {
+	if d.Get("catalog_resource").(bool) {
+		return &lakeformation.Resource{
+			Catalog: &lakeformation.CatalogResource{},
+		}
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		return &lakeformation.Resource{
+			DataLocation: &lakeformation.DataLocationResource{
+				ResourceArn: aws.String(tfMap["arn"].(string)),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		return &lakeformation.Resource{
+			Database: &lakeformation.DatabaseResource{
+				Name: aws.String(tfMap["name"].(string)),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		return &lakeformation.Resource{
+			Table: &lakeformation.TableResource{
+				DatabaseName: aws.String(tfMap["database_name"].(string)),
+				Name:         aws.String(tfMap["name"].(string)),
+			},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,406 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// These tests make the caller a data lake administrator, so they are not run in parallel.
+
+func TestAccAWSLakeFormationPermissions_catalogResource(t *testing.T) {
+	resourceName := "aws_lakeformation_permissions.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLakeFormation(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfigCatalogResource(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_resource", "true"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_with_grant_option.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLakeFormationPermissions_dataLocation(t *testing.T) {
+	resourceName := "aws_lakeformation_permissions.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLakeFormation(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfigDataLocation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_location.0.arn", bucketResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLakeFormationPermissions_database(t *testing.T) {
+	resourceName := "aws_lakeformation_permissions.test"
+	databaseResourceName := "aws_glue_catalog_database.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLakeFormation(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfigDatabase(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", databaseResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_with_grant_option.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLakeFormationPermissions_multiple(t *testing.T) {
+	resourceName1 := "aws_lakeformation_permissions.test1"
+	resourceName2 := "aws_lakeformation_permissions.test2"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLakeFormation(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfigMultiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName1),
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName1, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName1, "permissions_with_grant_option.#", "1"),
+					resource.TestCheckResourceAttr(resourceName2, "permissions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName2, "permissions_with_grant_option.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLakeFormationPermissions_table(t *testing.T) {
+	resourceName := "aws_lakeformation_permissions.test"
+	tableResourceName := "aws_glue_catalog_table.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLakeFormation(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfigTable(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.database_name", tableResourceName, "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.name", tableResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestLakeFormationPermissionsFilter(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Configured []interface{}
+		Granted    []*string
+		Expected   []string
+	}{
+		{
+			Name:       "all granted",
+			Configured: []interface{}{"ALTER", "DROP"},
+			Granted:    aws.StringSlice([]string{"ALTER", "DROP"}),
+			Expected:   []string{"ALTER", "DROP"},
+		},
+		{
+			Name:       "additional grants",
+			Configured: []interface{}{"ALTER"},
+			Granted:    aws.StringSlice([]string{"ALTER", "CREATE_TABLE", "DROP"}),
+			Expected:   []string{"ALTER"},
+		},
+		{
+			Name:       "missing grants",
+			Configured: []interface{}{"ALTER", "DROP"},
+			Granted:    aws.StringSlice([]string{"DROP"}),
+			Expected:   []string{"DROP"},
+		},
+		{
+			Name:       "no grants",
+			Configured: []interface{}{"ALTER"},
+			Granted:    nil,
+			Expected:   []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			configured := schema.NewSet(schema.HashString, testCase.Configured)
+			got := lakeFormationPermissionsFilter(configured, testCase.Granted)
+
+			if got.Len() != len(testCase.Expected) {
+				t.Fatalf("got %d permissions, expected %d: %v", got.Len(), len(testCase.Expected), got.List())
+			}
+
+			for _, permission := range testCase.Expected {
+				if !got.Contains(permission) {
+					t.Errorf("expected permission %s in %v", permission, got.List())
+				}
+			}
+		})
+	}
+}
+
+func testAccCheckAWSLakeFormationPermissionsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_permissions" {
+			continue
+		}
+
+		_, err := testAccAWSLakeFormationPermissionsFind(rs)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lake Formation Permissions %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLakeFormationPermissionsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation Permissions ID is set")
+		}
+
+		_, err := testAccAWSLakeFormationPermissionsFind(rs)
+
+		return err
+	}
+}
+
+func testAccAWSLakeFormationPermissionsFind(rs *terraform.ResourceState) ([]*lakeformation.PrincipalResourcePermissions, error) {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+	principal := rs.Primary.Attributes["principal"]
+
+	input := &lakeformation.ListPermissionsInput{
+		CatalogId: aws.String(rs.Primary.Attributes["catalog_id"]),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+		Resource: &lakeformation.Resource{},
+	}
+
+	var filter func(*lakeformation.Resource) bool
+
+	switch {
+	case rs.Primary.Attributes["catalog_resource"] == "true":
+		input.Resource = nil
+		input.ResourceType = aws.String(lakeformation.DataLakeResourceTypeCatalog)
+		filter = func(r *lakeformation.Resource) bool {
+			return r != nil && r.Catalog != nil
+		}
+	case rs.Primary.Attributes["data_location.#"] == "1":
+		input.Resource.DataLocation = &lakeformation.DataLocationResource{
+			ResourceArn: aws.String(rs.Primary.Attributes["data_location.0.arn"]),
+		}
+	case rs.Primary.Attributes["database.#"] == "1":
+		input.Resource.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(rs.Primary.Attributes["database.0.name"]),
+		}
+	case rs.Primary.Attributes["table.#"] == "1":
+		input.Resource.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(rs.Primary.Attributes["table.0.database_name"]),
+			Name:         aws.String(rs.Primary.Attributes["table.0.name"]),
+		}
+	}
+
+	return finder.PrincipalResourcePermissions(conn, input, principal, filter)
+}
+
+func testAccAWSLakeFormationPermissionsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "glue.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = ["${data.aws_caller_identity.current.arn}"]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfigCatalogResource(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfigBase(rName) + `
+resource "aws_lakeformation_permissions" "test" {
+  principal        = "${aws_iam_role.test.arn}"
+  permissions      = ["CREATE_DATABASE"]
+  catalog_resource = true
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+`
+}
+
+func testAccAWSLakeFormationPermissionsConfigDataLocation(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_lakeformation_resource" "test" {
+  arn = "${aws_s3_bucket.test.arn}"
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  principal   = "${aws_iam_role.test.arn}"
+  permissions = ["DATA_LOCATION_ACCESS"]
+
+  data_location {
+    arn = "${aws_lakeformation_resource.test.arn}"
+  }
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfigDatabase(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfigBase(rName) + fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  principal                     = "${aws_iam_role.test.arn}"
+  permissions                   = ["ALTER", "CREATE_TABLE", "DROP"]
+  permissions_with_grant_option = ["CREATE_TABLE"]
+
+  database {
+    name = "${aws_glue_catalog_database.test.name}"
+  }
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfigMultiple(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfigBase(rName) + fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_lakeformation_permissions" "test1" {
+  principal                     = "${aws_iam_role.test.arn}"
+  permissions                   = ["CREATE_TABLE"]
+  permissions_with_grant_option = ["CREATE_TABLE"]
+
+  database {
+    name = "${aws_glue_catalog_database.test.name}"
+  }
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+
+resource "aws_lakeformation_permissions" "test2" {
+  principal   = "${aws_iam_role.test.arn}"
+  permissions = ["ALTER", "DROP"]
+
+  database {
+    name = "${aws_glue_catalog_database.test.name}"
+  }
+
+  depends_on = ["aws_lakeformation_permissions.test1"]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfigTable(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfigBase(rName) + fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = "${aws_glue_catalog_database.test.name}"
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  principal   = "${aws_iam_role.test.arn}"
+  permissions = ["ALL"]
+
+  table {
+    database_name = "${aws_glue_catalog_table.test.database_name}"
+    name          = "${aws_glue_catalog_table.test.name}"
+  }
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+`, rName)
+}
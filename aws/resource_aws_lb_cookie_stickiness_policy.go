@@ -19,6 +19,15 @@ func resourceAwsLBCookieStickinessPolicy() *schema.Resource {
 		Create: resourceAwsLBCookieStickinessPolicyCreate,
 		Read:   resourceAwsLBCookieStickinessPolicyRead,
 		Delete: resourceAwsLBCookieStickinessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ":")
+				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER-NAME:LOAD-BALANCER-PORT:POLICY-NAME", d.Id())
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
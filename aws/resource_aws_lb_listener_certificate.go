@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +18,20 @@ func resourceAwsLbListenerCertificate() *schema.Resource {
 		Create: resourceAwsLbListenerCertificateCreate,
 		Read:   resourceAwsLbListenerCertificateRead,
 		Delete: resourceAwsLbListenerCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Listener ARNs cannot contain underscores, so only split on the first one
+				idParts := strings.SplitN(d.Id(), "_", 2)
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected LISTENER-ARN_CERTIFICATE-ARN", d.Id())
+				}
+				listenerArn := idParts[0]
+				certificateArn := idParts[1]
+				d.Set("listener_arn", listenerArn)
+				d.Set("certificate_arn", certificateArn)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"listener_arn": {
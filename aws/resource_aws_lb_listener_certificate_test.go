@@ -32,6 +32,11 @@ func TestAccAwsLbListenerCertificate_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_lb_listener_certificate.additional_2", "certificate_arn"),
 				),
 			},
+			{
+				ResourceName:      "aws_lb_listener_certificate.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -3,6 +3,8 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
@@ -15,6 +17,9 @@ func resourceAwsLbTargetGroupAttachment() *schema.Resource {
 		Create: resourceAwsLbAttachmentCreate,
 		Read:   resourceAwsLbAttachmentRead,
 		Delete: resourceAwsLbAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLbTargetGroupAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"target_group_arn": {
@@ -172,3 +177,26 @@ func resourceAwsLbAttachmentRead(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func resourceAwsLbTargetGroupAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ",")
+	if (len(idParts) != 2 && len(idParts) != 3) || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected TARGET-GROUP-ARN,TARGET-ID or TARGET-GROUP-ARN,TARGET-ID,PORT", d.Id())
+	}
+
+	targetGroupArn := idParts[0]
+	d.Set("target_group_arn", targetGroupArn)
+	d.Set("target_id", idParts[1])
+
+	if len(idParts) == 3 {
+		port, err := strconv.Atoi(idParts[2])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing port (%s): %s", idParts[2], err)
+		}
+		d.Set("port", port)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", targetGroupArn)))
+
+	return []*schema.ResourceData{d}, nil
+}
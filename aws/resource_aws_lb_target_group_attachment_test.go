@@ -28,10 +28,44 @@ func TestAccAWSLBTargetGroupAttachment_basic(t *testing.T) {
 					testAccCheckAWSLBTargetGroupAttachmentExists("aws_lb_target_group_attachment.test"),
 				),
 			},
+			{
+				ResourceName:      "aws_lb_target_group_attachment.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSLBTargetGroupAttachmentImportStateIdFunc("aws_lb_target_group_attachment.test"),
+				// The Create function uses resource.PrefixedUniqueId(), so IDs cannot be aligned
+				ImportStateCheck: testAccCheckAWSLBTargetGroupAttachmentImportState,
+			},
 		},
 	})
 }
 
+func testAccAWSLBTargetGroupAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s,%s", rs.Primary.Attributes["target_group_arn"], rs.Primary.Attributes["target_id"], rs.Primary.Attributes["port"]), nil
+	}
+}
+
+func testAccCheckAWSLBTargetGroupAttachmentImportState(s []*terraform.InstanceState) error {
+	if len(s) != 1 {
+		return fmt.Errorf("expected 1 state: %#v", s)
+	}
+
+	rs := s[0]
+
+	for _, attribute := range []string{"port", "target_group_arn", "target_id"} {
+		if rs.Attributes[attribute] == "" {
+			return fmt.Errorf("expected %s attribute to be set", attribute)
+		}
+	}
+
+	return nil
+}
+
 func TestAccAWSLBTargetGroupAttachment_disappears(t *testing.T) {
 	targetGroupName := fmt.Sprintf("test-target-group-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	resource.ParallelTest(t, resource.TestCase{
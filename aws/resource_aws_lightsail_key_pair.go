@@ -18,6 +18,9 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 		Create: resourceAwsLightsailKeyPairCreate,
 		Read:   resourceAwsLightsailKeyPairRead,
 		Delete: resourceAwsLightsailKeyPairDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
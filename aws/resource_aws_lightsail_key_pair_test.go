@@ -32,6 +32,12 @@ func TestAccAWSLightsailKeyPair_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_lightsail_key_pair.lightsail_key_pair_test", "private_key"),
 				),
 			},
+			{
+				ResourceName:            "aws_lightsail_key_pair.lightsail_key_pair_test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encrypted_fingerprint", "encrypted_private_key", "pgp_key", "private_key", "public_key"},
+			},
 		},
 	})
 }
@@ -14,6 +14,9 @@ func resourceAwsLightsailStaticIp() *schema.Resource {
 		Create: resourceAwsLightsailStaticIpCreate,
 		Read:   resourceAwsLightsailStaticIpRead,
 		Delete: resourceAwsLightsailStaticIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,7 +61,7 @@ func resourceAwsLightsailStaticIpCreate(d *schema.ResourceData, meta interface{}
 func resourceAwsLightsailStaticIpRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lightsailconn
 
-	name := d.Get("name").(string)
+	name := d.Id()
 	log.Printf("[INFO] Reading Lightsail Static IP: %q", name)
 	out, err := conn.GetStaticIp(&lightsail.GetStaticIpInput{
 		StaticIpName: aws.String(name),
@@ -77,6 +80,7 @@ func resourceAwsLightsailStaticIpRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("arn", out.StaticIp.Arn)
 	d.Set("ip_address", out.StaticIp.IpAddress)
+	d.Set("name", out.StaticIp.Name)
 	d.Set("support_code", out.StaticIp.SupportCode)
 
 	return nil
@@ -14,6 +14,9 @@ func resourceAwsLightsailStaticIpAttachment() *schema.Resource {
 		Create: resourceAwsLightsailStaticIpAttachmentCreate,
 		Read:   resourceAwsLightsailStaticIpAttachmentRead,
 		Delete: resourceAwsLightsailStaticIpAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"static_ip_name": {
@@ -52,7 +55,7 @@ func resourceAwsLightsailStaticIpAttachmentCreate(d *schema.ResourceData, meta i
 func resourceAwsLightsailStaticIpAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lightsailconn
 
-	staticIpName := d.Get("static_ip_name").(string)
+	staticIpName := d.Id()
 	log.Printf("[INFO] Reading Lightsail Static IP: %q", staticIpName)
 	out, err := conn.GetStaticIp(&lightsail.GetStaticIpInput{
 		StaticIpName: aws.String(staticIpName),
@@ -76,6 +79,7 @@ func resourceAwsLightsailStaticIpAttachmentRead(d *schema.ResourceData, meta int
 	log.Printf("[INFO] Received Lightsail Static IP: %s", *out)
 
 	d.Set("instance_name", out.StaticIp.AttachedTo)
+	d.Set("static_ip_name", out.StaticIp.Name)
 
 	return nil
 }
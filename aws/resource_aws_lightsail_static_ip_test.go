@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &sweep.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    testSweepLightsailStaticIps,
 	})
@@ -81,6 +82,11 @@ func TestAccAWSLightsailStaticIp_basic(t *testing.T) {
 					testAccCheckAWSLightsailStaticIpExists("aws_lightsail_static_ip.test", &staticIp),
 				),
 			},
+			{
+				ResourceName:      "aws_lightsail_static_ip.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,6 +17,15 @@ func resourceAwsLoadBalancerListenerPolicies() *schema.Resource {
 		Read:   resourceAwsLoadBalancerListenerPoliciesRead,
 		Update: resourceAwsLoadBalancerListenerPoliciesCreate,
 		Delete: resourceAwsLoadBalancerListenerPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ":")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER-NAME:LOAD-BALANCER-PORT", d.Id())
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": {
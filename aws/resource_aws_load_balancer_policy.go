@@ -17,6 +17,15 @@ func resourceAwsLoadBalancerPolicy() *schema.Resource {
 		Read:   resourceAwsLoadBalancerPolicyRead,
 		Update: resourceAwsLoadBalancerPolicyUpdate,
 		Delete: resourceAwsLoadBalancerPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ":")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER-NAME:POLICY-NAME", d.Id())
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": {
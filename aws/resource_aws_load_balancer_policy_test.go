@@ -33,6 +33,11 @@ func TestAccAWSLoadBalancerPolicy_basic(t *testing.T) {
 					testAccCheckAWSLoadBalancerPolicyState(loadBalancerResourceName, resourceName),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
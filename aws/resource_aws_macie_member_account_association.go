@@ -14,6 +14,9 @@ func resourceAwsMacieMemberAccountAssociation() *schema.Resource {
 		Create: resourceAwsMacieMemberAccountAssociationCreate,
 		Read:   resourceAwsMacieMemberAccountAssociationRead,
 		Delete: resourceAwsMacieMemberAccountAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"member_account_id": {
@@ -52,7 +55,7 @@ func resourceAwsMacieMemberAccountAssociationRead(d *schema.ResourceData, meta i
 	var res *macie.MemberAccount
 	err := conn.ListMemberAccountsPages(req, func(page *macie.ListMemberAccountsOutput, lastPage bool) bool {
 		for _, v := range page.MemberAccounts {
-			if aws.StringValue(v.AccountId) == d.Get("member_account_id").(string) {
+			if aws.StringValue(v.AccountId) == d.Id() {
 				res = v
 				return false
 			}
@@ -70,6 +73,8 @@ func resourceAwsMacieMemberAccountAssociationRead(d *schema.ResourceData, meta i
 		return nil
 	}
 
+	d.Set("member_account_id", res.AccountId)
+
 	return nil
 }
 
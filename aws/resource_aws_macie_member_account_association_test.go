@@ -29,6 +29,11 @@ func TestAccAWSMacieMemberAccountAssociation_basic(t *testing.T) {
 					testAccCheckAWSMacieMemberAccountAssociationExists("aws_macie_member_account_association.test"),
 				),
 			},
+			{
+				ResourceName:      "aws_macie_member_account_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
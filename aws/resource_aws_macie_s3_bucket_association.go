@@ -17,6 +17,24 @@ func resourceAwsMacieS3BucketAssociation() *schema.Resource {
 		Read:   resourceAwsMacieS3BucketAssociationRead,
 		Update: resourceAwsMacieS3BucketAssociationUpdate,
 		Delete: resourceAwsMacieS3BucketAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The prefix may be empty or contain slashes, so only split on the first one
+				idParts := strings.SplitN(d.Id(), "/", 2)
+				if idParts[0] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected BUCKET-NAME/PREFIX", d.Id())
+				}
+				bucketName := idParts[0]
+				d.Set("bucket_name", bucketName)
+				prefix := ""
+				if len(idParts) == 2 && idParts[1] != "" {
+					prefix = idParts[1]
+					d.Set("prefix", prefix)
+				}
+				d.SetId(fmt.Sprintf("%s/%s", bucketName, prefix))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket_name": {
@@ -27,6 +27,11 @@ func TestAccAWSMacieS3BucketAssociation_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_macie_s3_bucket_association.test", "classification_type.0.one_time", "NONE"),
 				),
 			},
+			{
+				ResourceName:      "aws_macie_s3_bucket_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSMacieS3BucketAssociationConfig_basicOneTime(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -0,0 +1,347 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediaconvert/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMediaConvertJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertJobTemplateCreate,
+		Read:   resourceAwsMediaConvertJobTemplateRead,
+		Update: resourceAwsMediaConvertJobTemplateUpdate,
+		Delete: resourceAwsMediaConvertJobTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acceleration_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.AccelerationModeDisabled,
+								mediaconvert.AccelerationModeEnabled,
+							}, false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(-50, 50),
+			},
+			// The API returns the ARN of the queue, which may have been configured by name.
+			"queue": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.HasSuffix(old, fmt.Sprintf(":queues/%s", new))
+				},
+			},
+			"settings": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := mediaConvertJobTemplateSettingsAreEquivalent(old, new)
+					return equal
+				},
+				ValidateFunc: validateMediaConvertJobTemplateSettings,
+			},
+			"status_update_interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.StatusUpdateIntervalSeconds10,
+					mediaconvert.StatusUpdateIntervalSeconds12,
+					mediaconvert.StatusUpdateIntervalSeconds15,
+					mediaconvert.StatusUpdateIntervalSeconds20,
+					mediaconvert.StatusUpdateIntervalSeconds30,
+					mediaconvert.StatusUpdateIntervalSeconds60,
+					mediaconvert.StatusUpdateIntervalSeconds120,
+					mediaconvert.StatusUpdateIntervalSeconds180,
+					mediaconvert.StatusUpdateIntervalSeconds240,
+					mediaconvert.StatusUpdateIntervalSeconds300,
+					mediaconvert.StatusUpdateIntervalSeconds360,
+					mediaconvert.StatusUpdateIntervalSeconds420,
+					mediaconvert.StatusUpdateIntervalSeconds480,
+					mediaconvert.StatusUpdateIntervalSeconds540,
+					mediaconvert.StatusUpdateIntervalSeconds600,
+				}, false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func validateMediaConvertJobTemplateSettings(v interface{}, k string) (ws []string, errors []error) {
+	settings, err := expandMediaConvertJobTemplateSettings(v.(string))
+
+	if err == nil {
+		err = settings.Validate()
+	}
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid MediaConvert job template settings: %s", k, err))
+	}
+
+	return
+}
+
+func resourceAwsMediaConvertJobTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	settings, err := expandMediaConvertJobTemplateSettings(d.Get("settings").(string))
+
+	if err != nil {
+		return fmt.Errorf("error expanding MediaConvert Job Template settings: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	input := &mediaconvert.CreateJobTemplateInput{
+		Name:     aws.String(name),
+		Priority: aws.Int64(int64(d.Get("priority").(int))),
+		Settings: settings,
+	}
+
+	if v, ok := d.GetOk("acceleration_settings"); ok {
+		input.AccelerationSettings = expandMediaConvertAccelerationSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("queue"); ok {
+		input.Queue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status_update_interval"); ok {
+		input.StatusUpdateInterval = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MediaconvertTags()
+	}
+
+	log.Printf("[DEBUG] Creating MediaConvert Job Template: %s", input)
+	_, err = conn.CreateJobTemplate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaConvert Job Template (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsMediaConvertJobTemplateRead(d, meta)
+}
+
+func resourceAwsMediaConvertJobTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	jobTemplate, err := finder.JobTemplateByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConvert Job Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConvert Job Template (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("acceleration_settings", flattenMediaConvertAccelerationSettings(jobTemplate.AccelerationSettings)); err != nil {
+		return fmt.Errorf("error setting acceleration_settings: %s", err)
+	}
+
+	arn := aws.StringValue(jobTemplate.Arn)
+	d.Set("arn", arn)
+	d.Set("category", jobTemplate.Category)
+	d.Set("description", jobTemplate.Description)
+	d.Set("name", jobTemplate.Name)
+	d.Set("priority", jobTemplate.Priority)
+	d.Set("queue", jobTemplate.Queue)
+	d.Set("status_update_interval", jobTemplate.StatusUpdateInterval)
+
+	settings, err := flattenApiObjectJson(jobTemplate.Settings)
+
+	if err != nil {
+		return fmt.Errorf("error flattening MediaConvert Job Template (%s) settings: %s", d.Id(), err)
+	}
+
+	d.Set("settings", settings)
+
+	tags, err := keyvaluetags.MediaconvertListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConvert Job Template (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertJobTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("acceleration_settings") || d.HasChange("category") || d.HasChange("description") ||
+		d.HasChange("priority") || d.HasChange("queue") || d.HasChange("settings") || d.HasChange("status_update_interval") {
+		input := &mediaconvert.UpdateJobTemplateInput{
+			Category:    aws.String(d.Get("category").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+			Priority:    aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		if d.HasChange("acceleration_settings") {
+			input.AccelerationSettings = expandMediaConvertAccelerationSettings(d.Get("acceleration_settings").([]interface{}))
+		}
+
+		if d.HasChange("queue") {
+			input.Queue = aws.String(d.Get("queue").(string))
+		}
+
+		if d.HasChange("settings") {
+			settings, err := expandMediaConvertJobTemplateSettings(d.Get("settings").(string))
+
+			if err != nil {
+				return fmt.Errorf("error expanding MediaConvert Job Template settings: %s", err)
+			}
+
+			input.Settings = settings
+		}
+
+		if d.HasChange("status_update_interval") {
+			input.StatusUpdateInterval = aws.String(d.Get("status_update_interval").(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaConvert Job Template: %s", input)
+		_, err := conn.UpdateJobTemplate(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaConvert Job Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.MediaconvertUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaConvert Job Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConvertJobTemplateRead(d, meta)
+}
+
+func resourceAwsMediaConvertJobTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConvert Job Template: %s", d.Id())
+	_, err = conn.DeleteJobTemplate(&mediaconvert.DeleteJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConvert Job Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaConvertAccelerationSettings(tfList []interface{}) *mediaconvert.AccelerationSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &mediaconvert.AccelerationSettings{
+		Mode: aws.String(tfMap["mode"].(string)),
+	}
+}
+
+func flattenMediaConvertAccelerationSettings(apiObject *mediaconvert.AccelerationSettings) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode": aws.StringValue(apiObject.Mode),
+		},
+	}
+}
@@ -0,0 +1,444 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediaconvert/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_media_convert_job_template", &sweep.Sweeper{
+		Name: "aws_media_convert_job_template",
+		F:    testSweepMediaConvertJobTemplates,
+	})
+}
+
+func testSweepMediaConvertJobTemplates(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn, err := client.(*AWSClient).mediaConvertAccountConn()
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaConvert Job Template sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	input := &mediaconvert.ListJobTemplatesInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListJobTemplates(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaConvert Job Template sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaConvert Job Templates: %s", err))
+			return sweeperErrs
+		}
+
+		for _, jobTemplate := range output.JobTemplates {
+			name := aws.StringValue(jobTemplate.Name)
+
+			// System job templates cannot be deleted.
+			if aws.StringValue(jobTemplate.Type) == mediaconvert.TypeSystem {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting MediaConvert Job Template: %s", name)
+			_, err := conn.DeleteJobTemplate(&mediaconvert.DeleteJobTemplateInput{
+				Name: aws.String(name),
+			})
+
+			if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting MediaConvert Job Template (%s): %s", name, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaConvertJobTemplate_basic(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	queueResourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConvertJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConvertJobTemplateConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", fmt.Sprintf("jobTemplates/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue", queueResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "settings"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+			{
+				Config: testAccAWSMediaConvertJobTemplateConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "category", "web"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Web renditions"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "status_update_interval", mediaconvert.StatusUpdateIntervalSeconds30),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertJobTemplate_disappears(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConvertJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConvertJobTemplateConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					testAccCheckAWSMediaConvertJobTemplateDisappears(&jobTemplate),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertJobTemplate_tags(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConvertJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConvertJobTemplateConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaConvertJobTemplateConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaConvertJobTemplateConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaConvertJobTemplateDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_job_template" {
+			continue
+		}
+
+		_, err := finder.JobTemplateByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConvert Job Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMediaConvertJobTemplateDisappears(jobTemplate *mediaconvert.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(*AWSClient).mediaConvertAccountConn()
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DeleteJobTemplate(&mediaconvert.DeleteJobTemplateInput{
+			Name: jobTemplate.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSMediaConvertJobTemplateExists(n string, v *mediaconvert.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConvert Job Template ID is set")
+		}
+
+		conn, err := testAccProvider.Meta().(*AWSClient).mediaConvertAccountConn()
+
+		if err != nil {
+			return err
+		}
+
+		jobTemplate, err := finder.JobTemplateByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *jobTemplate
+
+		return nil
+	}
+}
+
+func testAccAWSMediaConvertJobTemplateConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = <<EOF
+{
+  "containerSettings": {
+    "container": "MP4"
+  },
+  "videoDescription": {
+    "codecSettings": {
+      "codec": "H_264",
+      "h264Settings": {
+        "maxBitrate": 5000000,
+        "rateControlMode": "QVBR"
+      }
+    }
+  }
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSMediaConvertJobTemplateConfigBasic(rName string) string {
+	return testAccAWSMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name  = %[1]q
+  queue = "${aws_media_convert_queue.test.arn}"
+
+  settings = <<EOF
+{
+  "outputGroups": [
+    {
+      "name": "File Group",
+      "outputGroupSettings": {
+        "type": "FILE_GROUP_SETTINGS",
+        "fileGroupSettings": {
+          "destination": "s3://${aws_s3_bucket.test.bucket}/output/"
+        }
+      },
+      "outputs": [
+        {
+          "nameModifier": "_720p",
+          "preset": "${aws_media_convert_preset.test.name}"
+        }
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSMediaConvertJobTemplateConfigUpdated(rName string) string {
+	return testAccAWSMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name                   = %[1]q
+  category               = "web"
+  description            = "Web renditions"
+  priority               = 10
+  queue                  = "${aws_media_convert_queue.test.arn}"
+  status_update_interval = "SECONDS_30"
+
+  settings = <<EOF
+{
+  "outputGroups": [
+    {
+      "name": "File Group",
+      "outputGroupSettings": {
+        "type": "FILE_GROUP_SETTINGS",
+        "fileGroupSettings": {
+          "destination": "s3://${aws_s3_bucket.test.bucket}/output/"
+        }
+      },
+      "outputs": [
+        {
+          "nameModifier": "_web",
+          "preset": "${aws_media_convert_preset.test.name}"
+        }
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSMediaConvertJobTemplateConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name  = %[1]q
+  queue = "${aws_media_convert_queue.test.arn}"
+
+  settings = <<EOF
+{
+  "outputGroups": [
+    {
+      "name": "File Group",
+      "outputGroupSettings": {
+        "type": "FILE_GROUP_SETTINGS",
+        "fileGroupSettings": {
+          "destination": "s3://${aws_s3_bucket.test.bucket}/output/"
+        }
+      },
+      "outputs": [
+        {
+          "nameModifier": "_720p",
+          "preset": "${aws_media_convert_preset.test.name}"
+        }
+      ]
+    }
+  ]
+}
+EOF
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSMediaConvertJobTemplateConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name  = %[1]q
+  queue = "${aws_media_convert_queue.test.arn}"
+
+  settings = <<EOF
+{
+  "outputGroups": [
+    {
+      "name": "File Group",
+      "outputGroupSettings": {
+        "type": "FILE_GROUP_SETTINGS",
+        "fileGroupSettings": {
+          "destination": "s3://${aws_s3_bucket.test.bucket}/output/"
+        }
+      },
+      "outputs": [
+        {
+          "nameModifier": "_720p",
+          "preset": "${aws_media_convert_preset.test.name}"
+        }
+      ]
+    }
+  ]
+}
+EOF
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediaconvert/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMediaConvertPreset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertPresetCreate,
+		Read:   resourceAwsMediaConvertPresetRead,
+		Update: resourceAwsMediaConvertPresetUpdate,
+		Delete: resourceAwsMediaConvertPresetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"settings": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := mediaConvertPresetSettingsAreEquivalent(old, new)
+					return equal
+				},
+				ValidateFunc: validateMediaConvertPresetSettings,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func validateMediaConvertPresetSettings(v interface{}, k string) (ws []string, errors []error) {
+	settings, err := expandMediaConvertPresetSettings(v.(string))
+
+	if err == nil {
+		err = settings.Validate()
+	}
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid MediaConvert preset settings: %s", k, err))
+	}
+
+	return
+}
+
+func resourceAwsMediaConvertPresetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	settings, err := expandMediaConvertPresetSettings(d.Get("settings").(string))
+
+	if err != nil {
+		return fmt.Errorf("error expanding MediaConvert Preset settings: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	input := &mediaconvert.CreatePresetInput{
+		Name:     aws.String(name),
+		Settings: settings,
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MediaconvertTags()
+	}
+
+	log.Printf("[DEBUG] Creating MediaConvert Preset: %s", input)
+	_, err = conn.CreatePreset(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaConvert Preset (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsMediaConvertPresetRead(d, meta)
+}
+
+func resourceAwsMediaConvertPresetRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	preset, err := finder.PresetByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConvert Preset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConvert Preset (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(preset.Arn)
+	d.Set("arn", arn)
+	d.Set("category", preset.Category)
+	d.Set("description", preset.Description)
+	d.Set("name", preset.Name)
+
+	settings, err := flattenApiObjectJson(preset.Settings)
+
+	if err != nil {
+		return fmt.Errorf("error flattening MediaConvert Preset (%s) settings: %s", d.Id(), err)
+	}
+
+	d.Set("settings", settings)
+
+	tags, err := keyvaluetags.MediaconvertListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConvert Preset (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertPresetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("category") || d.HasChange("description") || d.HasChange("settings") {
+		input := &mediaconvert.UpdatePresetInput{
+			Category:    aws.String(d.Get("category").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		if d.HasChange("settings") {
+			settings, err := expandMediaConvertPresetSettings(d.Get("settings").(string))
+
+			if err != nil {
+				return fmt.Errorf("error expanding MediaConvert Preset settings: %s", err)
+			}
+
+			input.Settings = settings
+		}
+
+		log.Printf("[DEBUG] Updating MediaConvert Preset: %s", input)
+		_, err := conn.UpdatePreset(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaConvert Preset (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.MediaconvertUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaConvert Preset (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConvertPresetRead(d, meta)
+}
+
+func resourceAwsMediaConvertPresetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConvert Preset: %s", d.Id())
+	_, err = conn.DeletePreset(&mediaconvert.DeletePresetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConvert Preset (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
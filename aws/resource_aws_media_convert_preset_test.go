@@ -0,0 +1,399 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediaconvert/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_media_convert_preset", &sweep.Sweeper{
+		Name: "aws_media_convert_preset",
+		F:    testSweepMediaConvertPresets,
+		Dependencies: []string{
+			"aws_media_convert_job_template",
+		},
+	})
+}
+
+func testSweepMediaConvertPresets(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn, err := client.(*AWSClient).mediaConvertAccountConn()
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaConvert Preset sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	input := &mediaconvert.ListPresetsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListPresets(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaConvert Preset sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaConvert Presets: %s", err))
+			return sweeperErrs
+		}
+
+		for _, preset := range output.Presets {
+			name := aws.StringValue(preset.Name)
+
+			// System presets cannot be deleted.
+			if aws.StringValue(preset.Type) == mediaconvert.TypeSystem {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting MediaConvert Preset: %s", name)
+			_, err := conn.DeletePreset(&mediaconvert.DeletePresetInput{
+				Name: aws.String(name),
+			})
+
+			if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting MediaConvert Preset (%s): %s", name, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaConvertPreset_basic(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConvertPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConvertPresetConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertPresetExists(resourceName, &preset),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", fmt.Sprintf("presets/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "settings"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+			{
+				Config: testAccAWSMediaConvertPresetConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "category", "web"),
+					resource.TestCheckResourceAttr(resourceName, "description", "720p H.264"),
+					testAccCheckAWSMediaConvertPresetWidth(&preset, 1280),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertPreset_disappears(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConvertPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConvertPresetConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertPresetExists(resourceName, &preset),
+					testAccCheckAWSMediaConvertPresetDisappears(&preset),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertPreset_tags(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConvertPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConvertPresetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaConvertPresetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaConvertPresetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaConvertPresetDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_preset" {
+			continue
+		}
+
+		_, err := finder.PresetByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConvert Preset %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMediaConvertPresetDisappears(preset *mediaconvert.Preset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(*AWSClient).mediaConvertAccountConn()
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DeletePreset(&mediaconvert.DeletePresetInput{
+			Name: preset.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSMediaConvertPresetExists(n string, v *mediaconvert.Preset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConvert Preset ID is set")
+		}
+
+		conn, err := testAccProvider.Meta().(*AWSClient).mediaConvertAccountConn()
+
+		if err != nil {
+			return err
+		}
+
+		preset, err := finder.PresetByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *preset
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaConvertPresetWidth(preset *mediaconvert.Preset, width int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if preset.Settings == nil || preset.Settings.VideoDescription == nil {
+			return fmt.Errorf("MediaConvert Preset %s has no video description", aws.StringValue(preset.Name))
+		}
+
+		if v := aws.Int64Value(preset.Settings.VideoDescription.Width); v != width {
+			return fmt.Errorf("expected MediaConvert Preset %s width %d, got %d", aws.StringValue(preset.Name), width, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSMediaConvertPresetConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = <<EOF
+{
+  "containerSettings": {
+    "container": "MP4"
+  },
+  "videoDescription": {
+    "codecSettings": {
+      "codec": "H_264",
+      "h264Settings": {
+        "maxBitrate": 5000000,
+        "rateControlMode": "QVBR"
+      }
+    }
+  }
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSMediaConvertPresetConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name        = %[1]q
+  category    = "web"
+  description = "720p H.264"
+
+  settings = <<EOF
+{
+  "containerSettings": {
+    "container": "MP4"
+  },
+  "videoDescription": {
+    "codecSettings": {
+      "codec": "H_264",
+      "h264Settings": {
+        "maxBitrate": 3000000,
+        "rateControlMode": "QVBR"
+      }
+    },
+    "height": 720,
+    "width": 1280
+  }
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSMediaConvertPresetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = <<EOF
+{
+  "containerSettings": {
+    "container": "MP4"
+  },
+  "videoDescription": {
+    "codecSettings": {
+      "codec": "H_264",
+      "h264Settings": {
+        "maxBitrate": 5000000,
+        "rateControlMode": "QVBR"
+      }
+    }
+  }
+}
+EOF
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSMediaConvertPresetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = <<EOF
+{
+  "containerSettings": {
+    "container": "MP4"
+  },
+  "videoDescription": {
+    "codecSettings": {
+      "codec": "H_264",
+      "h264Settings": {
+        "maxBitrate": 5000000,
+        "rateControlMode": "QVBR"
+      }
+    }
+  }
+}
+EOF
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediaconvert/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMediaConvertQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertQueueCreate,
+		Read:   resourceAwsMediaConvertQueueRead,
+		Update: resourceAwsMediaConvertQueueUpdate,
+		Delete: resourceAwsMediaConvertQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"pricing_plan": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  mediaconvert.PricingPlanOnDemand,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.PricingPlanOnDemand,
+					mediaconvert.PricingPlanReserved,
+				}, false),
+			},
+			"reservation_plan_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"commitment": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.CommitmentOneYear,
+							}, false),
+						},
+						"renewal_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.RenewalTypeAutoRenew,
+								mediaconvert.RenewalTypeExpire,
+							}, false),
+						},
+						"reserved_slots": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  mediaconvert.QueueStatusActive,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.QueueStatusActive,
+					mediaconvert.QueueStatusPaused,
+				}, false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMediaConvertQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	input := &mediaconvert.CreateQueueInput{
+		Name:        aws.String(name),
+		PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+		Status:      aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reservation_plan_settings"); ok {
+		input.ReservationPlanSettings = expandMediaConvertReservationPlanSettings(v.([]interface{}))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MediaconvertTags()
+	}
+
+	log.Printf("[DEBUG] Creating MediaConvert Queue: %s", input)
+	_, err = conn.CreateQueue(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaConvert Queue (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsMediaConvertQueueRead(d, meta)
+}
+
+func resourceAwsMediaConvertQueueRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	queue, err := finder.QueueByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConvert Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConvert Queue (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(queue.Arn)
+	d.Set("arn", arn)
+	d.Set("description", queue.Description)
+	d.Set("name", queue.Name)
+	d.Set("pricing_plan", queue.PricingPlan)
+	d.Set("status", queue.Status)
+
+	if err := d.Set("reservation_plan_settings", flattenMediaConvertReservationPlan(queue.ReservationPlan)); err != nil {
+		return fmt.Errorf("error setting reservation_plan_settings: %s", err)
+	}
+
+	tags, err := keyvaluetags.MediaconvertListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConvert Queue (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("description") || d.HasChange("reservation_plan_settings") || d.HasChange("status") {
+		input := &mediaconvert.UpdateQueueInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+			Status:      aws.String(d.Get("status").(string)),
+		}
+
+		if d.HasChange("reservation_plan_settings") {
+			input.ReservationPlanSettings = expandMediaConvertReservationPlanSettings(d.Get("reservation_plan_settings").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaConvert Queue: %s", input)
+		_, err := conn.UpdateQueue(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaConvert Queue (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.MediaconvertUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaConvert Queue (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConvertQueueRead(d, meta)
+}
+
+func resourceAwsMediaConvertQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := meta.(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConvert Queue: %s", d.Id())
+	_, err = conn.DeleteQueue(&mediaconvert.DeleteQueueInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConvert Queue (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaConvertReservationPlanSettings(tfList []interface{}) *mediaconvert.ReservationPlanSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &mediaconvert.ReservationPlanSettings{
+		Commitment:    aws.String(tfMap["commitment"].(string)),
+		RenewalType:   aws.String(tfMap["renewal_type"].(string)),
+		ReservedSlots: aws.Int64(int64(tfMap["reserved_slots"].(int))),
+	}
+}
+
+func flattenMediaConvertReservationPlan(apiObject *mediaconvert.ReservationPlan) []interface{} {
+	if apiObject == nil || apiObject.Commitment == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"commitment":     aws.StringValue(apiObject.Commitment),
+			"renewal_type":   aws.StringValue(apiObject.RenewalType),
+			"reserved_slots": int(aws.Int64Value(apiObject.ReservedSlots)),
+		},
+	}
+}
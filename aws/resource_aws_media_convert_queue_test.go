@@ -0,0 +1,322 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediaconvert/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_media_convert_queue", &sweep.Sweeper{
+		Name: "aws_media_convert_queue",
+		F:    testSweepMediaConvertQueues,
+		Dependencies: []string{
+			"aws_media_convert_job_template",
+		},
+	})
+}
+
+func testSweepMediaConvertQueues(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn, err := client.(*AWSClient).mediaConvertAccountConn()
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaConvert Queue sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	input := &mediaconvert.ListQueuesInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListQueues(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaConvert Queue sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaConvert Queues: %s", err))
+			return sweeperErrs
+		}
+
+		for _, queue := range output.Queues {
+			name := aws.StringValue(queue.Name)
+
+			// The default queue cannot be deleted.
+			if aws.StringValue(queue.Type) == mediaconvert.TypeSystem {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting MediaConvert Queue: %s", name)
+			_, err := conn.DeleteQueue(&mediaconvert.DeleteQueueInput{
+				Name: aws.String(name),
+			})
+
+			if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting MediaConvert Queue (%s): %s", name, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaConvertQueue_basic(t *testing.T) {
+	var queue mediaconvert.Queue
+	resourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConvertQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConvertQueueConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertQueueExists(resourceName, &queue),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", fmt.Sprintf("queues/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pricing_plan", mediaconvert.PricingPlanOnDemand),
+					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconvert.QueueStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaConvertQueueConfigUpdated(rName, "Paused queue", mediaconvert.QueueStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "description", "Paused queue"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconvert.QueueStatusPaused),
+				),
+			},
+			{
+				Config: testAccAWSMediaConvertQueueConfigUpdated(rName, "Active queue", mediaconvert.QueueStatusActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "description", "Active queue"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconvert.QueueStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertQueue_disappears(t *testing.T) {
+	var queue mediaconvert.Queue
+	resourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConvertQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConvertQueueConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertQueueExists(resourceName, &queue),
+					testAccCheckAWSMediaConvertQueueDisappears(&queue),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertQueue_tags(t *testing.T) {
+	var queue mediaconvert.Queue
+	resourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConvertQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConvertQueueConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaConvertQueueConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaConvertQueueConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaConvertQueueDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(*AWSClient).mediaConvertAccountConn()
+
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_queue" {
+			continue
+		}
+
+		_, err := finder.QueueByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConvert Queue %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMediaConvertQueueDisappears(queue *mediaconvert.Queue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(*AWSClient).mediaConvertAccountConn()
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DeleteQueue(&mediaconvert.DeleteQueueInput{
+			Name: queue.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSMediaConvertQueueExists(n string, v *mediaconvert.Queue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConvert Queue ID is set")
+		}
+
+		conn, err := testAccProvider.Meta().(*AWSClient).mediaConvertAccountConn()
+
+		if err != nil {
+			return err
+		}
+
+		queue, err := finder.QueueByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *queue
+
+		return nil
+	}
+}
+
+func testAccAWSMediaConvertQueueConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSMediaConvertQueueConfigUpdated(rName, description, status string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name        = %[1]q
+  description = %[2]q
+  status      = %[3]q
+}
+`, rName, description, status)
+}
+
+func testAccAWSMediaConvertQueueConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSMediaConvertQueueConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
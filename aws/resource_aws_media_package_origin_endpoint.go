@@ -0,0 +1,1153 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediapackage/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+var mediaPackageOriginEndpointPackages = []string{
+	"cmaf_package",
+	"dash_package",
+	"hls_package",
+	"mss_package",
+}
+
+func resourceAwsMediaPackageOriginEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaPackageOriginEndpointCreate,
+		Read:   resourceAwsMediaPackageOriginEndpointRead,
+		Update: resourceAwsMediaPackageOriginEndpointUpdate,
+		Delete: resourceAwsMediaPackageOriginEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cmaf_package": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: mediaPackageOriginEndpointConflictingPackages("cmaf_package"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_rotation_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"speke_key_provider": mediaPackageOriginEndpointSpekeKeyProviderSchema(),
+								},
+							},
+						},
+						"hls_manifest": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ad_markers": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validateMediaPackageAdMarkers,
+									},
+									"id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateMediaPackageOriginEndpointID,
+									},
+									"include_iframe_only_stream": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"manifest_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"playlist_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validateMediaPackagePlaylistType,
+									},
+									"playlist_window_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"program_date_time_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"segment_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_selection": mediaPackageOriginEndpointStreamSelectionSchema(),
+					},
+				},
+			},
+			"dash_package": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: mediaPackageOriginEndpointConflictingPackages("dash_package"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_triggers":                  mediaPackageOriginEndpointAdTriggersSchema(),
+						"ads_on_delivery_restrictions": mediaPackageOriginEndpointAdsOnDeliveryRestrictionsSchema(),
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_rotation_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"speke_key_provider": mediaPackageOriginEndpointSpekeKeyProviderSchema(),
+								},
+							},
+						},
+						"manifest_layout": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediapackage.ManifestLayoutCompact,
+								mediapackage.ManifestLayoutFull,
+							}, false),
+						},
+						"manifest_window_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_buffer_time_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_update_period_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"period_triggers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"ADS"}, false),
+							},
+						},
+						"profile": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediapackage.ProfileHbbtv15,
+								mediapackage.ProfileNone,
+							}, false),
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"segment_template_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediapackage.SegmentTemplateFormatNumberWithDuration,
+								mediapackage.SegmentTemplateFormatNumberWithTimeline,
+								mediapackage.SegmentTemplateFormatTimeWithTimeline,
+							}, false),
+						},
+						"stream_selection": mediaPackageOriginEndpointStreamSelectionSchema(),
+						"suggested_presentation_delay_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMediaPackageOriginEndpointID,
+			},
+			"hls_package": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: mediaPackageOriginEndpointConflictingPackages("hls_package"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_markers": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateMediaPackageAdMarkers,
+						},
+						"ad_triggers":                  mediaPackageOriginEndpointAdTriggersSchema(),
+						"ads_on_delivery_restrictions": mediaPackageOriginEndpointAdsOnDeliveryRestrictionsSchema(),
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"constant_initialization_vector": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"encryption_method": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											mediapackage.EncryptionMethodAes128,
+											mediapackage.EncryptionMethodSampleAes,
+										}, false),
+									},
+									"key_rotation_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"repeat_ext_x_key": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"speke_key_provider": mediaPackageOriginEndpointSpekeKeyProviderSchema(),
+								},
+							},
+						},
+						"include_iframe_only_stream": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"playlist_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateMediaPackagePlaylistType,
+						},
+						"playlist_window_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"program_date_time_interval_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_selection": mediaPackageOriginEndpointStreamSelectionSchema(),
+						"use_audio_rendition_group": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"manifest_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"mss_package": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: mediaPackageOriginEndpointConflictingPackages("mss_package"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"speke_key_provider": mediaPackageOriginEndpointSpekeKeyProviderSchema(),
+								},
+							},
+						},
+						"manifest_window_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_selection": mediaPackageOriginEndpointStreamSelectionSchema(),
+					},
+				},
+			},
+			"startover_window_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"time_delay_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 86400),
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"whitelist": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDRNetworkAddress,
+				},
+			},
+		},
+	}
+}
+
+var validateMediaPackageOriginEndpointID = validation.StringMatch(regexp.MustCompile(`^[\w-]+$`), "must only contain alphanumeric characters, dashes or underscores")
+
+var validateMediaPackageAdMarkers = validation.StringInSlice([]string{
+	mediapackage.AdMarkersNone,
+	mediapackage.AdMarkersPassthrough,
+	mediapackage.AdMarkersScte35Enhanced,
+}, false)
+
+var validateMediaPackagePlaylistType = validation.StringInSlice([]string{
+	mediapackage.PlaylistTypeEvent,
+	mediapackage.PlaylistTypeNone,
+	mediapackage.PlaylistTypeVod,
+}, false)
+
+func mediaPackageOriginEndpointConflictingPackages(packageType string) []string {
+	var conflicts []string
+
+	for _, v := range mediaPackageOriginEndpointPackages {
+		if v != packageType {
+			conflicts = append(conflicts, v)
+		}
+	}
+
+	return conflicts
+}
+
+func mediaPackageOriginEndpointAdTriggersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				"BREAK",
+				"DISTRIBUTOR_ADVERTISEMENT",
+				"DISTRIBUTOR_OVERLAY_PLACEMENT_OPPORTUNITY",
+				"DISTRIBUTOR_PLACEMENT_OPPORTUNITY",
+				"PROVIDER_ADVERTISEMENT",
+				"PROVIDER_OVERLAY_PLACEMENT_OPPORTUNITY",
+				"PROVIDER_PLACEMENT_OPPORTUNITY",
+				"SPLICE_INSERT",
+			}, false),
+		},
+	}
+}
+
+func mediaPackageOriginEndpointAdsOnDeliveryRestrictionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			mediapackage.AdsOnDeliveryRestrictionsBoth,
+			mediapackage.AdsOnDeliveryRestrictionsNone,
+			mediapackage.AdsOnDeliveryRestrictionsRestricted,
+			mediapackage.AdsOnDeliveryRestrictionsUnrestricted,
+		}, false),
+	}
+}
+
+func mediaPackageOriginEndpointSpekeKeyProviderSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateArn,
+				},
+				"resource_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+				"system_ids": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func mediaPackageOriginEndpointStreamSelectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_video_bits_per_second": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"min_video_bits_per_second": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"stream_order": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						mediapackage.StreamOrderOriginal,
+						mediapackage.StreamOrderVideoBitrateAscending,
+						mediapackage.StreamOrderVideoBitrateDescending,
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaPackageOriginEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	endpointID := d.Get("endpoint_id").(string)
+	input := &mediapackage.CreateOriginEndpointInput{
+		ChannelId:   aws.String(d.Get("channel_id").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		Id:          aws.String(endpointID),
+	}
+
+	if v, ok := d.GetOk("cmaf_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CmafPackage = expandMediaPackageCmafPackageCreateOrUpdateParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("dash_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DashPackage = expandMediaPackageDashPackage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("hls_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.HlsPackage = expandMediaPackageHlsPackage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_name"); ok {
+		input.ManifestName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("mss_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MssPackage = expandMediaPackageMssPackage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("startover_window_seconds"); ok {
+		input.StartoverWindowSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MediapackageTags()
+	}
+
+	if v, ok := d.GetOk("time_delay_seconds"); ok {
+		input.TimeDelaySeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("whitelist"); ok && v.(*schema.Set).Len() > 0 {
+		input.Whitelist = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating MediaPackage Origin Endpoint: %s", input)
+	_, err := conn.CreateOriginEndpoint(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaPackage Origin Endpoint (%s): %s", endpointID, err)
+	}
+
+	d.SetId(endpointID)
+
+	return resourceAwsMediaPackageOriginEndpointRead(d, meta)
+}
+
+func resourceAwsMediaPackageOriginEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	endpoint, err := finder.OriginEndpointByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaPackage Origin Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(endpoint.Arn)
+	d.Set("arn", arn)
+	d.Set("channel_id", endpoint.ChannelId)
+
+	if err := d.Set("cmaf_package", flattenMediaPackageCmafPackage(endpoint.CmafPackage)); err != nil {
+		return fmt.Errorf("error setting cmaf_package: %s", err)
+	}
+
+	if err := d.Set("dash_package", flattenMediaPackageDashPackage(endpoint.DashPackage)); err != nil {
+		return fmt.Errorf("error setting dash_package: %s", err)
+	}
+
+	d.Set("description", endpoint.Description)
+	d.Set("endpoint_id", endpoint.Id)
+
+	if err := d.Set("hls_package", flattenMediaPackageHlsPackage(endpoint.HlsPackage)); err != nil {
+		return fmt.Errorf("error setting hls_package: %s", err)
+	}
+
+	d.Set("manifest_name", endpoint.ManifestName)
+
+	if err := d.Set("mss_package", flattenMediaPackageMssPackage(endpoint.MssPackage)); err != nil {
+		return fmt.Errorf("error setting mss_package: %s", err)
+	}
+
+	d.Set("startover_window_seconds", endpoint.StartoverWindowSeconds)
+	d.Set("time_delay_seconds", endpoint.TimeDelaySeconds)
+	d.Set("url", endpoint.Url)
+
+	if err := d.Set("whitelist", aws.StringValueSlice(endpoint.Whitelist)); err != nil {
+		return fmt.Errorf("error setting whitelist: %s", err)
+	}
+
+	tags, err := keyvaluetags.MediapackageListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaPackageOriginEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	if d.HasChange("cmaf_package") || d.HasChange("dash_package") || d.HasChange("description") || d.HasChange("hls_package") ||
+		d.HasChange("manifest_name") || d.HasChange("mss_package") || d.HasChange("startover_window_seconds") ||
+		d.HasChange("time_delay_seconds") || d.HasChange("whitelist") {
+		input := &mediapackage.UpdateOriginEndpointInput{
+			Description:            aws.String(d.Get("description").(string)),
+			Id:                     aws.String(d.Id()),
+			StartoverWindowSeconds: aws.Int64(int64(d.Get("startover_window_seconds").(int))),
+			TimeDelaySeconds:       aws.Int64(int64(d.Get("time_delay_seconds").(int))),
+			Whitelist:              expandStringSet(d.Get("whitelist").(*schema.Set)),
+		}
+
+		if v, ok := d.GetOk("cmaf_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.CmafPackage = expandMediaPackageCmafPackageCreateOrUpdateParameters(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("dash_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DashPackage = expandMediaPackageDashPackage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("hls_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.HlsPackage = expandMediaPackageHlsPackage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("manifest_name"); ok {
+			input.ManifestName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("mss_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MssPackage = expandMediaPackageMssPackage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaPackage Origin Endpoint: %s", input)
+		_, err := conn.UpdateOriginEndpoint(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.MediapackageUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaPackage Origin Endpoint (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaPackageOriginEndpointRead(d, meta)
+}
+
+func resourceAwsMediaPackageOriginEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediapackageconn
+
+	log.Printf("[DEBUG] Deleting MediaPackage Origin Endpoint: %s", d.Id())
+	_, err := conn.DeleteOriginEndpoint(&mediapackage.DeleteOriginEndpointInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediapackage.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaPackageCmafPackageCreateOrUpdateParameters(tfMap map[string]interface{}) *mediapackage.CmafPackageCreateOrUpdateParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.CmafPackageCreateOrUpdateParameters{}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		encryption := &mediapackage.CmafEncryption{
+			SpekeKeyProvider: expandMediaPackageSpekeKeyProvider(tfMap["speke_key_provider"].([]interface{})),
+		}
+
+		if v, ok := tfMap["key_rotation_interval_seconds"].(int); ok && v != 0 {
+			encryption.KeyRotationIntervalSeconds = aws.Int64(int64(v))
+		}
+
+		apiObject.Encryption = encryption
+	}
+
+	if v, ok := tfMap["hls_manifest"].([]interface{}); ok && len(v) > 0 {
+		apiObject.HlsManifests = expandMediaPackageHlsManifestCreateOrUpdateParameters(v)
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_prefix"].(string); ok && v != "" {
+		apiObject.SegmentPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_selection"].([]interface{}); ok {
+		apiObject.StreamSelection = expandMediaPackageStreamSelection(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaPackageHlsManifestCreateOrUpdateParameters(tfList []interface{}) []*mediapackage.HlsManifestCreateOrUpdateParameters {
+	var apiObjects []*mediapackage.HlsManifestCreateOrUpdateParameters
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediapackage.HlsManifestCreateOrUpdateParameters{
+			Id:                      aws.String(tfMap["id"].(string)),
+			IncludeIframeOnlyStream: aws.Bool(tfMap["include_iframe_only_stream"].(bool)),
+		}
+
+		if v, ok := tfMap["ad_markers"].(string); ok && v != "" {
+			apiObject.AdMarkers = aws.String(v)
+		}
+
+		if v, ok := tfMap["manifest_name"].(string); ok && v != "" {
+			apiObject.ManifestName = aws.String(v)
+		}
+
+		if v, ok := tfMap["playlist_type"].(string); ok && v != "" {
+			apiObject.PlaylistType = aws.String(v)
+		}
+
+		if v, ok := tfMap["playlist_window_seconds"].(int); ok && v != 0 {
+			apiObject.PlaylistWindowSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["program_date_time_interval_seconds"].(int); ok && v != 0 {
+			apiObject.ProgramDateTimeIntervalSeconds = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaPackageDashPackage(tfMap map[string]interface{}) *mediapackage.DashPackage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.DashPackage{}
+
+	if v, ok := tfMap["ad_triggers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AdTriggers = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["ads_on_delivery_restrictions"].(string); ok && v != "" {
+		apiObject.AdsOnDeliveryRestrictions = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		encryption := &mediapackage.DashEncryption{
+			SpekeKeyProvider: expandMediaPackageSpekeKeyProvider(tfMap["speke_key_provider"].([]interface{})),
+		}
+
+		if v, ok := tfMap["key_rotation_interval_seconds"].(int); ok && v != 0 {
+			encryption.KeyRotationIntervalSeconds = aws.Int64(int64(v))
+		}
+
+		apiObject.Encryption = encryption
+	}
+
+	if v, ok := tfMap["manifest_layout"].(string); ok && v != "" {
+		apiObject.ManifestLayout = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+		apiObject.ManifestWindowSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_buffer_time_seconds"].(int); ok && v != 0 {
+		apiObject.MinBufferTimeSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_update_period_seconds"].(int); ok && v != 0 {
+		apiObject.MinUpdatePeriodSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["period_triggers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PeriodTriggers = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["profile"].(string); ok && v != "" {
+		apiObject.Profile = aws.String(v)
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_template_format"].(string); ok && v != "" {
+		apiObject.SegmentTemplateFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_selection"].([]interface{}); ok {
+		apiObject.StreamSelection = expandMediaPackageStreamSelection(v)
+	}
+
+	if v, ok := tfMap["suggested_presentation_delay_seconds"].(int); ok && v != 0 {
+		apiObject.SuggestedPresentationDelaySeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandMediaPackageHlsPackage(tfMap map[string]interface{}) *mediapackage.HlsPackage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.HlsPackage{
+		IncludeIframeOnlyStream: aws.Bool(tfMap["include_iframe_only_stream"].(bool)),
+		UseAudioRenditionGroup:  aws.Bool(tfMap["use_audio_rendition_group"].(bool)),
+	}
+
+	if v, ok := tfMap["ad_markers"].(string); ok && v != "" {
+		apiObject.AdMarkers = aws.String(v)
+	}
+
+	if v, ok := tfMap["ad_triggers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AdTriggers = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["ads_on_delivery_restrictions"].(string); ok && v != "" {
+		apiObject.AdsOnDeliveryRestrictions = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		encryption := &mediapackage.HlsEncryption{
+			RepeatExtXKey:    aws.Bool(tfMap["repeat_ext_x_key"].(bool)),
+			SpekeKeyProvider: expandMediaPackageSpekeKeyProvider(tfMap["speke_key_provider"].([]interface{})),
+		}
+
+		if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+			encryption.ConstantInitializationVector = aws.String(v)
+		}
+
+		if v, ok := tfMap["encryption_method"].(string); ok && v != "" {
+			encryption.EncryptionMethod = aws.String(v)
+		}
+
+		if v, ok := tfMap["key_rotation_interval_seconds"].(int); ok && v != 0 {
+			encryption.KeyRotationIntervalSeconds = aws.Int64(int64(v))
+		}
+
+		apiObject.Encryption = encryption
+	}
+
+	if v, ok := tfMap["playlist_type"].(string); ok && v != "" {
+		apiObject.PlaylistType = aws.String(v)
+	}
+
+	if v, ok := tfMap["playlist_window_seconds"].(int); ok && v != 0 {
+		apiObject.PlaylistWindowSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["program_date_time_interval_seconds"].(int); ok && v != 0 {
+		apiObject.ProgramDateTimeIntervalSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_selection"].([]interface{}); ok {
+		apiObject.StreamSelection = expandMediaPackageStreamSelection(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaPackageMssPackage(tfMap map[string]interface{}) *mediapackage.MssPackage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.MssPackage{}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Encryption = &mediapackage.MssEncryption{
+			SpekeKeyProvider: expandMediaPackageSpekeKeyProvider(tfMap["speke_key_provider"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+		apiObject.ManifestWindowSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_selection"].([]interface{}); ok {
+		apiObject.StreamSelection = expandMediaPackageStreamSelection(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaPackageSpekeKeyProvider(tfList []interface{}) *mediapackage.SpekeKeyProvider {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &mediapackage.SpekeKeyProvider{
+		ResourceId: aws.String(tfMap["resource_id"].(string)),
+		RoleArn:    aws.String(tfMap["role_arn"].(string)),
+		SystemIds:  expandStringSet(tfMap["system_ids"].(*schema.Set)),
+		Url:        aws.String(tfMap["url"].(string)),
+	}
+
+	if v, ok := tfMap["certificate_arn"].(string); ok && v != "" {
+		apiObject.CertificateArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaPackageStreamSelection(tfList []interface{}) *mediapackage.StreamSelection {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &mediapackage.StreamSelection{}
+
+	if v, ok := tfMap["max_video_bits_per_second"].(int); ok && v != 0 {
+		apiObject.MaxVideoBitsPerSecond = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_video_bits_per_second"].(int); ok && v != 0 {
+		apiObject.MinVideoBitsPerSecond = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_order"].(string); ok && v != "" {
+		apiObject.StreamOrder = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMediaPackageCmafPackage(apiObject *mediapackage.CmafPackage) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"hls_manifest":             flattenMediaPackageHlsManifests(apiObject.HlsManifests),
+		"segment_duration_seconds": aws.Int64Value(apiObject.SegmentDurationSeconds),
+		"segment_prefix":           aws.StringValue(apiObject.SegmentPrefix),
+		"stream_selection":         flattenMediaPackageStreamSelection(apiObject.StreamSelection),
+	}
+
+	if v := apiObject.Encryption; v != nil {
+		tfMap["encryption"] = []interface{}{
+			map[string]interface{}{
+				"key_rotation_interval_seconds": aws.Int64Value(v.KeyRotationIntervalSeconds),
+				"speke_key_provider":            flattenMediaPackageSpekeKeyProvider(v.SpekeKeyProvider),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMediaPackageHlsManifests(apiObjects []*mediapackage.HlsManifest) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"ad_markers":                         aws.StringValue(apiObject.AdMarkers),
+			"id":                                 aws.StringValue(apiObject.Id),
+			"include_iframe_only_stream":         aws.BoolValue(apiObject.IncludeIframeOnlyStream),
+			"manifest_name":                      aws.StringValue(apiObject.ManifestName),
+			"playlist_type":                      aws.StringValue(apiObject.PlaylistType),
+			"playlist_window_seconds":            aws.Int64Value(apiObject.PlaylistWindowSeconds),
+			"program_date_time_interval_seconds": aws.Int64Value(apiObject.ProgramDateTimeIntervalSeconds),
+			"url":                                aws.StringValue(apiObject.Url),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMediaPackageDashPackage(apiObject *mediapackage.DashPackage) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"ad_triggers":                          aws.StringValueSlice(apiObject.AdTriggers),
+		"ads_on_delivery_restrictions":         aws.StringValue(apiObject.AdsOnDeliveryRestrictions),
+		"manifest_layout":                      aws.StringValue(apiObject.ManifestLayout),
+		"manifest_window_seconds":              aws.Int64Value(apiObject.ManifestWindowSeconds),
+		"min_buffer_time_seconds":              aws.Int64Value(apiObject.MinBufferTimeSeconds),
+		"min_update_period_seconds":            aws.Int64Value(apiObject.MinUpdatePeriodSeconds),
+		"period_triggers":                      aws.StringValueSlice(apiObject.PeriodTriggers),
+		"profile":                              aws.StringValue(apiObject.Profile),
+		"segment_duration_seconds":             aws.Int64Value(apiObject.SegmentDurationSeconds),
+		"segment_template_format":              aws.StringValue(apiObject.SegmentTemplateFormat),
+		"stream_selection":                     flattenMediaPackageStreamSelection(apiObject.StreamSelection),
+		"suggested_presentation_delay_seconds": aws.Int64Value(apiObject.SuggestedPresentationDelaySeconds),
+	}
+
+	if v := apiObject.Encryption; v != nil {
+		tfMap["encryption"] = []interface{}{
+			map[string]interface{}{
+				"key_rotation_interval_seconds": aws.Int64Value(v.KeyRotationIntervalSeconds),
+				"speke_key_provider":            flattenMediaPackageSpekeKeyProvider(v.SpekeKeyProvider),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMediaPackageHlsPackage(apiObject *mediapackage.HlsPackage) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"ad_markers":                         aws.StringValue(apiObject.AdMarkers),
+		"ad_triggers":                        aws.StringValueSlice(apiObject.AdTriggers),
+		"ads_on_delivery_restrictions":       aws.StringValue(apiObject.AdsOnDeliveryRestrictions),
+		"include_iframe_only_stream":         aws.BoolValue(apiObject.IncludeIframeOnlyStream),
+		"playlist_type":                      aws.StringValue(apiObject.PlaylistType),
+		"playlist_window_seconds":            aws.Int64Value(apiObject.PlaylistWindowSeconds),
+		"program_date_time_interval_seconds": aws.Int64Value(apiObject.ProgramDateTimeIntervalSeconds),
+		"segment_duration_seconds":           aws.Int64Value(apiObject.SegmentDurationSeconds),
+		"stream_selection":                   flattenMediaPackageStreamSelection(apiObject.StreamSelection),
+		"use_audio_rendition_group":          aws.BoolValue(apiObject.UseAudioRenditionGroup),
+	}
+
+	if v := apiObject.Encryption; v != nil {
+		tfMap["encryption"] = []interface{}{
+			map[string]interface{}{
+				"constant_initialization_vector": aws.StringValue(v.ConstantInitializationVector),
+				"encryption_method":              aws.StringValue(v.EncryptionMethod),
+				"key_rotation_interval_seconds":  aws.Int64Value(v.KeyRotationIntervalSeconds),
+				"repeat_ext_x_key":               aws.BoolValue(v.RepeatExtXKey),
+				"speke_key_provider":             flattenMediaPackageSpekeKeyProvider(v.SpekeKeyProvider),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMediaPackageMssPackage(apiObject *mediapackage.MssPackage) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"manifest_window_seconds":  aws.Int64Value(apiObject.ManifestWindowSeconds),
+		"segment_duration_seconds": aws.Int64Value(apiObject.SegmentDurationSeconds),
+		"stream_selection":         flattenMediaPackageStreamSelection(apiObject.StreamSelection),
+	}
+
+	if v := apiObject.Encryption; v != nil {
+		tfMap["encryption"] = []interface{}{
+			map[string]interface{}{
+				"speke_key_provider": flattenMediaPackageSpekeKeyProvider(v.SpekeKeyProvider),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMediaPackageSpekeKeyProvider(apiObject *mediapackage.SpekeKeyProvider) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_arn": aws.StringValue(apiObject.CertificateArn),
+			"resource_id":     aws.StringValue(apiObject.ResourceId),
+			"role_arn":        aws.StringValue(apiObject.RoleArn),
+			"system_ids":      aws.StringValueSlice(apiObject.SystemIds),
+			"url":             aws.StringValue(apiObject.Url),
+		},
+	}
+}
+
+func flattenMediaPackageStreamSelection(apiObject *mediapackage.StreamSelection) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_video_bits_per_second": aws.Int64Value(apiObject.MaxVideoBitsPerSecond),
+			"min_video_bits_per_second": aws.Int64Value(apiObject.MinVideoBitsPerSecond),
+			"stream_order":              aws.StringValue(apiObject.StreamOrder),
+		},
+	}
+}
@@ -0,0 +1,424 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediapackage/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_media_package_origin_endpoint", &sweep.Sweeper{
+		Name: "aws_media_package_origin_endpoint",
+		F:    testSweepMediaPackageOriginEndpoints,
+	})
+}
+
+func testSweepMediaPackageOriginEndpoints(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).mediapackageconn
+	input := &mediapackage.ListOriginEndpointsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListOriginEndpoints(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaPackage Origin Endpoint sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaPackage Origin Endpoints: %s", err))
+			return sweeperErrs
+		}
+
+		for _, endpoint := range output.OriginEndpoints {
+			id := aws.StringValue(endpoint.Id)
+
+			log.Printf("[INFO] Deleting MediaPackage Origin Endpoint: %s", id)
+			_, err := conn.DeleteOriginEndpoint(&mediapackage.DeleteOriginEndpointInput{
+				Id: aws.String(id),
+			})
+
+			if isAWSErr(err, mediapackage.ErrCodeNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting MediaPackage Origin Endpoint (%s): %s", id, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaPackageOriginEndpoint_basic(t *testing.T) {
+	var endpoint mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	channelResourceName := "aws_media_package_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaPackage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaPackageOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfigHlsPackage(rName, "description1", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "mediapackage", regexp.MustCompile(`origin_endpoints/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", channelResourceName, "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.ad_markers", mediapackage.AdMarkersNone),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.include_iframe_only_stream", "false"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.playlist_type", mediapackage.PlaylistTypeEvent),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.playlist_window_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.segment_duration_seconds", "6"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.stream_selection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.stream_selection.0.stream_order", mediapackage.StreamOrderVideoBitrateAscending),
+					resource.TestCheckResourceAttr(resourceName, "manifest_name", "index"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "time_delay_seconds", "0"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(`^https://.+/index\.m3u8$`)),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfigHlsPackage(rName, "description2", 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "time_delay_seconds", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaPackageOriginEndpoint_CmafPackage(t *testing.T) {
+	var endpoint mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaPackage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaPackageOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfigCmafPackage(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.0.id", "hls"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifest.0.manifest_name", "main"),
+					resource.TestMatchResourceAttr(resourceName, "cmaf_package.0.hls_manifest.0.url", regexp.MustCompile(`^https://.+/main\.m3u8$`)),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.segment_duration_seconds", "4"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaPackageOriginEndpoint_DashPackage(t *testing.T) {
+	var endpoint mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaPackage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaPackageOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfigDashPackage(rName, mediapackage.ProfileNone),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.manifest_window_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.profile", mediapackage.ProfileNone),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.segment_template_format", mediapackage.SegmentTemplateFormatNumberWithTimeline),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(`^https://.+/index\.mpd$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfigDashPackage(rName, mediapackage.ProfileHbbtv15),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.profile", mediapackage.ProfileHbbtv15),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaPackageOriginEndpoint_disappears(t *testing.T) {
+	var endpoint mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaPackage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaPackageOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfigHlsPackage(rName, "description1", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					testAccCheckAWSMediaPackageOriginEndpointDisappears(&endpoint),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaPackageOriginEndpoint_tags(t *testing.T) {
+	var endpoint mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaPackage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaPackageOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaPackageOriginEndpointConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaPackageOriginEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaPackageOriginEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_package_origin_endpoint" {
+			continue
+		}
+
+		_, err := finder.OriginEndpointByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaPackage Origin Endpoint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMediaPackageOriginEndpointDisappears(endpoint *mediapackage.DescribeOriginEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+
+		_, err := conn.DeleteOriginEndpoint(&mediapackage.DeleteOriginEndpointInput{
+			Id: endpoint.Id,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSMediaPackageOriginEndpointExists(n string, v *mediapackage.DescribeOriginEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaPackage Origin Endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+
+		endpoint, err := finder.OriginEndpointByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *endpoint
+
+		return nil
+	}
+}
+
+func testAccAWSMediaPackageOriginEndpointConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_package_channel" "test" {
+  channel_id = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSMediaPackageOriginEndpointConfigHlsPackage(rName, description string, timeDelaySeconds int) string {
+	return testAccAWSMediaPackageOriginEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id         = "${aws_media_package_channel.test.channel_id}"
+  endpoint_id        = %[1]q
+  description        = %[2]q
+  time_delay_seconds = %[3]d
+
+  hls_package {
+    ad_markers               = "NONE"
+    playlist_type            = "EVENT"
+    playlist_window_seconds  = 60
+    segment_duration_seconds = 6
+
+    stream_selection {
+      stream_order = "VIDEO_BITRATE_ASCENDING"
+    }
+  }
+}
+`, rName, description, timeDelaySeconds)
+}
+
+func testAccAWSMediaPackageOriginEndpointConfigCmafPackage(rName string) string {
+	return testAccAWSMediaPackageOriginEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = "${aws_media_package_channel.test.channel_id}"
+  endpoint_id = %[1]q
+
+  cmaf_package {
+    segment_duration_seconds = 4
+
+    hls_manifest {
+      id            = "hls"
+      manifest_name = "main"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSMediaPackageOriginEndpointConfigDashPackage(rName, profile string) string {
+	return testAccAWSMediaPackageOriginEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = "${aws_media_package_channel.test.channel_id}"
+  endpoint_id = %[1]q
+
+  dash_package {
+    manifest_window_seconds = 60
+    profile                 = %[2]q
+    segment_template_format = "NUMBER_WITH_TIMELINE"
+  }
+}
+`, rName, profile)
+}
+
+func testAccAWSMediaPackageOriginEndpointConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSMediaPackageOriginEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = "${aws_media_package_channel.test.channel_id}"
+  endpoint_id = %[1]q
+
+  hls_package {
+    segment_duration_seconds = 6
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSMediaPackageOriginEndpointConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSMediaPackageOriginEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = "${aws_media_package_channel.test.channel_id}"
+  endpoint_id = %[1]q
+
+  hls_package {
+    segment_duration_seconds = 6
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
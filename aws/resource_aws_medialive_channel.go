@@ -0,0 +1,725 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMediaLiveChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveChannelCreate,
+		Read:   resourceAwsMediaLiveChannelRead,
+		Update: resourceAwsMediaLiveChannelUpdate,
+		Delete: resourceAwsMediaLiveChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  medialive.ChannelClassStandard,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.ChannelClassSinglePipeline,
+					medialive.ChannelClassStandard,
+				}, false),
+			},
+			"destination": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"media_package_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_param": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stream_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"encoder_settings": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := mediaLiveEncoderSettingsAreEquivalent(old, new)
+					return equal
+				},
+				ValidateFunc: validateMediaLiveEncoderSettings,
+			},
+			"input_attachment": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_attachment_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"input_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"input_settings": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								equal, _ := mediaLiveInputSettingsAreEquivalent(old, new)
+								return equal
+							},
+							ValidateFunc: validateMediaLiveInputSettings,
+						},
+					},
+				},
+			},
+			"input_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"codec": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								medialive.InputCodecAvc,
+								medialive.InputCodecHevc,
+								medialive.InputCodecMpeg2,
+							}, false),
+						},
+						"maximum_bitrate": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								medialive.InputMaximumBitrateMax10Mbps,
+								medialive.InputMaximumBitrateMax20Mbps,
+								medialive.InputMaximumBitrateMax50Mbps,
+							}, false),
+						},
+						"resolution": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								medialive.InputResolutionHd,
+								medialive.InputResolutionSd,
+								medialive.InputResolutionUhd,
+							}, false),
+						},
+					},
+				},
+			},
+			"log_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.LogLevelDebug,
+					medialive.LogLevelDisabled,
+					medialive.LogLevelError,
+					medialive.LogLevelInfo,
+					medialive.LogLevelWarning,
+				}, false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"start_channel": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func validateMediaLiveEncoderSettings(v interface{}, k string) (ws []string, errors []error) {
+	settings, err := expandMediaLiveEncoderSettings(v.(string))
+
+	if err == nil {
+		err = settings.Validate()
+	}
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid MediaLive encoder settings: %s", k, err))
+	}
+
+	return
+}
+
+func validateMediaLiveInputSettings(v interface{}, k string) (ws []string, errors []error) {
+	settings, err := expandMediaLiveInputSettings(v.(string))
+
+	if err == nil {
+		err = settings.Validate()
+	}
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid MediaLive input settings: %s", k, err))
+	}
+
+	return
+}
+
+func resourceAwsMediaLiveChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	encoderSettings, err := expandMediaLiveEncoderSettings(d.Get("encoder_settings").(string))
+
+	if err != nil {
+		return fmt.Errorf("error expanding MediaLive Channel encoder settings: %s", err)
+	}
+
+	inputAttachments, err := expandMediaLiveInputAttachments(d.Get("input_attachment").([]interface{}))
+
+	if err != nil {
+		return fmt.Errorf("error expanding MediaLive Channel input attachments: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateChannelInput{
+		ChannelClass:     aws.String(d.Get("channel_class").(string)),
+		Destinations:     expandMediaLiveOutputDestinations(d.Get("destination").(*schema.Set).List()),
+		EncoderSettings:  encoderSettings,
+		InputAttachments: inputAttachments,
+		Name:             aws.String(name),
+	}
+
+	if v, ok := d.GetOk("input_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InputSpecification = expandMediaLiveInputSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("log_level"); ok {
+		input.LogLevel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MedialiveTags()
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Channel: %s", input)
+	output, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Channel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Channel.Id))
+
+	if _, err := waiter.ChannelCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) to be created: %s", d.Id(), err)
+	}
+
+	if d.Get("start_channel").(bool) {
+		if err := mediaLiveChannelStart(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaLiveChannelRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	channel, err := finder.ChannelByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(channel.Arn)
+	d.Set("arn", arn)
+	d.Set("channel_class", channel.ChannelClass)
+
+	if err := d.Set("destination", flattenMediaLiveOutputDestinations(channel.Destinations)); err != nil {
+		return fmt.Errorf("error setting destination: %s", err)
+	}
+
+	encoderSettings, err := flattenApiObjectJson(channel.EncoderSettings)
+
+	if err != nil {
+		return fmt.Errorf("error flattening MediaLive Channel (%s) encoder settings: %s", d.Id(), err)
+	}
+
+	d.Set("encoder_settings", encoderSettings)
+
+	inputAttachments, err := flattenMediaLiveInputAttachments(channel.InputAttachments)
+
+	if err != nil {
+		return fmt.Errorf("error flattening MediaLive Channel (%s) input attachments: %s", d.Id(), err)
+	}
+
+	if err := d.Set("input_attachment", inputAttachments); err != nil {
+		return fmt.Errorf("error setting input_attachment: %s", err)
+	}
+
+	if err := d.Set("input_specification", flattenMediaLiveInputSpecification(channel.InputSpecification)); err != nil {
+		return fmt.Errorf("error setting input_specification: %s", err)
+	}
+
+	d.Set("log_level", channel.LogLevel)
+	d.Set("name", channel.Name)
+	d.Set("role_arn", channel.RoleArn)
+
+	switch aws.StringValue(channel.State) {
+	case medialive.ChannelStateRecovering, medialive.ChannelStateRunning, medialive.ChannelStateStarting:
+		d.Set("start_channel", true)
+	default:
+		d.Set("start_channel", false)
+	}
+
+	tags, err := keyvaluetags.MedialiveListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("destination") || d.HasChange("encoder_settings") || d.HasChange("input_attachment") ||
+		d.HasChange("input_specification") || d.HasChange("log_level") || d.HasChange("name") || d.HasChange("role_arn") {
+		encoderSettings, err := expandMediaLiveEncoderSettings(d.Get("encoder_settings").(string))
+
+		if err != nil {
+			return fmt.Errorf("error expanding MediaLive Channel encoder settings: %s", err)
+		}
+
+		inputAttachments, err := expandMediaLiveInputAttachments(d.Get("input_attachment").([]interface{}))
+
+		if err != nil {
+			return fmt.Errorf("error expanding MediaLive Channel input attachments: %s", err)
+		}
+
+		input := &medialive.UpdateChannelInput{
+			ChannelId:        aws.String(d.Id()),
+			Destinations:     expandMediaLiveOutputDestinations(d.Get("destination").(*schema.Set).List()),
+			EncoderSettings:  encoderSettings,
+			InputAttachments: inputAttachments,
+			Name:             aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("input_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.InputSpecification = expandMediaLiveInputSpecification(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("log_level"); ok {
+			input.LogLevel = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		// Running channels cannot be updated.
+		o, _ := d.GetChange("start_channel")
+		if o.(bool) {
+			if err := mediaLiveChannelStop(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Channel: %s", input)
+		_, err = conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waiter.ChannelUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Channel (%s) to update: %s", d.Id(), err)
+		}
+
+		if d.Get("start_channel").(bool) {
+			if err := mediaLiveChannelStart(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	} else if d.HasChange("start_channel") {
+		if d.Get("start_channel").(bool) {
+			if err := mediaLiveChannelStart(conn, d.Id()); err != nil {
+				return err
+			}
+		} else {
+			if err := mediaLiveChannelStop(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveChannelRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.Get("start_channel").(bool) {
+		if err := mediaLiveChannelStop(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaLive Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(&medialive.DeleteChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.ChannelDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func mediaLiveChannelStart(conn *medialive.MediaLive, id string) error {
+	log.Printf("[DEBUG] Starting MediaLive Channel: %s", id)
+	_, err := conn.StartChannel(&medialive.StartChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaLive Channel (%s): %s", id, err)
+	}
+
+	if _, err := waiter.ChannelStarted(conn, id); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) to start: %s", id, err)
+	}
+
+	return nil
+}
+
+func mediaLiveChannelStop(conn *medialive.MediaLive, id string) error {
+	log.Printf("[DEBUG] Stopping MediaLive Channel: %s", id)
+	_, err := conn.StopChannel(&medialive.StopChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaLive Channel (%s): %s", id, err)
+	}
+
+	if _, err := waiter.ChannelStopped(conn, id); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) to stop: %s", id, err)
+	}
+
+	return nil
+}
+
+func expandMediaLiveOutputDestinations(tfList []interface{}) []*medialive.OutputDestination {
+	var apiObjects []*medialive.OutputDestination
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.OutputDestination{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["media_package_settings"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.MediaPackageSettings = append(apiObject.MediaPackageSettings, &medialive.MediaPackageOutputDestinationSettings{
+					ChannelId: aws.String(tfMap["channel_id"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["settings"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Settings = expandMediaLiveOutputDestinationSettings(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveOutputDestinationSettings(tfList []interface{}) []*medialive.OutputDestinationSettings {
+	var apiObjects []*medialive.OutputDestinationSettings
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.OutputDestinationSettings{}
+
+		if v, ok := tfMap["password_param"].(string); ok && v != "" {
+			apiObject.PasswordParam = aws.String(v)
+		}
+
+		if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+			apiObject.StreamName = aws.String(v)
+		}
+
+		if v, ok := tfMap["url"].(string); ok && v != "" {
+			apiObject.Url = aws.String(v)
+		}
+
+		if v, ok := tfMap["username"].(string); ok && v != "" {
+			apiObject.Username = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveInputAttachments(tfList []interface{}) ([]*medialive.InputAttachment, error) {
+	var apiObjects []*medialive.InputAttachment
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputAttachment{
+			InputId: aws.String(tfMap["input_id"].(string)),
+		}
+
+		if v, ok := tfMap["input_attachment_name"].(string); ok && v != "" {
+			apiObject.InputAttachmentName = aws.String(v)
+		}
+
+		if v, ok := tfMap["input_settings"].(string); ok && v != "" {
+			inputSettings, err := expandMediaLiveInputSettings(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			apiObject.InputSettings = inputSettings
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandMediaLiveInputSpecification(tfMap map[string]interface{}) *medialive.InputSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &medialive.InputSpecification{
+		Codec:          aws.String(tfMap["codec"].(string)),
+		MaximumBitrate: aws.String(tfMap["maximum_bitrate"].(string)),
+		Resolution:     aws.String(tfMap["resolution"].(string)),
+	}
+}
+
+func flattenMediaLiveOutputDestinations(apiObjects []*medialive.OutputDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var mediaPackageSettings []interface{}
+
+		for _, v := range apiObject.MediaPackageSettings {
+			if v == nil {
+				continue
+			}
+
+			mediaPackageSettings = append(mediaPackageSettings, map[string]interface{}{
+				"channel_id": aws.StringValue(v.ChannelId),
+			})
+		}
+
+		var settings []interface{}
+
+		for _, v := range apiObject.Settings {
+			if v == nil {
+				continue
+			}
+
+			settings = append(settings, map[string]interface{}{
+				"password_param": aws.StringValue(v.PasswordParam),
+				"stream_name":    aws.StringValue(v.StreamName),
+				"url":            aws.StringValue(v.Url),
+				"username":       aws.StringValue(v.Username),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":                     aws.StringValue(apiObject.Id),
+			"media_package_settings": mediaPackageSettings,
+			"settings":               settings,
+		})
+	}
+
+	return tfList
+}
+
+func flattenMediaLiveInputAttachments(apiObjects []*medialive.InputAttachment) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"input_attachment_name": aws.StringValue(apiObject.InputAttachmentName),
+			"input_id":              aws.StringValue(apiObject.InputId),
+		}
+
+		if apiObject.InputSettings != nil {
+			inputSettings, err := flattenApiObjectJson(apiObject.InputSettings)
+
+			if err != nil {
+				return nil, err
+			}
+
+			tfMap["input_settings"] = inputSettings
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
+func flattenMediaLiveInputSpecification(apiObject *medialive.InputSpecification) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"codec":           aws.StringValue(apiObject.Codec),
+			"maximum_bitrate": aws.StringValue(apiObject.MaximumBitrate),
+			"resolution":      aws.StringValue(apiObject.Resolution),
+		},
+	}
+}
@@ -0,0 +1,494 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_medialive_channel", &sweep.Sweeper{
+		Name: "aws_medialive_channel",
+		F:    testSweepMediaLiveChannels,
+	})
+}
+
+func testSweepMediaLiveChannels(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).medialiveconn
+	input := &medialive.ListChannelsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListChannels(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaLive Channel sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaLive Channels: %s", err))
+			return sweeperErrs
+		}
+
+		for _, channel := range output.Channels {
+			id := aws.StringValue(channel.Id)
+
+			switch aws.StringValue(channel.State) {
+			case medialive.ChannelStateRecovering, medialive.ChannelStateRunning, medialive.ChannelStateStarting:
+				if testSweepDryRun() {
+					sweepRunner.RecordDryRunRequest(medialive.ServiceName, "StopChannel", &medialive.StopChannelInput{
+						ChannelId: aws.String(id),
+					})
+				} else if err := mediaLiveChannelStop(conn, id); err != nil {
+					sweeperErrs = multierror.Append(sweeperErrs, err)
+					continue
+				}
+			}
+
+			log.Printf("[INFO] Deleting MediaLive Channel: %s", id)
+			_, err := conn.DeleteChannel(&medialive.DeleteChannelInput{
+				ChannelId: aws.String(id),
+			})
+
+			if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting MediaLive Channel (%s): %s", id, err))
+				continue
+			}
+
+			if testSweepDryRun() {
+				continue
+			}
+
+			if _, err := waiter.ChannelDeleted(conn, id); err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error waiting for MediaLive Channel (%s) to delete: %s", id, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaLiveChannel_basic(t *testing.T) {
+	var channel medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	inputResourceName := "aws_medialive_input.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t); testAccPreCheckAWSMediaPackage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveChannelConfig(rName, rName, medialive.LogLevelDisabled, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &channel),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`channel:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "channel_class", medialive.ChannelClassStandard),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_attachment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_attachment.0.input_attachment_name", "primary"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_attachment.0.input_id", inputResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.codec", medialive.InputCodecAvc),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.maximum_bitrate", medialive.InputMaximumBitrateMax20Mbps),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.resolution", medialive.InputResolutionHd),
+					resource.TestCheckResourceAttr(resourceName, "log_level", medialive.LogLevelDisabled),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encoder_settings"},
+			},
+			{
+				Config: testAccAWSMediaLiveChannelConfig(rName, rName+"-updated", medialive.LogLevelError, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "log_level", medialive.LogLevelError),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveChannel_StartChannel(t *testing.T) {
+	var channel medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t); testAccPreCheckAWSMediaPackage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveChannelConfig(rName, rName, medialive.LogLevelDisabled, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &channel),
+					testAccCheckAWSMediaLiveChannelState(&channel, medialive.ChannelStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "true"),
+				),
+			},
+			{
+				// Running channels are stopped, updated and started again.
+				Config: testAccAWSMediaLiveChannelConfig(rName, rName, medialive.LogLevelError, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &channel),
+					testAccCheckAWSMediaLiveChannelState(&channel, medialive.ChannelStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "log_level", medialive.LogLevelError),
+				),
+			},
+			{
+				Config: testAccAWSMediaLiveChannelConfig(rName, rName, medialive.LogLevelError, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &channel),
+					testAccCheckAWSMediaLiveChannelState(&channel, medialive.ChannelStateIdle),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveChannel_disappears(t *testing.T) {
+	var channel medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t); testAccPreCheckAWSMediaPackage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveChannelConfig(rName, rName, medialive.LogLevelDisabled, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &channel),
+					testAccCheckAWSMediaLiveChannelDisappears(&channel),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveChannel_tags(t *testing.T) {
+	var channel medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t); testAccPreCheckAWSMediaPackage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveChannelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encoder_settings"},
+			},
+			{
+				Config: testAccAWSMediaLiveChannelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaLiveChannelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaLiveChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_channel" {
+			continue
+		}
+
+		_, err := finder.ChannelByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaLive Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMediaLiveChannelDisappears(channel *medialive.DescribeChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		id := aws.StringValue(channel.Id)
+		_, err := conn.DeleteChannel(&medialive.DeleteChannelInput{
+			ChannelId: aws.String(id),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = waiter.ChannelDeleted(conn, id)
+
+		return err
+	}
+}
+
+func testAccCheckAWSMediaLiveChannelExists(n string, v *medialive.DescribeChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		channel, err := finder.ChannelByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *channel
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaLiveChannelState(channel *medialive.DescribeChannelOutput, expectedState string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if state := aws.StringValue(channel.State); state != expectedState {
+			return fmt.Errorf("MediaLive Channel (%s) state is %s, expected %s", aws.StringValue(channel.Id), state, expectedState)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSMediaLiveChannelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "medialive.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "mediapackage:DescribeChannel",
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "logs:DescribeLogStreams",
+        "logs:DescribeLogGroups"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_media_package_channel" "test" {
+  channel_id = %[1]q
+}
+
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+}
+
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  type                  = "UDP_PUSH"
+  input_security_groups = ["${aws_medialive_input_security_group.test.id}"]
+}
+`, rName)
+}
+
+const testAccAWSMediaLiveChannelEncoderSettings = `
+  encoder_settings = <<EOF
+{
+  "audioDescriptions": [
+    {
+      "audioSelectorName": "default",
+      "name": "audio_1"
+    }
+  ],
+  "outputGroups": [
+    {
+      "outputGroupSettings": {
+        "mediaPackageGroupSettings": {
+          "destination": {
+            "destinationRefId": "destination1"
+          }
+        }
+      },
+      "outputs": [
+        {
+          "audioDescriptionNames": ["audio_1"],
+          "outputName": "output_1",
+          "outputSettings": {
+            "mediaPackageOutputSettings": {}
+          },
+          "videoDescriptionName": "video_1"
+        }
+      ]
+    }
+  ],
+  "timecodeConfig": {
+    "source": "EMBEDDED"
+  },
+  "videoDescriptions": [
+    {
+      "height": 720,
+      "name": "video_1",
+      "width": 1280
+    }
+  ]
+}
+EOF
+`
+
+func testAccAWSMediaLiveChannelConfigChannel(name, logLevel string, startChannel bool, tags string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name          = %[1]q
+  log_level     = %[2]q
+  role_arn      = "${aws_iam_role.test.arn}"
+  start_channel = %[3]t
+
+  destination {
+    id = "destination1"
+
+    media_package_settings {
+      channel_id = "${aws_media_package_channel.test.channel_id}"
+    }
+  }
+
+  input_attachment {
+    input_attachment_name = "primary"
+    input_id              = "${aws_medialive_input.test.id}"
+  }
+
+  input_specification {
+    codec           = "AVC"
+    maximum_bitrate = "MAX_20_MBPS"
+    resolution      = "HD"
+  }
+%[4]s
+%[5]s
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, name, logLevel, startChannel, testAccAWSMediaLiveChannelEncoderSettings, tags)
+}
+
+func testAccAWSMediaLiveChannelConfig(rName, name, logLevel string, startChannel bool) string {
+	return testAccAWSMediaLiveChannelConfigBase(rName) + testAccAWSMediaLiveChannelConfigChannel(name, logLevel, startChannel, "")
+}
+
+func testAccAWSMediaLiveChannelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSMediaLiveChannelConfigBase(rName) + testAccAWSMediaLiveChannelConfigChannel(rName, medialive.LogLevelDisabled, false, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSMediaLiveChannelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSMediaLiveChannelConfigBase(rName) + testAccAWSMediaLiveChannelConfigChannel(rName, medialive.LogLevelDisabled, false, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,507 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMediaLiveInput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputCreate,
+		Read:   resourceAwsMediaLiveInputRead,
+		Update: resourceAwsMediaLiveInputUpdate,
+		Delete: resourceAwsMediaLiveInputDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attached_channels": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Push inputs without stream names are allocated destinations by the service.
+			"destinations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"input_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_security_groups": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"vpc"},
+			},
+			"input_source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"media_connect_flows": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_param": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.InputTypeMediaconnect,
+					medialive.InputTypeMp4File,
+					medialive.InputTypeRtmpPull,
+					medialive.InputTypeRtmpPush,
+					medialive.InputTypeRtpPush,
+					medialive.InputTypeUdpPush,
+					medialive.InputTypeUrlPull,
+				}, false),
+			},
+			// The VPC configuration is not returned by the API.
+			"vpc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				ConflictsWith: []string{"input_security_groups"},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateInputInput{
+		Name: aws.String(name),
+		Type: aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("destinations"); ok && len(v.([]interface{})) > 0 {
+		input.Destinations = expandMediaLiveInputDestinationRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_security_groups"); ok && v.(*schema.Set).Len() > 0 {
+		input.InputSecurityGroups = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("media_connect_flows"); ok && len(v.([]interface{})) > 0 {
+		input.MediaConnectFlows = expandMediaLiveMediaConnectFlowRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sources"); ok && len(v.([]interface{})) > 0 {
+		input.Sources = expandMediaLiveInputSourceRequests(v.([]interface{}))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MedialiveTags()
+	}
+
+	if v, ok := d.GetOk("vpc"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Vpc = expandMediaLiveInputVpcRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input: %s", input)
+	output, err := conn.CreateInput(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Input.Id))
+
+	if _, err := waiter.InputCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaLiveInputRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input, err := finder.InputByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(input.Arn)
+	d.Set("arn", arn)
+
+	if err := d.Set("attached_channels", aws.StringValueSlice(input.AttachedChannels)); err != nil {
+		return fmt.Errorf("error setting attached_channels: %s", err)
+	}
+
+	if err := d.Set("destinations", flattenMediaLiveInputDestinations(input.Destinations)); err != nil {
+		return fmt.Errorf("error setting destinations: %s", err)
+	}
+
+	d.Set("input_class", input.InputClass)
+
+	if err := d.Set("input_security_groups", aws.StringValueSlice(input.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting input_security_groups: %s", err)
+	}
+
+	d.Set("input_source_type", input.InputSourceType)
+
+	if err := d.Set("media_connect_flows", flattenMediaLiveMediaConnectFlows(input.MediaConnectFlows)); err != nil {
+		return fmt.Errorf("error setting media_connect_flows: %s", err)
+	}
+
+	d.Set("name", input.Name)
+	d.Set("role_arn", input.RoleArn)
+
+	if err := d.Set("sources", flattenMediaLiveInputSources(input.Sources)); err != nil {
+		return fmt.Errorf("error setting sources: %s", err)
+	}
+
+	d.Set("type", input.Type)
+
+	tags, err := keyvaluetags.MedialiveListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("destinations") || d.HasChange("input_security_groups") || d.HasChange("media_connect_flows") ||
+		d.HasChange("name") || d.HasChange("role_arn") || d.HasChange("sources") {
+		input := &medialive.UpdateInputInput{
+			InputId: aws.String(d.Id()),
+			Name:    aws.String(d.Get("name").(string)),
+		}
+
+		if d.HasChange("destinations") {
+			input.Destinations = expandMediaLiveInputDestinationRequests(d.Get("destinations").([]interface{}))
+		}
+
+		if d.HasChange("input_security_groups") {
+			input.InputSecurityGroups = expandStringSet(d.Get("input_security_groups").(*schema.Set))
+		}
+
+		if d.HasChange("media_connect_flows") {
+			input.MediaConnectFlows = expandMediaLiveMediaConnectFlowRequests(d.Get("media_connect_flows").([]interface{}))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("sources") {
+			input.Sources = expandMediaLiveInputSourceRequests(d.Get("sources").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input: %s", input)
+		_, err := conn.UpdateInput(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Input (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Input (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveInputRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input: %s", d.Id())
+	_, err := conn.DeleteInput(&medialive.DeleteInputInput{
+		InputId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.InputDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaLiveInputDestinationRequests(tfList []interface{}) []*medialive.InputDestinationRequest {
+	var apiObjects []*medialive.InputDestinationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputDestinationRequest{}
+
+		if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+			apiObject.StreamName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveInputSourceRequests(tfList []interface{}) []*medialive.InputSourceRequest {
+	var apiObjects []*medialive.InputSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputSourceRequest{
+			Url: aws.String(tfMap["url"].(string)),
+		}
+
+		if v, ok := tfMap["password_param"].(string); ok && v != "" {
+			apiObject.PasswordParam = aws.String(v)
+		}
+
+		if v, ok := tfMap["username"].(string); ok && v != "" {
+			apiObject.Username = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveMediaConnectFlowRequests(tfList []interface{}) []*medialive.MediaConnectFlowRequest {
+	var apiObjects []*medialive.MediaConnectFlowRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &medialive.MediaConnectFlowRequest{
+			FlowArn: aws.String(tfMap["flow_arn"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveInputVpcRequest(tfMap map[string]interface{}) *medialive.InputVpcRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.InputVpcRequest{
+		SubnetIds: expandStringSet(tfMap["subnet_ids"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenMediaLiveInputDestinations(apiObjects []*medialive.InputDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"ip":          aws.StringValue(apiObject.Ip),
+			"port":        aws.StringValue(apiObject.Port),
+			"stream_name": mediaLiveInputDestinationStreamName(aws.StringValue(apiObject.Url)),
+			"url":         aws.StringValue(apiObject.Url),
+		})
+	}
+
+	return tfList
+}
+
+// mediaLiveInputDestinationStreamName returns the stream name from an input destination URL.
+// The API does not return the stream name separately, e.g. rtmp://198.51.100.1:1935/app/instance.
+func mediaLiveInputDestinationStreamName(destinationUrl string) string {
+	u, err := url.Parse(destinationUrl)
+
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(u.Path, "/")
+}
+
+func flattenMediaLiveInputSources(apiObjects []*medialive.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"password_param": aws.StringValue(apiObject.PasswordParam),
+			"url":            aws.StringValue(apiObject.Url),
+			"username":       aws.StringValue(apiObject.Username),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMediaLiveMediaConnectFlows(apiObjects []*medialive.MediaConnectFlow) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"flow_arn": aws.StringValue(apiObject.FlowArn),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMediaLiveInputSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputSecurityGroupCreate,
+		Read:   resourceAwsMediaLiveInputSecurityGroupRead,
+		Update: resourceAwsMediaLiveInputSecurityGroupUpdate,
+		Delete: resourceAwsMediaLiveInputSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inputs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"whitelist_rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.CIDRNetwork(0, 32),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.CreateInputSecurityGroupInput{
+		WhitelistRules: expandMediaLiveInputWhitelistRuleCidrs(d.Get("whitelist_rule").(*schema.Set).List()),
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().MedialiveTags()
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input Security Group: %s", input)
+	output, err := conn.CreateInputSecurityGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input Security Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroup.Id))
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	inputSecurityGroup, err := finder.InputSecurityGroupByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Input Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(inputSecurityGroup.Arn)
+	d.Set("arn", arn)
+
+	if err := d.Set("inputs", aws.StringValueSlice(inputSecurityGroup.Inputs)); err != nil {
+		return fmt.Errorf("error setting inputs: %s", err)
+	}
+
+	if err := d.Set("whitelist_rule", flattenMediaLiveInputWhitelistRules(inputSecurityGroup.WhitelistRules)); err != nil {
+		return fmt.Errorf("error setting whitelist_rule: %s", err)
+	}
+
+	tags, err := keyvaluetags.MedialiveListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("whitelist_rule") {
+		input := &medialive.UpdateInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(d.Id()),
+			WhitelistRules:       expandMediaLiveInputWhitelistRuleCidrs(d.Get("whitelist_rule").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input Security Group: %s", input)
+		_, err := conn.UpdateInputSecurityGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Input Security Group (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waiter.InputSecurityGroupUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Input Security Group (%s) to update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Input Security Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input Security Group: %s", d.Id())
+	_, err := conn.DeleteInputSecurityGroup(&medialive.DeleteInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.InputSecurityGroupDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input Security Group (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaLiveInputWhitelistRuleCidrs(tfList []interface{}) []*medialive.InputWhitelistRuleCidr {
+	var apiObjects []*medialive.InputWhitelistRuleCidr
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &medialive.InputWhitelistRuleCidr{
+			Cidr: aws.String(tfMap["cidr"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenMediaLiveInputWhitelistRules(apiObjects []*medialive.InputWhitelistRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"cidr": aws.StringValue(apiObject.Cidr),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,323 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_medialive_input_security_group", &sweep.Sweeper{
+		Name: "aws_medialive_input_security_group",
+		F:    testSweepMediaLiveInputSecurityGroups,
+		Dependencies: []string{
+			"aws_medialive_input",
+		},
+	})
+}
+
+func testSweepMediaLiveInputSecurityGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).medialiveconn
+	input := &medialive.ListInputSecurityGroupsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListInputSecurityGroups(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaLive Input Security Group sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaLive Input Security Groups: %s", err))
+			return sweeperErrs
+		}
+
+		for _, inputSecurityGroup := range output.InputSecurityGroups {
+			id := aws.StringValue(inputSecurityGroup.Id)
+
+			log.Printf("[INFO] Deleting MediaLive Input Security Group: %s", id)
+			_, err := conn.DeleteInputSecurityGroup(&medialive.DeleteInputSecurityGroupInput{
+				InputSecurityGroupId: aws.String(id),
+			})
+
+			if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting MediaLive Input Security Group (%s): %s", id, err))
+				continue
+			}
+
+			if testSweepDryRun() {
+				continue
+			}
+
+			if _, err := waiter.InputSecurityGroupDeleted(conn, id); err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error waiting for MediaLive Input Security Group (%s) to delete: %s", id, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaLiveInputSecurityGroup_basic(t *testing.T) {
+	var inputSecurityGroup medialive.DescribeInputSecurityGroupOutput
+	resourceName := "aws_medialive_input_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfigWhitelistRules1("10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &inputSecurityGroup),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`inputSecurityGroup:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "inputs.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfigWhitelistRules2("10.0.0.0/16", "192.0.2.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &inputSecurityGroup),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInputSecurityGroup_disappears(t *testing.T) {
+	var inputSecurityGroup medialive.DescribeInputSecurityGroupOutput
+	resourceName := "aws_medialive_input_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfigWhitelistRules1("10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &inputSecurityGroup),
+					testAccCheckAWSMediaLiveInputSecurityGroupDisappears(&inputSecurityGroup),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInputSecurityGroup_tags(t *testing.T) {
+	var inputSecurityGroup medialive.DescribeInputSecurityGroupOutput
+	resourceName := "aws_medialive_input_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &inputSecurityGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &inputSecurityGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &inputSecurityGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSMediaLive(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	input := &medialive.ListInputSecurityGroupsInput{}
+
+	_, err := conn.ListInputSecurityGroups(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSMediaLiveInputSecurityGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input_security_group" {
+			continue
+		}
+
+		_, err := finder.InputSecurityGroupByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaLive Input Security Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMediaLiveInputSecurityGroupDisappears(inputSecurityGroup *medialive.DescribeInputSecurityGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		id := aws.StringValue(inputSecurityGroup.Id)
+		_, err := conn.DeleteInputSecurityGroup(&medialive.DeleteInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(id),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = waiter.InputSecurityGroupDeleted(conn, id)
+
+		return err
+	}
+}
+
+func testAccCheckAWSMediaLiveInputSecurityGroupExists(n string, v *medialive.DescribeInputSecurityGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input Security Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		inputSecurityGroup, err := finder.InputSecurityGroupByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *inputSecurityGroup
+
+		return nil
+	}
+}
+
+func testAccAWSMediaLiveInputSecurityGroupConfigWhitelistRules1(cidr1 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = %[1]q
+  }
+}
+`, cidr1)
+}
+
+func testAccAWSMediaLiveInputSecurityGroupConfigWhitelistRules2(cidr1, cidr2 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = %[1]q
+  }
+
+  whitelist_rule {
+    cidr = %[2]q
+  }
+}
+`, cidr1, cidr2)
+}
+
+func testAccAWSMediaLiveInputSecurityGroupConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSMediaLiveInputSecurityGroupConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,401 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_medialive_input", &sweep.Sweeper{
+		Name: "aws_medialive_input",
+		F:    testSweepMediaLiveInputs,
+		Dependencies: []string{
+			"aws_medialive_channel",
+		},
+	})
+}
+
+func testSweepMediaLiveInputs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).medialiveconn
+	input := &medialive.ListInputsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListInputs(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaLive Input sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaLive Inputs: %s", err))
+			return sweeperErrs
+		}
+
+		for _, mediaLiveInput := range output.Inputs {
+			id := aws.StringValue(mediaLiveInput.Id)
+
+			log.Printf("[INFO] Deleting MediaLive Input: %s", id)
+			_, err := conn.DeleteInput(&medialive.DeleteInputInput{
+				InputId: aws.String(id),
+			})
+
+			if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting MediaLive Input (%s): %s", id, err))
+				continue
+			}
+
+			if testSweepDryRun() {
+				continue
+			}
+
+			if _, err := waiter.InputDeleted(conn, id); err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error waiting for MediaLive Input (%s) to delete: %s", id, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestMediaLiveInputDestinationStreamName(t *testing.T) {
+	testCases := []struct {
+		Url      string
+		Expected string
+	}{
+		{
+			Url:      "rtmp://198.51.100.1:1935/live/stream",
+			Expected: "live/stream",
+		},
+		{
+			Url:      "udp://198.51.100.1:5000",
+			Expected: "",
+		},
+		{
+			Url:      "",
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := mediaLiveInputDestinationStreamName(testCase.Url); got != testCase.Expected {
+			t.Errorf("%q: got %q, expected %q", testCase.Url, got, testCase.Expected)
+		}
+	}
+}
+
+func TestAccAWSMediaLiveInput_basic(t *testing.T) {
+	var input medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputConfigRtmpPush(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &input),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`input:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "attached_channels.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.0.stream_name", "live/stream1"),
+					resource.TestMatchResourceAttr(resourceName, "destinations.0.url", regexp.MustCompile(`^rtmp://.+/live/stream1$`)),
+					resource.TestCheckResourceAttr(resourceName, "destinations.1.stream_name", "live/stream2"),
+					resource.TestCheckResourceAttr(resourceName, "input_class", medialive.InputClassStandard),
+					resource.TestCheckResourceAttr(resourceName, "input_security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", medialive.InputTypeRtmpPush),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveInputConfigRtmpPush(rName + "-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInput_UrlPull(t *testing.T) {
+	var input medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputConfigUrlPull(rName, "https://example.com/live/primary.m3u8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.url", "https://example.com/live/primary.m3u8"),
+					resource.TestCheckResourceAttr(resourceName, "sources.1.url", "https://example.com/live/secondary.m3u8"),
+					resource.TestCheckResourceAttr(resourceName, "type", medialive.InputTypeUrlPull),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveInputConfigUrlPull(rName, "https://example.com/live/updated.m3u8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.url", "https://example.com/live/updated.m3u8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInput_disappears(t *testing.T) {
+	var input medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputConfigRtmpPush(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &input),
+					testAccCheckAWSMediaLiveInputDisappears(&input),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInput_tags(t *testing.T) {
+	var input medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveInputConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaLiveInputConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaLiveInputDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input" {
+			continue
+		}
+
+		_, err := finder.InputByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaLive Input %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMediaLiveInputDisappears(input *medialive.DescribeInputOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		id := aws.StringValue(input.Id)
+		_, err := conn.DeleteInput(&medialive.DeleteInputInput{
+			InputId: aws.String(id),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = waiter.InputDeleted(conn, id)
+
+		return err
+	}
+}
+
+func testAccCheckAWSMediaLiveInputExists(n string, v *medialive.DescribeInputOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		input, err := finder.InputByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *input
+
+		return nil
+	}
+}
+
+const testAccAWSMediaLiveInputConfigSecurityGroupBase = `
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+}
+`
+
+func testAccAWSMediaLiveInputConfigRtmpPush(rName string) string {
+	return testAccAWSMediaLiveInputConfigSecurityGroupBase + fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  type                  = "RTMP_PUSH"
+  input_security_groups = ["${aws_medialive_input_security_group.test.id}"]
+
+  destinations {
+    stream_name = "live/stream1"
+  }
+
+  destinations {
+    stream_name = "live/stream2"
+  }
+}
+`, rName)
+}
+
+func testAccAWSMediaLiveInputConfigUrlPull(rName, primaryUrl string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name = %[1]q
+  type = "URL_PULL"
+
+  sources {
+    url = %[2]q
+  }
+
+  sources {
+    url = "https://example.com/live/secondary.m3u8"
+  }
+}
+`, rName, primaryUrl)
+}
+
+func testAccAWSMediaLiveInputConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSMediaLiveInputConfigSecurityGroupBase + fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  type                  = "UDP_PUSH"
+  input_security_groups = ["${aws_medialive_input_security_group.test.id}"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSMediaLiveInputConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSMediaLiveInputConfigSecurityGroupBase + fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  type                  = "UDP_PUSH"
+  input_security_groups = ["${aws_medialive_input_security_group.test.id}"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/mitchellh/copystructure"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsMqBroker() *schema.Resource {
@@ -21,6 +22,11 @@ func resourceAwsMqBroker() *schema.Resource {
 		Read:   resourceAwsMqBrokerRead,
 		Update: resourceAwsMqBrokerUpdate,
 		Delete: resourceAwsMqBrokerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
 
 		Schema: map[string]*schema.Schema{
 			"apply_immediately": {
@@ -203,7 +209,8 @@ func resourceAwsMqBroker() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -238,8 +245,8 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("subnet_ids"); ok {
 		input.SubnetIds = expandStringList(v.(*schema.Set).List())
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().MqTags()
 	}
 
 	log.Printf("[INFO] Creating MQ Broker: %s", input)
@@ -278,6 +285,21 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
+	tags, err := readAwsMqBroker(d, meta)
+	if err != nil || d.Id() == "" {
+		return err
+	}
+
+	if err := setTagsAll(d, meta, tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// readAwsMqBroker populates the broker attributes shared by the resource
+// and data source, returning the broker tags.
+func readAwsMqBroker(d *schema.ResourceData, meta interface{}) (map[string]string, error) {
 	conn := meta.(*AWSClient).mqconn
 
 	log.Printf("[INFO] Reading MQ Broker: %s", d.Id())
@@ -288,15 +310,15 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		if isAWSErr(err, "NotFoundException", "") {
 			log.Printf("[WARN] MQ Broker %q not found, removing from state", d.Id())
 			d.SetId("")
-			return nil
+			return nil, nil
 		}
 		// API docs say a 404 can also return a 403
 		if isAWSErr(err, "ForbiddenException", "Forbidden") {
 			log.Printf("[WARN] MQ Broker %q not found, removing from state", d.Id())
 			d.SetId("")
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 
 	d.Set("auto_minor_version_upgrade", out.AutoMinorVersionUpgrade)
@@ -310,18 +332,18 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("publicly_accessible", out.PubliclyAccessible)
 	err = d.Set("maintenance_window_start_time", flattenMqWeeklyStartTime(out.MaintenanceWindowStartTime))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	d.Set("security_groups", aws.StringValueSlice(out.SecurityGroups))
 	d.Set("subnet_ids", aws.StringValueSlice(out.SubnetIds))
 
 	if err := d.Set("logs", flattenMqLogs(out.Logs)); err != nil {
-		return fmt.Errorf("error setting logs: %s", err)
+		return nil, fmt.Errorf("error setting logs: %s", err)
 	}
 
 	err = d.Set("configuration", flattenMqConfigurationId(out.Configurations.Current))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rawUsers := make([]*mq.User, len(out.Users))
@@ -331,7 +353,7 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 			Username: u.Username,
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		rawUsers[i] = &mq.User{
@@ -343,10 +365,17 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 
 	users := flattenMqUsers(rawUsers, d.Get("user").(*schema.Set).List())
 	if err = d.Set("user", users); err != nil {
-		return err
+		return nil, err
+	}
+
+	tagsOut, err := conn.ListTags(&mq.ListTagsInput{
+		ResourceArn: out.BrokerArn,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags for MQ Broker (%s): %s", d.Id(), err)
 	}
 
-	return getTagsMQ(conn, d, aws.StringValue(out.BrokerArn))
+	return keyvaluetags.MqKeyValueTags(tagsOut.Tags).IgnoreAws().Map(), nil
 }
 
 func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -404,8 +433,12 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if tagErr := setTagsMQ(conn, d, d.Get("arn").(string)); tagErr != nil {
-		return fmt.Errorf("error setting mq broker tags: %s", tagErr)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MqUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error setting mq broker tags: %s", err)
+		}
 	}
 
 	return nil
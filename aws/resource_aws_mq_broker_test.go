@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mq_broker", &sweep.Sweeper{
 		Name: "aws_mq_broker",
 		F:    testSweepMqBrokers,
 	})
@@ -102,6 +103,10 @@ func testSweepMqBrokers(region string) error {
 		if err != nil {
 			return err
 		}
+		if testSweepDryRun() {
+			continue
+		}
+
 		err = waitForMqBrokerDeletion(conn, *bs.BrokerId)
 		if err != nil {
 			return err
@@ -285,6 +290,12 @@ func TestAccAWSMqBroker_basic(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_mq_broker.test", "instances.0.endpoints.4", regexp.MustCompile(`^wss://[a-z0-9-\.]+:61619$`)),
 				),
 			},
+			{
+				ResourceName:            "aws_mq_broker.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+			},
 		},
 	})
 }
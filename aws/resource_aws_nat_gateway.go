@@ -3,14 +3,14 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
 )
 
 func resourceAwsNatGateway() *schema.Resource {
@@ -23,6 +23,8 @@ func resourceAwsNatGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
 				Type:     schema.TypeString,
@@ -51,7 +53,8 @@ func resourceAwsNatGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -78,14 +81,7 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 	// Wait for the NAT Gateway to become available
 	log.Printf("[DEBUG] Waiting for NAT Gateway (%s) to become available", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pending"},
-		Target:  []string{"available"},
-		Refresh: NGStateRefreshFunc(conn, d.Id()),
-		Timeout: 10 * time.Minute,
-	}
-
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waiter.NatGatewayAvailable(conn, d.Id(), waiter.NatGatewayCreateTimeout); err != nil {
 		return fmt.Errorf("Error waiting for NAT Gateway (%s) to become available: %s", d.Id(), err)
 	}
 
@@ -96,26 +92,26 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	// Refresh the NAT Gateway state
-	ngRaw, state, err := NGStateRefreshFunc(conn, d.Id())()
-	if err != nil {
-		return err
+	ng, err := finder.NatGatewayByID(conn, d.Id())
+
+	if isResourceNotFoundError(err) {
+		log.Printf("[WARN] NAT Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
-	status := map[string]bool{
-		"deleted":  true,
-		"deleting": true,
-		"failed":   true,
+	if err != nil {
+		return fmt.Errorf("error reading NAT Gateway (%s): %s", d.Id(), err)
 	}
 
-	if _, ok := status[strings.ToLower(state)]; ngRaw == nil || ok {
-		log.Printf("[INFO] Removing %s from Terraform state as it is not found or in the deleted state.", d.Id())
+	switch state := aws.StringValue(ng.State); state {
+	case ec2.NatGatewayStateDeleted, ec2.NatGatewayStateDeleting, ec2.NatGatewayStateFailed:
+		log.Printf("[WARN] NAT Gateway (%s) in state (%s), removing from state", d.Id(), state)
 		d.SetId("")
 		return nil
 	}
 
 	// Set NAT Gateway attributes
-	ng := ngRaw.(*ec2.NatGateway)
 	d.Set("subnet_id", ng.SubnetId)
 
 	// Address
@@ -126,7 +122,9 @@ func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_ip", address.PublicIp)
 
 	// Tags
-	d.Set("tags", tagsToMap(ng.Tags))
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(ng.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	return nil
 }
@@ -141,6 +139,7 @@ func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 	return resourceAwsNatGatewayRead(d, meta)
@@ -167,47 +166,9 @@ func resourceAwsNatGatewayDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"deleting"},
-		Target:     []string{"deleted"},
-		Refresh:    NGStateRefreshFunc(conn, d.Id()),
-		Timeout:    30 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
-
-	_, stateErr := stateConf.WaitForState()
-	if stateErr != nil {
+	if _, err := waiter.NatGatewayDeleted(conn, d.Id(), waiter.NatGatewayDeleteTimeout); err != nil {
 		return fmt.Errorf("Error waiting for NAT Gateway (%s) to delete: %s", d.Id(), err)
 	}
 
 	return nil
 }
-
-// NGStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// a NAT Gateway.
-func NGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		opts := &ec2.DescribeNatGatewaysInput{
-			NatGatewayIds: []*string{aws.String(id)},
-		}
-		resp, err := conn.DescribeNatGateways(opts)
-		if err != nil {
-			if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "NatGatewayNotFound" {
-				resp = nil
-			} else {
-				log.Printf("Error on NGStateRefresh: %s", err)
-				return nil, "", err
-			}
-		}
-
-		if resp == nil {
-			// Sometimes AWS just has consistency issues and doesn't see
-			// our instance yet. Return an empty state.
-			return nil, "", nil
-		}
-
-		ng := resp.NatGateways[0]
-		return ng, *ng.State, nil
-	}
-}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 		Create: resourceAwsNetworkAclRuleCreate,
 		Read:   resourceAwsNetworkAclRuleRead,
 		Delete: resourceAwsNetworkAclRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkAclRuleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_acl_id": {
@@ -253,6 +257,31 @@ func resourceAwsNetworkAclRuleDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func resourceAwsNetworkAclRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NETWORK-ACL-ID:RULE-NUMBER:PROTOCOL:EGRESS", d.Id())
+	}
+
+	networkAclID := idParts[0]
+	ruleNumber, err := strconv.Atoi(idParts[1])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing rule number (%s): %s", idParts[1], err)
+	}
+	protocol := idParts[2]
+	egress, err := strconv.ParseBool(idParts[3])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing egress (%s): %s", idParts[3], err)
+	}
+
+	d.Set("network_acl_id", networkAclID)
+	d.Set("rule_number", ruleNumber)
+	d.Set("egress", egress)
+	d.SetId(networkAclIdRuleNumberEgressHash(networkAclID, ruleNumber, egress, protocol))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findNetworkAclRule(d *schema.ResourceData, meta interface{}) (*ec2.NetworkAclEntry, error) {
 	conn := meta.(*AWSClient).ec2conn
 
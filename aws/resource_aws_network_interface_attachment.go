@@ -17,6 +17,9 @@ func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
 		Create: resourceAwsNetworkInterfaceAttachmentCreate,
 		Read:   resourceAwsNetworkInterfaceAttachmentRead,
 		Delete: resourceAwsNetworkInterfaceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkInterfaceAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"device_index": {
@@ -132,6 +135,28 @@ func resourceAwsNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceAwsNetworkInterfaceAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeNetworkInterfacesInput{
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"attachment.attachment-id": d.Id(),
+		}),
+	}
+
+	resp, err := conn.DescribeNetworkInterfaces(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving ENI for attachment (%s): %s", d.Id(), err)
+	}
+	if len(resp.NetworkInterfaces) != 1 {
+		return nil, fmt.Errorf("Unable to find ENI for attachment (%s)", d.Id())
+	}
+
+	d.Set("network_interface_id", resp.NetworkInterfaces[0].NetworkInterfaceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
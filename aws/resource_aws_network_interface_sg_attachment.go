@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -15,6 +16,19 @@ func resourceAwsNetworkInterfaceSGAttachment() *schema.Resource {
 		Create: resourceAwsNetworkInterfaceSGAttachmentCreate,
 		Read:   resourceAwsNetworkInterfaceSGAttachmentRead,
 		Delete: resourceAwsNetworkInterfaceSGAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "_")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected SECURITY-GROUP-ID_NETWORK-INTERFACE-ID", d.Id())
+				}
+				sgID := idParts[0]
+				interfaceID := idParts[1]
+				d.Set("security_group_id", sgID)
+				d.Set("network_interface_id", interfaceID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -30,6 +30,11 @@ func TestAccAWSNetworkInterfaceSGAttachment_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", securityGroupResourceName, "id"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -37,6 +37,12 @@ func TestAccAWSOpsworksPermission_basic(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_permission.tf-acc-perm",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSOpsworksPermissionImportStateIdFunc("aws_opsworks_permission.tf-acc-perm"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAwsOpsworksPermissionCreate(sName, "true", "false", "iam_only"),
 				Check: resource.ComposeTestCheckFunc(
@@ -92,6 +98,17 @@ func TestAccAWSOpsworksPermission_basic(t *testing.T) {
 	})
 }
 
+func testAccAWSOpsworksPermissionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["stack_id"], rs.Primary.Attributes["user_arn"]), nil
+	}
+}
+
 func testAccCheckAWSOpsworksPermissionExists(
 	n string, opsperm *opsworks.Permission) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opsworks"
@@ -15,6 +16,20 @@ func resourceAwsOpsworksRdsDbInstance() *schema.Resource {
 		Update: resourceAwsOpsworksRdsDbInstanceUpdate,
 		Delete: resourceAwsOpsworksRdsDbInstanceDeregister,
 		Read:   resourceAwsOpsworksRdsDbInstanceRead,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.SplitN(d.Id(), "/", 2)
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected STACK-ID/RDS-DB-INSTANCE-ARN", d.Id())
+				}
+				stackID := idParts[0]
+				rdsDbInstanceArn := idParts[1]
+				d.Set("stack_id", stackID)
+				d.Set("rds_db_instance_arn", rdsDbInstanceArn)
+				d.SetId(rdsDbInstanceArn + stackID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"stack_id": {
@@ -31,6 +31,13 @@ func TestAccAWSOpsworksRdsDbInstance(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:            "aws_opsworks_rds_db_instance.tf-acc-opsworks-db",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSOpsworksRdsDbInstanceImportStateIdFunc("aws_opsworks_rds_db_instance.tf-acc-opsworks-db"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"db_password"},
+			},
 			{
 				Config: testAccAwsOpsworksRdsDbInstance(sName, "bar", "barbarbarbar"),
 				Check: resource.ComposeTestCheckFunc(
@@ -68,6 +75,17 @@ func TestAccAWSOpsworksRdsDbInstance(t *testing.T) {
 	})
 }
 
+func testAccAWSOpsworksRdsDbInstanceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["stack_id"], rs.Primary.Attributes["rds_db_instance_arn"]), nil
+	}
+}
+
 func testAccCheckAWSOpsworksRdsDbExists(
 	n string, opsdb *opsworks.RdsDbInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsOrganizationsAccount() *schema.Resource {
@@ -23,6 +24,8 @@ func resourceAwsOrganizationsAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -70,7 +73,8 @@ func resourceAwsOrganizationsAccount() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateAwsOrganizationsAccountRoleName,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -163,7 +167,7 @@ func resourceAwsOrganizationsAccountCreate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if tags := tagsFromMapOrganizations(d.Get("tags").(map[string]interface{})); len(tags) > 0 {
+	if tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().OrganizationsTags(); len(tags) > 0 {
 		input := &organizations.TagResourceInput{
 			ResourceId: aws.String(d.Id()),
 			Tags:       tags,
@@ -230,7 +234,7 @@ func resourceAwsOrganizationsAccountRead(d *schema.ResourceData, meta interface{
 	d.Set("parent_id", parentId)
 	d.Set("status", account.Status)
 
-	if err := d.Set("tags", tagsToMapOrganizations(tagsOutput.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.OrganizationsKeyValueTags(tagsOutput.Tags).IgnoreAws().Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -254,36 +258,11 @@ func resourceAwsOrganizationsAccountUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsOrganizations(tagsFromMapOrganizations(o), tagsFromMapOrganizations(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			input := &organizations.UntagResourceInput{
-				ResourceId: aws.String(d.Id()),
-				TagKeys:    remove,
-			}
-
-			log.Printf("[DEBUG] Removing Organizations Account (%s) tags: %s", d.Id(), input)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			if _, err := conn.UntagResource(input); err != nil {
-				return fmt.Errorf("error removing Organizations Account (%s) tags: %s", d.Id(), err)
-			}
-		}
-		if len(create) > 0 {
-			input := &organizations.TagResourceInput{
-				ResourceId: aws.String(d.Id()),
-				Tags:       create,
-			}
-
-			log.Printf("[DEBUG] Adding Organizations Account (%s) tags: %s", d.Id(), input)
-
-			if _, err := conn.TagResource(input); err != nil {
-				return fmt.Errorf("error updating Organizations Account (%s) tags: %s", d.Id(), err)
-			}
+		if err := keyvaluetags.OrganizationsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating AWS Organizations Account (%s) tags: %s", d.Id(), err)
 		}
 	}
 
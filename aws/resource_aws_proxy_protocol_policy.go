@@ -17,6 +17,17 @@ func resourceAwsProxyProtocolPolicy() *schema.Resource {
 		Read:   resourceAwsProxyProtocolPolicyRead,
 		Update: resourceAwsProxyProtocolPolicyUpdate,
 		Delete: resourceAwsProxyProtocolPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ":")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER-NAME:POLICY-NAME", d.Id())
+				}
+				lbName := idParts[0]
+				d.Set("load_balancer", lbName)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer": {
@@ -0,0 +1,1169 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsQuickSightDataSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightDataSourceCreate,
+		Read:   resourceAwsQuickSightDataSourceRead,
+		Update: resourceAwsQuickSightDataSourceUpdate,
+		Delete: resourceAwsQuickSightDataSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"credentials": {
+				Type:      schema.TypeList,
+				Optional:  true,
+				MaxItems:  1,
+				Sensitive: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_pair": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"username": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"data_source_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_elasticsearch": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"athena": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"work_group": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"aurora":            quickSightDataSourceDatabaseParametersSchema(),
+						"aurora_postgresql": quickSightDataSourceDatabaseParametersSchema(),
+						"aws_iot_analytics": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_set_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"jira": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"site_base_url": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"maria_db":   quickSightDataSourceDatabaseParametersSchema(),
+						"mysql":      quickSightDataSourceDatabaseParametersSchema(),
+						"postgresql": quickSightDataSourceDatabaseParametersSchema(),
+						"presto": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"host": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"rds": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"instance_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"redshift": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"database": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"host": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"s3": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"manifest_file_location": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_now": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"site_base_url": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"snowflake": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"host": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"warehouse": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"spark": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"sql_server": quickSightDataSourceDatabaseParametersSchema(),
+						"teradata":   quickSightDataSourceDatabaseParametersSchema(),
+						"twitter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_rows": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 16,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+
+			"ssl_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_ssl": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					quicksight.DataSourceTypeAmazonElasticsearch,
+					quicksight.DataSourceTypeAthena,
+					quicksight.DataSourceTypeAurora,
+					quicksight.DataSourceTypeAuroraPostgresql,
+					quicksight.DataSourceTypeAwsIotAnalytics,
+					quicksight.DataSourceTypeJira,
+					quicksight.DataSourceTypeMariadb,
+					quicksight.DataSourceTypeMysql,
+					quicksight.DataSourceTypePostgresql,
+					quicksight.DataSourceTypePresto,
+					quicksight.DataSourceTypeRedshift,
+					quicksight.DataSourceTypeS3,
+					quicksight.DataSourceTypeServicenow,
+					quicksight.DataSourceTypeSnowflake,
+					quicksight.DataSourceTypeSpark,
+					quicksight.DataSourceTypeSqlserver,
+					quicksight.DataSourceTypeTeradata,
+					quicksight.DataSourceTypeTwitter,
+				}, false),
+			},
+
+			"vpc_connection_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_connection_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// quickSightDataSourceDatabaseParametersSchema returns the schema shared by
+// the parameters of data sources identified by a database, host and port.
+func quickSightDataSourceDatabaseParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"host": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightDataSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	dataSourceID := d.Get("data_source_id").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	input := &quicksight.CreateDataSourceInput{
+		AwsAccountId:         aws.String(awsAccountID),
+		DataSourceId:         aws.String(dataSourceID),
+		DataSourceParameters: expandQuickSightDataSourceParameters(d.Get("parameters").([]interface{})),
+		Name:                 aws.String(d.Get("name").(string)),
+		Type:                 aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("credentials"); ok {
+		input.Credentials = expandQuickSightDataSourceCredentials(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("ssl_properties"); ok {
+		input.SslProperties = expandQuickSightDataSourceSslProperties(v.([]interface{}))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().QuicksightTags()
+	}
+
+	if v, ok := d.GetOk("vpc_connection_properties"); ok {
+		input.VpcConnectionProperties = expandQuickSightDataSourceVpcConnectionProperties(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Data Source: %s", input)
+	_, err := conn.CreateDataSource(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating QuickSight Data Source (%s): %s", dataSourceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountID, dataSourceID))
+
+	if _, err := waiter.DataSourceCreated(conn, awsAccountID, dataSourceID); err != nil {
+		return fmt.Errorf("error waiting for QuickSight Data Source (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsQuickSightDataSourceRead(d, meta)
+}
+
+func resourceAwsQuickSightDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	dataSource, err := finder.DataSourceByID(conn, awsAccountID, dataSourceID)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Data Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading QuickSight Data Source (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(dataSource.Arn)
+	d.Set("arn", arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("data_source_id", dataSource.DataSourceId)
+	d.Set("name", dataSource.Name)
+	d.Set("type", dataSource.Type)
+
+	if err := d.Set("parameters", flattenQuickSightDataSourceParameters(dataSource.DataSourceParameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	if err := d.Set("ssl_properties", flattenQuickSightDataSourceSslProperties(dataSource.SslProperties)); err != nil {
+		return fmt.Errorf("error setting ssl_properties: %s", err)
+	}
+
+	if err := d.Set("vpc_connection_properties", flattenQuickSightDataSourceVpcConnectionProperties(dataSource.VpcConnectionProperties)); err != nil {
+		return fmt.Errorf("error setting vpc_connection_properties: %s", err)
+	}
+
+	permissionsOutput, err := conn.DescribeDataSourcePermissions(&quicksight.DescribeDataSourcePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading QuickSight Data Source (%s) permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightDataSourcePermissions(permissionsOutput.Permissions)); err != nil {
+		return fmt.Errorf("error setting permission: %s", err)
+	}
+
+	tags, err := keyvaluetags.QuicksightListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for QuickSight Data Source (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("credentials") || d.HasChange("name") || d.HasChange("parameters") || d.HasChange("ssl_properties") || d.HasChange("vpc_connection_properties") {
+		input := &quicksight.UpdateDataSourceInput{
+			AwsAccountId:         aws.String(awsAccountID),
+			DataSourceId:         aws.String(dataSourceID),
+			DataSourceParameters: expandQuickSightDataSourceParameters(d.Get("parameters").([]interface{})),
+			Name:                 aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("credentials"); ok {
+			input.Credentials = expandQuickSightDataSourceCredentials(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("ssl_properties"); ok {
+			input.SslProperties = expandQuickSightDataSourceSslProperties(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("vpc_connection_properties"); ok {
+			input.VpcConnectionProperties = expandQuickSightDataSourceVpcConnectionProperties(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Data Source (%s): %s", d.Id(), input)
+		_, err := conn.UpdateDataSource(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating QuickSight Data Source (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waiter.DataSourceUpdated(conn, awsAccountID, dataSourceID); err != nil {
+			return fmt.Errorf("error waiting for QuickSight Data Source (%s) to update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		grants, revokes := diffQuickSightDataSourcePermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateDataSourcePermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSourceId: aws.String(dataSourceID),
+		}
+
+		if len(grants) > 0 {
+			input.GrantPermissions = grants
+		}
+
+		if len(revokes) > 0 {
+			input.RevokePermissions = revokes
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Data Source (%s) permissions: %s", d.Id(), input)
+		_, err := conn.UpdateDataSourcePermissions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating QuickSight Data Source (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.QuicksightUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating QuickSight Data Source (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsQuickSightDataSourceRead(d, meta)
+}
+
+func resourceAwsQuickSightDataSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Data Source: %s", d.Id())
+	_, err = conn.DeleteDataSource(&quicksight.DeleteDataSourceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	})
+
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting QuickSight Data Source (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSourceParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SOURCE_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func expandQuickSightDataSourceCredentials(vCredentials []interface{}) *quicksight.DataSourceCredentials {
+	if len(vCredentials) == 0 || vCredentials[0] == nil {
+		return nil
+	}
+
+	vCredentialPair, ok := vCredentials[0].(map[string]interface{})["credential_pair"].([]interface{})
+
+	if !ok || len(vCredentialPair) == 0 || vCredentialPair[0] == nil {
+		return nil
+	}
+
+	mCredentialPair := vCredentialPair[0].(map[string]interface{})
+
+	return &quicksight.DataSourceCredentials{
+		CredentialPair: &quicksight.CredentialPair{
+			Password: aws.String(mCredentialPair["password"].(string)),
+			Username: aws.String(mCredentialPair["username"].(string)),
+		},
+	}
+}
+
+// quickSightDataSourceParametersBlock returns the named single-item block from a parameters map, or nil if it is not set.
+func quickSightDataSourceParametersBlock(mParameters map[string]interface{}, key string) map[string]interface{} {
+	v, ok := mParameters[key].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	return v[0].(map[string]interface{})
+}
+
+func expandQuickSightDataSourceParameters(vParameters []interface{}) *quicksight.DataSourceParameters {
+	if len(vParameters) == 0 || vParameters[0] == nil {
+		return nil
+	}
+
+	mParameters := vParameters[0].(map[string]interface{})
+	parameters := &quicksight.DataSourceParameters{}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "amazon_elasticsearch"); m != nil {
+		parameters.AmazonElasticsearchParameters = &quicksight.AmazonElasticsearchParameters{
+			Domain: aws.String(m["domain"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "athena"); m != nil {
+		parameters.AthenaParameters = &quicksight.AthenaParameters{}
+
+		if v, ok := m["work_group"].(string); ok && v != "" {
+			parameters.AthenaParameters.WorkGroup = aws.String(v)
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "aurora"); m != nil {
+		parameters.AuroraParameters = &quicksight.AuroraParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "aurora_postgresql"); m != nil {
+		parameters.AuroraPostgreSqlParameters = &quicksight.AuroraPostgreSqlParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "aws_iot_analytics"); m != nil {
+		parameters.AwsIotAnalyticsParameters = &quicksight.AwsIotAnalyticsParameters{
+			DataSetName: aws.String(m["data_set_name"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "jira"); m != nil {
+		parameters.JiraParameters = &quicksight.JiraParameters{
+			SiteBaseUrl: aws.String(m["site_base_url"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "maria_db"); m != nil {
+		parameters.MariaDbParameters = &quicksight.MariaDbParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "mysql"); m != nil {
+		parameters.MySqlParameters = &quicksight.MySqlParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "postgresql"); m != nil {
+		parameters.PostgreSqlParameters = &quicksight.PostgreSqlParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "presto"); m != nil {
+		parameters.PrestoParameters = &quicksight.PrestoParameters{
+			Catalog: aws.String(m["catalog"].(string)),
+			Host:    aws.String(m["host"].(string)),
+			Port:    aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "rds"); m != nil {
+		parameters.RdsParameters = &quicksight.RdsParameters{
+			Database:   aws.String(m["database"].(string)),
+			InstanceId: aws.String(m["instance_id"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "redshift"); m != nil {
+		parameters.RedshiftParameters = &quicksight.RedshiftParameters{
+			Database: aws.String(m["database"].(string)),
+		}
+
+		if v, ok := m["cluster_id"].(string); ok && v != "" {
+			parameters.RedshiftParameters.ClusterId = aws.String(v)
+		}
+
+		if v, ok := m["host"].(string); ok && v != "" {
+			parameters.RedshiftParameters.Host = aws.String(v)
+		}
+
+		if v, ok := m["port"].(int); ok && v != 0 {
+			parameters.RedshiftParameters.Port = aws.Int64(int64(v))
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "s3"); m != nil {
+		if mManifestFileLocation := quickSightDataSourceParametersBlock(m, "manifest_file_location"); mManifestFileLocation != nil {
+			parameters.S3Parameters = &quicksight.S3Parameters{
+				ManifestFileLocation: &quicksight.ManifestFileLocation{
+					Bucket: aws.String(mManifestFileLocation["bucket"].(string)),
+					Key:    aws.String(mManifestFileLocation["key"].(string)),
+				},
+			}
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "service_now"); m != nil {
+		parameters.ServiceNowParameters = &quicksight.ServiceNowParameters{
+			SiteBaseUrl: aws.String(m["site_base_url"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "snowflake"); m != nil {
+		parameters.SnowflakeParameters = &quicksight.SnowflakeParameters{
+			Database:  aws.String(m["database"].(string)),
+			Host:      aws.String(m["host"].(string)),
+			Warehouse: aws.String(m["warehouse"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "spark"); m != nil {
+		parameters.SparkParameters = &quicksight.SparkParameters{
+			Host: aws.String(m["host"].(string)),
+			Port: aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "sql_server"); m != nil {
+		parameters.SqlServerParameters = &quicksight.SqlServerParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "teradata"); m != nil {
+		parameters.TeradataParameters = &quicksight.TeradataParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParametersBlock(mParameters, "twitter"); m != nil {
+		parameters.TwitterParameters = &quicksight.TwitterParameters{
+			MaxRows: aws.Int64(int64(m["max_rows"].(int))),
+			Query:   aws.String(m["query"].(string)),
+		}
+	}
+
+	return parameters
+}
+
+func expandQuickSightDataSourcePermissions(vPermissions []interface{}) []*quicksight.ResourcePermission {
+	permissions := []*quicksight.ResourcePermission{}
+
+	for _, vPermission := range vPermissions {
+		mPermission, ok := vPermission.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		permissions = append(permissions, &quicksight.ResourcePermission{
+			Actions:   expandStringSet(mPermission["actions"].(*schema.Set)),
+			Principal: aws.String(mPermission["principal"].(string)),
+		})
+	}
+
+	return permissions
+}
+
+func expandQuickSightDataSourceSslProperties(vSslProperties []interface{}) *quicksight.SslProperties {
+	if len(vSslProperties) == 0 || vSslProperties[0] == nil {
+		return nil
+	}
+
+	return &quicksight.SslProperties{
+		DisableSsl: aws.Bool(vSslProperties[0].(map[string]interface{})["disable_ssl"].(bool)),
+	}
+}
+
+func expandQuickSightDataSourceVpcConnectionProperties(vVpcConnectionProperties []interface{}) *quicksight.VpcConnectionProperties {
+	if len(vVpcConnectionProperties) == 0 || vVpcConnectionProperties[0] == nil {
+		return nil
+	}
+
+	return &quicksight.VpcConnectionProperties{
+		VpcConnectionArn: aws.String(vVpcConnectionProperties[0].(map[string]interface{})["vpc_connection_arn"].(string)),
+	}
+}
+
+// diffQuickSightDataSourcePermissions returns the permissions to grant and revoke
+// to move from the old permission set to the new one.
+func diffQuickSightDataSourcePermissions(oldPermissions, newPermissions []interface{}) ([]*quicksight.ResourcePermission, []*quicksight.ResourcePermission) {
+	oldActions := map[string]*schema.Set{}
+	for _, permission := range expandQuickSightDataSourcePermissions(oldPermissions) {
+		oldActions[aws.StringValue(permission.Principal)] = schema.NewSet(schema.HashString, flattenStringList(permission.Actions))
+	}
+
+	newActions := map[string]*schema.Set{}
+	for _, permission := range expandQuickSightDataSourcePermissions(newPermissions) {
+		newActions[aws.StringValue(permission.Principal)] = schema.NewSet(schema.HashString, flattenStringList(permission.Actions))
+	}
+
+	var grants, revokes []*quicksight.ResourcePermission
+
+	for principal, actions := range newActions {
+		if oldSet, ok := oldActions[principal]; ok {
+			actions = actions.Difference(oldSet)
+		}
+
+		if actions.Len() > 0 {
+			grants = append(grants, &quicksight.ResourcePermission{
+				Actions:   expandStringSet(actions),
+				Principal: aws.String(principal),
+			})
+		}
+	}
+
+	for principal, actions := range oldActions {
+		if newSet, ok := newActions[principal]; ok {
+			actions = actions.Difference(newSet)
+		}
+
+		if actions.Len() > 0 {
+			revokes = append(revokes, &quicksight.ResourcePermission{
+				Actions:   expandStringSet(actions),
+				Principal: aws.String(principal),
+			})
+		}
+	}
+
+	return grants, revokes
+}
+
+func flattenQuickSightDataSourceParameters(parameters *quicksight.DataSourceParameters) []interface{} {
+	if parameters == nil {
+		return []interface{}{}
+	}
+
+	mParameters := map[string]interface{}{}
+
+	if v := parameters.AmazonElasticsearchParameters; v != nil {
+		mParameters["amazon_elasticsearch"] = []interface{}{
+			map[string]interface{}{
+				"domain": aws.StringValue(v.Domain),
+			},
+		}
+	}
+
+	if v := parameters.AthenaParameters; v != nil {
+		mParameters["athena"] = []interface{}{
+			map[string]interface{}{
+				"work_group": aws.StringValue(v.WorkGroup),
+			},
+		}
+	}
+
+	if v := parameters.AuroraParameters; v != nil {
+		mParameters["aurora"] = []interface{}{
+			map[string]interface{}{
+				"database": aws.StringValue(v.Database),
+				"host":     aws.StringValue(v.Host),
+				"port":     int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.AuroraPostgreSqlParameters; v != nil {
+		mParameters["aurora_postgresql"] = []interface{}{
+			map[string]interface{}{
+				"database": aws.StringValue(v.Database),
+				"host":     aws.StringValue(v.Host),
+				"port":     int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.AwsIotAnalyticsParameters; v != nil {
+		mParameters["aws_iot_analytics"] = []interface{}{
+			map[string]interface{}{
+				"data_set_name": aws.StringValue(v.DataSetName),
+			},
+		}
+	}
+
+	if v := parameters.JiraParameters; v != nil {
+		mParameters["jira"] = []interface{}{
+			map[string]interface{}{
+				"site_base_url": aws.StringValue(v.SiteBaseUrl),
+			},
+		}
+	}
+
+	if v := parameters.MariaDbParameters; v != nil {
+		mParameters["maria_db"] = []interface{}{
+			map[string]interface{}{
+				"database": aws.StringValue(v.Database),
+				"host":     aws.StringValue(v.Host),
+				"port":     int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.MySqlParameters; v != nil {
+		mParameters["mysql"] = []interface{}{
+			map[string]interface{}{
+				"database": aws.StringValue(v.Database),
+				"host":     aws.StringValue(v.Host),
+				"port":     int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.PostgreSqlParameters; v != nil {
+		mParameters["postgresql"] = []interface{}{
+			map[string]interface{}{
+				"database": aws.StringValue(v.Database),
+				"host":     aws.StringValue(v.Host),
+				"port":     int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.PrestoParameters; v != nil {
+		mParameters["presto"] = []interface{}{
+			map[string]interface{}{
+				"catalog": aws.StringValue(v.Catalog),
+				"host":    aws.StringValue(v.Host),
+				"port":    int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.RdsParameters; v != nil {
+		mParameters["rds"] = []interface{}{
+			map[string]interface{}{
+				"database":    aws.StringValue(v.Database),
+				"instance_id": aws.StringValue(v.InstanceId),
+			},
+		}
+	}
+
+	if v := parameters.RedshiftParameters; v != nil {
+		mParameters["redshift"] = []interface{}{
+			map[string]interface{}{
+				"cluster_id": aws.StringValue(v.ClusterId),
+				"database":   aws.StringValue(v.Database),
+				"host":       aws.StringValue(v.Host),
+				"port":       int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.S3Parameters; v != nil && v.ManifestFileLocation != nil {
+		mParameters["s3"] = []interface{}{
+			map[string]interface{}{
+				"manifest_file_location": []interface{}{
+					map[string]interface{}{
+						"bucket": aws.StringValue(v.ManifestFileLocation.Bucket),
+						"key":    aws.StringValue(v.ManifestFileLocation.Key),
+					},
+				},
+			},
+		}
+	}
+
+	if v := parameters.ServiceNowParameters; v != nil {
+		mParameters["service_now"] = []interface{}{
+			map[string]interface{}{
+				"site_base_url": aws.StringValue(v.SiteBaseUrl),
+			},
+		}
+	}
+
+	if v := parameters.SnowflakeParameters; v != nil {
+		mParameters["snowflake"] = []interface{}{
+			map[string]interface{}{
+				"database":  aws.StringValue(v.Database),
+				"host":      aws.StringValue(v.Host),
+				"warehouse": aws.StringValue(v.Warehouse),
+			},
+		}
+	}
+
+	if v := parameters.SparkParameters; v != nil {
+		mParameters["spark"] = []interface{}{
+			map[string]interface{}{
+				"host": aws.StringValue(v.Host),
+				"port": int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.SqlServerParameters; v != nil {
+		mParameters["sql_server"] = []interface{}{
+			map[string]interface{}{
+				"database": aws.StringValue(v.Database),
+				"host":     aws.StringValue(v.Host),
+				"port":     int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.TeradataParameters; v != nil {
+		mParameters["teradata"] = []interface{}{
+			map[string]interface{}{
+				"database": aws.StringValue(v.Database),
+				"host":     aws.StringValue(v.Host),
+				"port":     int(aws.Int64Value(v.Port)),
+			},
+		}
+	}
+
+	if v := parameters.TwitterParameters; v != nil {
+		mParameters["twitter"] = []interface{}{
+			map[string]interface{}{
+				"max_rows": int(aws.Int64Value(v.MaxRows)),
+				"query":    aws.StringValue(v.Query),
+			},
+		}
+	}
+
+	return []interface{}{mParameters}
+}
+
+func flattenQuickSightDataSourcePermissions(permissions []*quicksight.ResourcePermission) []interface{} {
+	vPermissions := []interface{}{}
+
+	for _, permission := range permissions {
+		if permission == nil {
+			continue
+		}
+
+		vPermissions = append(vPermissions, map[string]interface{}{
+			"actions":   flattenStringSet(permission.Actions),
+			"principal": aws.StringValue(permission.Principal),
+		})
+	}
+
+	return vPermissions
+}
+
+func flattenQuickSightDataSourceSslProperties(sslProperties *quicksight.SslProperties) []interface{} {
+	if sslProperties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disable_ssl": aws.BoolValue(sslProperties.DisableSsl),
+		},
+	}
+}
+
+func flattenQuickSightDataSourceVpcConnectionProperties(vpcConnectionProperties *quicksight.VpcConnectionProperties) []interface{} {
+	if vpcConnectionProperties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vpc_connection_arn": aws.StringValue(vpcConnectionProperties.VpcConnectionArn),
+		},
+	}
+}
@@ -0,0 +1,416 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_quicksight_data_source", &sweep.Sweeper{
+		Name: "aws_quicksight_data_source",
+		F:    testSweepQuickSightDataSources,
+	})
+}
+
+func testSweepQuickSightDataSources(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).quicksightconn
+	awsAccountID := client.(*AWSClient).accountid
+	input := &quicksight.ListDataSourcesInput{
+		AwsAccountId: aws.String(awsAccountID),
+	}
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListDataSourcesPages(input, func(page *quicksight.ListDataSourcesOutput, lastPage bool) bool {
+		for _, dataSource := range page.DataSources {
+			dataSourceID := aws.StringValue(dataSource.DataSourceId)
+
+			log.Printf("[INFO] Deleting QuickSight Data Source: %s", dataSourceID)
+			_, err := conn.DeleteDataSource(&quicksight.DeleteDataSourceInput{
+				AwsAccountId: aws.String(awsAccountID),
+				DataSourceId: aws.String(dataSourceID),
+			})
+
+			if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting QuickSight Data Source (%s): %s", dataSourceID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping QuickSight Data Source sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing QuickSight Data Sources: %s", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSQuickSightDataSource_basic(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("datasource/%s", rName)),
+					testAccCheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "data_source_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameters.0.s3.0.manifest_file_location.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameters.0.s3.0.manifest_file_location.0.key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeS3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName, fmt.Sprintf("%s-updated", rName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-updated", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_disappears(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					testAccCheckQuickSightDataSourceDisappears(&dataSource),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_Permissions(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfigPermissions(rName, `"quicksight:DescribeDataSource", "quicksight:DescribeDataSourcePermissions", "quicksight:PassDataSource"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfigPermissions(rName, `"quicksight:DeleteDataSource", "quicksight:DescribeDataSource", "quicksight:DescribeDataSourcePermissions", "quicksight:PassDataSource", "quicksight:UpdateDataSource", "quicksight:UpdateDataSourcePermissions"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_Tags(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightDataSourceExists(resourceName string, dataSource *quicksight.DataSource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		output, err := finder.DataSourceByID(conn, awsAccountID, dataSourceID)
+
+		if err != nil {
+			return err
+		}
+
+		*dataSource = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightDataSourceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_source" {
+			continue
+		}
+
+		awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.DataSourceByID(conn, awsAccountID, dataSourceID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Data Source %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckQuickSightDataSourceDisappears(v *quicksight.DataSource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		input := &quicksight.DeleteDataSourceInput{
+			AwsAccountId: aws.String(testAccProvider.Meta().(*AWSClient).accountid),
+			DataSourceId: v.DataSourceId,
+		}
+
+		_, err := conn.DeleteDataSource(input)
+
+		return err
+	}
+}
+
+func testAccAWSQuickSightDataSourceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  acl    = "public-read"
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "test" {
+  acl    = "public-read"
+  bucket = "${aws_s3_bucket.test.bucket}"
+  key    = "%[1]s-manifest.json"
+
+  content = <<EOF
+{
+  "fileLocations": [
+    {
+      "URIPrefixes": [
+        "https://${aws_s3_bucket.test.bucket_domain_name}"
+      ]
+    }
+  ],
+  "globalUploadSettings": {
+    "format": "CSV",
+    "delimiter": ",",
+    "textqualifier": "\"",
+    "containsHeader": "true"
+  }
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSQuickSightDataSourceConfig(rId, rName string) string {
+	return testAccAWSQuickSightDataSourceConfigBase(rId) + fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = "${aws_s3_bucket.test.bucket}"
+        key    = "${aws_s3_bucket_object.test.key}"
+      }
+    }
+  }
+
+  type = "S3"
+}
+`, rId, rName)
+}
+
+func testAccAWSQuickSightDataSourceConfigPermissions(rName, actions string) string {
+	return testAccAWSQuickSightDataSourceConfigBase(rName) + testAccAWSQuickSightUserConfig(rName) + fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[1]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = "${aws_s3_bucket.test.bucket}"
+        key    = "${aws_s3_bucket_object.test.key}"
+      }
+    }
+  }
+
+  permission {
+    actions   = [%[2]s]
+    principal = "${aws_quicksight_user.default.arn}"
+  }
+
+  type = "S3"
+}
+`, rName, actions)
+}
+
+func testAccAWSQuickSightDataSourceConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSQuickSightDataSourceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[1]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = "${aws_s3_bucket.test.bucket}"
+        key    = "${aws_s3_bucket_object.test.key}"
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  type = "S3"
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSQuickSightDataSourceConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSQuickSightDataSourceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[1]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = "${aws_s3_bucket.test.bucket}"
+        key    = "${aws_s3_bucket_object.test.key}"
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  type = "S3"
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
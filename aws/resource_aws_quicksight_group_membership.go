@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func resourceAwsQuickSightGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightGroupMembershipCreate,
+		Read:   resourceAwsQuickSightGroupMembershipRead,
+		Delete: resourceAwsQuickSightGroupMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "default",
+				ValidateFunc: validation.StringInSlice([]string{
+					"default",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	namespace := d.Get("namespace").(string)
+	groupName := d.Get("group_name").(string)
+	memberName := d.Get("member_name").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	createOpts := &quicksight.CreateGroupMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		GroupName:    aws.String(groupName),
+		MemberName:   aws.String(memberName),
+		Namespace:    aws.String(namespace),
+	}
+
+	_, err := conn.CreateGroupMembership(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error adding Quick Sight User (%s) to Group (%s): %s", memberName, groupName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", awsAccountID, namespace, groupName, memberName))
+
+	return resourceAwsQuickSightGroupMembershipRead(d, meta)
+}
+
+func resourceAwsQuickSightGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, groupName, memberName, err := resourceAwsQuickSightGroupMembershipParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := findQuickSightGroupMember(conn, awsAccountID, namespace, groupName, memberName)
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Quick Sight Group %s/%s/%s is already gone", awsAccountID, namespace, groupName)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing Quick Sight Group Memberships (%s): %s", d.Id(), err)
+	}
+
+	if member == nil {
+		log.Printf("[WARN] Quick Sight Group Membership %s is already gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", member.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("group_name", groupName)
+	d.Set("member_name", member.MemberName)
+	d.Set("namespace", namespace)
+
+	return nil
+}
+
+func resourceAwsQuickSightGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, groupName, memberName, err := resourceAwsQuickSightGroupMembershipParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteOpts := &quicksight.DeleteGroupMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		GroupName:    aws.String(groupName),
+		MemberName:   aws.String(memberName),
+		Namespace:    aws.String(namespace),
+	}
+
+	if _, err := conn.DeleteGroupMembership(deleteOpts); err != nil {
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Quick Sight Group Membership %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// findQuickSightGroupMember returns the named member of a group, or nil if the user is not a member.
+func findQuickSightGroupMember(conn *quicksight.QuickSight, awsAccountID, namespace, groupName, memberName string) (*quicksight.GroupMember, error) {
+	listOpts := &quicksight.ListGroupMembershipsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		GroupName:    aws.String(groupName),
+		Namespace:    aws.String(namespace),
+	}
+
+	for {
+		resp, err := conn.ListGroupMemberships(listOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range resp.GroupMemberList {
+			if aws.StringValue(member.MemberName) == memberName {
+				return member, nil
+			}
+		}
+
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+
+		listOpts.NextToken = resp.NextToken
+	}
+
+	return nil, nil
+}
+
+func resourceAwsQuickSightGroupMembershipParseID(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) < 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/NAMESPACE/GROUP_NAME/MEMBER_NAME", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
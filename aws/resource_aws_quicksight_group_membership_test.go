@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+)
+
+func TestResourceAwsQuickSightGroupMembershipParseID(t *testing.T) {
+	testCases := []struct {
+		Input              string
+		ExpectedAccountID  string
+		ExpectedNamespace  string
+		ExpectedGroupName  string
+		ExpectedMemberName string
+		ErrCount           int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "123456789012/default/group",
+			ErrCount: 1,
+		},
+		{
+			Input:    "123456789012/default//member",
+			ErrCount: 1,
+		},
+		{
+			Input:              "123456789012/default/group/member",
+			ExpectedAccountID:  "123456789012",
+			ExpectedNamespace:  "default",
+			ExpectedGroupName:  "group",
+			ExpectedMemberName: "member",
+			ErrCount:           0,
+		},
+		{
+			Input:              "123456789012/default/group/role/session",
+			ExpectedAccountID:  "123456789012",
+			ExpectedNamespace:  "default",
+			ExpectedGroupName:  "group",
+			ExpectedMemberName: "role/session",
+			ErrCount:           0,
+		},
+	}
+
+	for _, tc := range testCases {
+		awsAccountID, namespace, groupName, memberName, err := resourceAwsQuickSightGroupMembershipParseID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if awsAccountID != tc.ExpectedAccountID {
+			t.Fatalf("expected %q to return account ID %q, received: %q", tc.Input, tc.ExpectedAccountID, awsAccountID)
+		}
+		if namespace != tc.ExpectedNamespace {
+			t.Fatalf("expected %q to return namespace %q, received: %q", tc.Input, tc.ExpectedNamespace, namespace)
+		}
+		if groupName != tc.ExpectedGroupName {
+			t.Fatalf("expected %q to return group name %q, received: %q", tc.Input, tc.ExpectedGroupName, groupName)
+		}
+		if memberName != tc.ExpectedMemberName {
+			t.Fatalf("expected %q to return member name %q, received: %q", tc.Input, tc.ExpectedMemberName, memberName)
+		}
+	}
+}
+
+func TestAccAWSQuickSightGroupMembership_basic(t *testing.T) {
+	var member quicksight.GroupMember
+	resourceName := "aws_quicksight_group_membership.default"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightGroupMembershipConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightGroupMembershipExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "member_name", rName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("user/default/%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightGroupMembership_disappears(t *testing.T) {
+	var member quicksight.GroupMember
+	resourceName := "aws_quicksight_group_membership.default"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightGroupMembershipConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightGroupMembershipExists(resourceName, &member),
+					testAccCheckQuickSightGroupMembershipDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightGroupMembershipExists(resourceName string, member *quicksight.GroupMember) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, namespace, groupName, memberName, err := resourceAwsQuickSightGroupMembershipParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		output, err := findQuickSightGroupMember(conn, awsAccountID, namespace, groupName, memberName)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Quick Sight Group Membership (%s) not found", rs.Primary.ID)
+		}
+
+		*member = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightGroupMembershipDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_group_membership" {
+			continue
+		}
+
+		awsAccountID, namespace, groupName, memberName, err := resourceAwsQuickSightGroupMembershipParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		member, err := findQuickSightGroupMember(conn, awsAccountID, namespace, groupName, memberName)
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if member != nil {
+			return fmt.Errorf("Quick Sight Group Membership '%s' was not deleted properly", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckQuickSightGroupMembershipDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, namespace, groupName, memberName, err := resourceAwsQuickSightGroupMembershipParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		input := &quicksight.DeleteGroupMembershipInput{
+			AwsAccountId: aws.String(awsAccountID),
+			GroupName:    aws.String(groupName),
+			MemberName:   aws.String(memberName),
+			Namespace:    aws.String(namespace),
+		}
+
+		if _, err := conn.DeleteGroupMembership(input); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSQuickSightGroupMembershipConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_group" "default" {
+  group_name = %[1]q
+}
+
+resource "aws_quicksight_user" "default" {
+  user_name     = %[1]q
+  email         = "fakeemail@example.com"
+  identity_type = "QUICKSIGHT"
+  user_role     = "READER"
+}
+
+resource "aws_quicksight_group_membership" "default" {
+  group_name  = "${aws_quicksight_group.default.group_name}"
+  member_name = "${aws_quicksight_user.default.user_name}"
+}
+`, rName)
+}
@@ -11,6 +11,9 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/waiter"
 )
 
 func resourceAwsRDSClusterInstance() *schema.Resource {
@@ -24,11 +27,13 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(90 * time.Minute),
-			Update: schema.DefaultTimeout(90 * time.Minute),
-			Delete: schema.DefaultTimeout(90 * time.Minute),
+			Create: schema.DefaultTimeout(waiter.DBClusterInstanceCreateTimeout),
+			Update: schema.DefaultTimeout(waiter.DBClusterInstanceUpdateTimeout),
+			Delete: schema.DefaultTimeout(waiter.DBClusterInstanceDeleteTimeout),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -206,14 +211,15 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 				Default:  false,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
 
 func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().RdsTags()
 
 	createOpts := &rds.CreateDBInstanceInput{
 		DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
@@ -298,37 +304,26 @@ func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(*resp.DBInstance.DBInstanceIdentifier)
 
-	// reuse db_instance refresh func
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsRdsClusterInstanceCreateUpdatePendingStates,
-		Target:     []string{"available"},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
+	_, err = waiter.DBClusterInstanceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return fmt.Errorf("error waiting for RDS Cluster Instance (%s) creation: %s", d.Id(), err)
 	}
 
 	return resourceAwsRDSClusterInstanceRead(d, meta)
 }
 
 func resourceAwsRDSClusterInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	db, err := resourceAwsDbInstanceRetrieve(d.Id(), meta.(*AWSClient).rdsconn)
-	// Errors from this helper are always reportable
-	if err != nil {
-		return fmt.Errorf("Error on retrieving RDS Cluster Instance (%s): %s", d.Id(), err)
-	}
-	// A nil response means "not found"
-	if db == nil {
-		log.Printf("[WARN] RDS Cluster Instance (%s): not found, removing from state.", d.Id())
+	db, err := finder.DBInstanceByID(meta.(*AWSClient).rdsconn, d.Id())
+
+	if isResourceNotFoundError(err) {
+		log.Printf("[WARN] RDS Cluster Instance (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Cluster Instance (%s): %s", d.Id(), err)
+	}
 	// Database instance is not in RDS Cluster
 	if db.DBClusterIdentifier == nil {
 		return fmt.Errorf("Cluster identifier is missing from instance (%s). The aws_db_instance resource should be used for non-Aurora instances", d.Id())
@@ -396,8 +391,14 @@ func resourceAwsRDSClusterInstanceRead(d *schema.ResourceData, meta interface{})
 		d.Set("db_parameter_group_name", db.DBParameterGroups[0].DBParameterGroupName)
 	}
 
-	if err := saveTagsRDS(conn, d, aws.StringValue(db.DBInstanceArn)); err != nil {
-		log.Printf("[WARN] Failed to save tags for RDS Cluster Instance (%s): %s", *db.DBClusterIdentifier, err)
+	tags, err := keyvaluetags.RdsListTags(conn, aws.StringValue(db.DBInstanceArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for RDS Cluster Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	return nil
@@ -502,26 +503,18 @@ func resourceAwsRDSClusterInstanceUpdate(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("Error modifying DB Instance %s: %s", d.Id(), err)
 		}
 
-		// reuse db_instance refresh func
-		stateConf := &resource.StateChangeConf{
-			Pending:    resourceAwsRdsClusterInstanceCreateUpdatePendingStates,
-			Target:     []string{"available"},
-			Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			MinTimeout: 10 * time.Second,
-			Delay:      30 * time.Second, // Wait 30 secs before starting
-		}
-
-		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = waiter.DBClusterInstanceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return fmt.Errorf("error waiting for RDS Cluster Instance (%s) update: %s", d.Id(), err)
 		}
-
 	}
 
-	if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.RdsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return err
+		}
 	}
 
 	return resourceAwsRDSClusterInstanceRead(d, meta)
@@ -539,38 +532,10 @@ func resourceAwsRDSClusterInstanceDelete(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	// re-uses db_instance refresh func
 	log.Println("[INFO] Waiting for RDS Cluster Instance to be destroyed")
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsRdsClusterInstanceDeletePendingStates,
-		Target:     []string{},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
+	if _, err := waiter.DBClusterInstanceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for RDS Cluster Instance (%s) deletion: %s", d.Id(), err)
 	}
 
-	_, err := stateConf.WaitForState()
-	return err
-
-}
-
-var resourceAwsRdsClusterInstanceCreateUpdatePendingStates = []string{
-	"backing-up",
-	"configuring-enhanced-monitoring",
-	"configuring-log-exports",
-	"creating",
-	"maintenance",
-	"modifying",
-	"rebooting",
-	"renaming",
-	"resetting-master-credentials",
-	"starting",
-	"upgrading",
-}
-
-var resourceAwsRdsClusterInstanceDeletePendingStates = []string{
-	"configuring-log-exports",
-	"modifying",
-	"deleting",
+	return nil
 }
@@ -16,10 +16,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster", &sweep.Sweeper{
 		Name: "aws_rds_cluster",
 		F:    testSweepRdsClusters,
 		Dependencies: []string{
@@ -81,7 +83,11 @@ func testSweepRdsClusters(region string) error {
 				continue
 			}
 
-			if err := waitForRDSClusterDeletion(conn, id, 40*time.Minute); err != nil {
+			if testSweepDryRun() {
+				continue
+			}
+
+			if _, err := waiter.DBClusterDeleted(conn, id, 40*time.Minute); err != nil {
 				log.Printf("[ERROR] Failure while waiting for RDS DB Cluster (%s) to be deleted: %s", id, err)
 			}
 		}
@@ -29,6 +29,11 @@ func TestAccAWSRedshiftSnapshotCopyGrant_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_redshift_snapshot_copy_grant.basic", "kms_key_id"),
 				),
 			},
+			{
+				ResourceName:      "aws_redshift_snapshot_copy_grant.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_endpoint", &sweep.Sweeper{
 		Name: "aws_route53_resolver_endpoint",
 		F:    testSweepRoute53ResolverEndpoints,
 	})
@@ -47,6 +48,10 @@ func testSweepRoute53ResolverEndpoints(region string) error {
 				continue
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			err = route53ResolverEndpointWaitUntilTargetState(conn, id, 10*time.Minute,
 				[]string{route53resolver.ResolverEndpointStatusDeleting},
 				[]string{route53ResolverEndpointStatusDeleted})
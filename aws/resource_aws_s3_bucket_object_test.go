@@ -17,10 +17,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_bucket_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket_object", &sweep.Sweeper{
 		Name: "aws_s3_bucket_object",
 		F:    testSweepS3BucketObjects,
 	})
@@ -249,10 +250,28 @@ func TestAccAWSS3BucketObject_content(t *testing.T) {
 					testAccCheckAWSS3BucketObjectBody(&obj, "some_bucket_content"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSS3BucketObjectImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "content"},
+			},
 		},
 	})
 }
 
+func testAccAWSS3BucketObjectImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"]), nil
+	}
+}
+
 func TestAccAWSS3BucketObject_etagEncryption(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 const (
@@ -18,7 +19,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_model", &sweep.Sweeper{
 		Name: "aws_sagemaker_model",
 		F:    testSweepSagemakerModels,
 	})
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 const sagemakerTestAccSagemakerNotebookInstanceResourceNamePrefix = "terraform-testacc-"
@@ -270,7 +271,7 @@ func testAccCheckAWSSagemakerNotebookInstanceTags(notebook *sagemaker.DescribeNo
 			return fmt.Errorf("Error listing tags: %s", err)
 		}
 
-		m := tagsToMapSagemaker(ts.Tags)
+		m := keyvaluetags.SagemakerKeyValueTags(ts.Tags).IgnoreAws().Map()
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +16,9 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespace() *schema.Resource {
 		Create: resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate,
 		Read:   resourceAwsServiceDiscoveryPrivateDnsNamespaceRead,
 		Delete: resourceAwsServiceDiscoveryPrivateDnsNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsServiceDiscoveryPrivateDnsNamespaceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -102,6 +107,7 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespaceRead(d *schema.ResourceData,
 		return err
 	}
 
+	d.Set("name", resp.Namespace.Name)
 	d.Set("description", resp.Namespace.Description)
 	d.Set("arn", resp.Namespace.Arn)
 	if resp.Namespace.Properties != nil {
@@ -110,6 +116,19 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespaceRead(d *schema.ResourceData,
 	return nil
 }
 
+func resourceAwsServiceDiscoveryPrivateDnsNamespaceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NAMESPACE-ID:VPC-ID", d.Id())
+	}
+
+	// The VPC is not returned by the API, so it is part of the import ID
+	d.SetId(idParts[0])
+	d.Set("vpc", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsServiceDiscoveryPrivateDnsNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sdconn
 
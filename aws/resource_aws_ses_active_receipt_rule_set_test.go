@@ -24,6 +24,11 @@ func TestAccAWSSESActiveReceiptRuleSet_basic(t *testing.T) {
 					testAccCheckAwsSESActiveReceiptRuleSetExists("aws_ses_active_receipt_rule_set.test"),
 				),
 			},
+			{
+				ResourceName:      "aws_ses_active_receipt_rule_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
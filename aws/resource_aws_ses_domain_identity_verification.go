@@ -18,6 +18,9 @@ func resourceAwsSesDomainIdentityVerification() *schema.Resource {
 		Create: resourceAwsSesDomainIdentityVerificationCreate,
 		Read:   resourceAwsSesDomainIdentityVerificationRead,
 		Delete: resourceAwsSesDomainIdentityVerificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -38,6 +38,11 @@ func TestAccAwsSesDomainIdentityVerification_basic(t *testing.T) {
 				Config: testAccAwsSesDomainIdentityVerification_basic(rootDomain, domain),
 				Check:  testAccCheckAwsSesDomainIdentityVerificationPassed("aws_ses_domain_identity_verification.test"),
 			},
+			{
+				ResourceName:      "aws_ses_domain_identity_verification.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,6 +17,17 @@ func resourceAwsSnapshotCreateVolumePermission() *schema.Resource {
 		Create: resourceAwsSnapshotCreateVolumePermissionCreate,
 		Read:   resourceAwsSnapshotCreateVolumePermissionRead,
 		Delete: resourceAwsSnapshotCreateVolumePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				snapshotID, accountID, err := resourceAwsSnapshotCreateVolumePermissionParseID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("snapshot_id", snapshotID)
+				d.Set("account_id", accountID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"snapshot_id": {
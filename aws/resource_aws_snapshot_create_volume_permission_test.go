@@ -25,6 +25,11 @@ func TestAccAWSSnapshotCreateVolumePermission_Basic(t *testing.T) {
 					testAccAWSSnapshotCreateVolumePermissionExists(&accountId, &snapshotId),
 				),
 			},
+			{
+				ResourceName:      "aws_snapshot_create_volume_permission.self-test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Drop just create volume permission to test destruction
 			{
 				Config: testAccAWSSnapshotCreateVolumePermissionConfig(false, accountId),
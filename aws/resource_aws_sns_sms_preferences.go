@@ -33,6 +33,9 @@ func resourceAwsSnsSmsPreferences() *schema.Resource {
 		Read:   resourceAwsSnsSmsPreferencesGet,
 		Update: resourceAwsSnsSmsPreferencesSet,
 		Delete: resourceAwsSnsSmsPreferencesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"monthly_spend_limit": {
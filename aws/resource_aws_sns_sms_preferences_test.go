@@ -66,6 +66,11 @@ func testAccAWSSNSSMSPreferences_defaultSMSType(t *testing.T) {
 					resource.TestCheckNoResourceAttr("aws_sns_sms_preferences.test_pref", "usage_report_s3_bucket"),
 				),
 			},
+			{
+				ResourceName:      "aws_sns_sms_preferences.test_pref",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
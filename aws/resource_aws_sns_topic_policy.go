@@ -19,6 +19,9 @@ func resourceAwsSnsTopicPolicy() *schema.Resource {
 		Read:   resourceAwsSnsTopicPolicyRead,
 		Update: resourceAwsSnsTopicPolicyUpsert,
 		Delete: resourceAwsSnsTopicPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -90,6 +93,7 @@ func resourceAwsSnsTopicPolicyRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 
+	d.Set("arn", attrmap["TopicArn"])
 	d.Set("policy", policy)
 
 	return nil
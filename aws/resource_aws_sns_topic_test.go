@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
 )
 
 func TestAccAWSSNSTopic_importBasic(t *testing.T) {
@@ -699,3 +700,54 @@ resource "aws_sns_topic" "test_topic" {
 	}
 `, r, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func TestOfflineAWSSNSTopic_basic(t *testing.T) {
+	s := testOfflineServer()
+	defer s.Close()
+	fake := fakeaws.RegisterSNS(s)
+	resourceName := "aws_sns_topic.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testOfflineProviderFactories(),
+		CheckDestroy:      testOfflineCheckDestroyed("SNS Topics", fake.TopicARNs),
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(s) + testOfflineAWSSNSTopicConfig("original"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "arn", "arn:aws:sns:us-east-1:123456789012:tf-offline-test"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "original"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-offline-test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "original"),
+				),
+			},
+			{
+				Config:            testOfflineProviderConfig(s) + testOfflineAWSSNSTopicConfig("original"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testOfflineProviderConfig(s) + testOfflineAWSSNSTopicConfig("changed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "display_name", "changed"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "changed"),
+				),
+			},
+		},
+	})
+}
+
+func testOfflineAWSSNSTopicConfig(value string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name         = "tf-offline-test"
+  display_name = %[1]q
+
+  tags = {
+    Usage = %[1]q
+  }
+}
+`, value)
+}
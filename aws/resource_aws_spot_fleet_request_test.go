@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_fleet_request", &sweep.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    testSweepSpotFleetRequests,
 	})
@@ -39,6 +40,14 @@ func testSweepSpotFleetRequests(region string) error {
 			id := aws.StringValue(config.SpotFleetRequestId)
 
 			log.Printf("[INFO] Deleting Spot Fleet Request: %s", id)
+			if testSweepDryRun() {
+				sweepRunner.RecordDryRunRequest(ec2.ServiceName, "CancelSpotFleetRequests", &ec2.CancelSpotFleetRequestsInput{
+					SpotFleetRequestIds: aws.StringSlice([]string{id}),
+					TerminateInstances:  aws.Bool(true),
+				})
+				continue
+			}
+
 			err := deleteSpotFleetRequest(id, true, 5*time.Minute, conn)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Spot Fleet Request (%s): %s", id, err)
@@ -74,6 +83,12 @@ func TestAccAWSSpotFleetRequest_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_spot_fleet_request.foo", "excess_capacity_termination_policy", "Default"),
 				),
 			},
+			{
+				ResourceName:            "aws_spot_fleet_request.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+			},
 		},
 	})
 }
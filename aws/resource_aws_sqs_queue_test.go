@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
 )
 
 func TestAccAWSSQSQueue_basic(t *testing.T) {
@@ -714,3 +715,60 @@ resource "aws_sqs_queue" "queue" {
 }
 `, r)
 }
+
+func TestOfflineAWSSQSQueue_basic(t *testing.T) {
+	s := testOfflineServer()
+	defer s.Close()
+	fake := fakeaws.RegisterSQS(s)
+
+	resource.UnitTest(t, testOfflineAWSSQSQueueTestCase(s, testOfflineCheckDestroyed("SQS Queues", fake.QueueURLs)))
+}
+
+func testOfflineAWSSQSQueueTestCase(s *fakeaws.Server, checkDestroy resource.TestCheckFunc) resource.TestCase {
+	resourceName := "aws_sqs_queue.test"
+
+	return resource.TestCase{
+		ProviderFactories: testOfflineProviderFactories(),
+		CheckDestroy:      checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(s) + testOfflineAWSSQSQueueConfig(30, "original"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "arn", "arn:aws:sqs:us-east-1:123456789012:tf-offline-test"),
+					resource.TestCheckResourceAttr(resourceName, "delay_seconds", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-offline-test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "original"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "30"),
+				),
+			},
+			{
+				Config:            testOfflineProviderConfig(s) + testOfflineAWSSQSQueueConfig(30, "original"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testOfflineProviderConfig(s) + testOfflineAWSSQSQueueConfig(60, "changed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "changed"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "60"),
+				),
+			},
+		},
+	}
+}
+
+func testOfflineAWSSQSQueueConfig(visibilityTimeoutSeconds int, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                       = "tf-offline-test"
+  visibility_timeout_seconds = %d
+
+  tags = {
+    Usage = %q
+  }
+}
+`, visibilityTimeoutSeconds, tagValue)
+}
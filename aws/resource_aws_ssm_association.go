@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -17,6 +18,9 @@ func resourceAwsSsmAssociation() *schema.Resource {
 		Read:   resourceAwsSsmAssociationRead,
 		Update: resourceAwsSsmAssociationUpdate,
 		Delete: resourceAwsSsmAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		MigrateState:  resourceAwsSsmAssociationMigrateState,
 		SchemaVersion: 1,
@@ -205,7 +209,9 @@ func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("association_name", association.AssociationName)
 	d.Set("instance_id", association.InstanceId)
 	d.Set("name", association.Name)
-	d.Set("parameters", association.Parameters)
+	if err := d.Set("parameters", flattenSSMDocumentParameters(association.Parameters)); err != nil {
+		return fmt.Errorf("Error setting parameters error: %#v", err)
+	}
 	d.Set("association_id", association.AssociationId)
 	d.Set("schedule_expression", association.ScheduleExpression)
 	d.Set("document_version", association.DocumentVersion)
@@ -307,6 +313,15 @@ func expandSSMDocumentParameters(params map[string]interface{}) map[string][]*st
 	return docParams
 }
 
+func flattenSSMDocumentParameters(params map[string][]*string) map[string]string {
+	docParams := make(map[string]string, len(params))
+	for k, v := range params {
+		docParams[k] = strings.Join(aws.StringValueSlice(v), ",")
+	}
+
+	return docParams
+}
+
 func expandSSMAssociationOutputLocation(config []interface{}) *ssm.InstanceAssociationOutputLocation {
 	if config == nil {
 		return nil
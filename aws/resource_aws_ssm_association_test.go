@@ -56,6 +56,11 @@ func TestAccAWSSSMAssociation_basic(t *testing.T) {
 					testAccCheckAWSSSMAssociationExists("aws_ssm_association.foo"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_association.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				PreConfig: deleteSsmAssociaton,
 				Config:    testAccAWSSSMAssociationBasicConfig(name),
@@ -147,6 +152,11 @@ func TestAccAWSSSMAssociation_withParameters(t *testing.T) {
 						"aws_ssm_association.foo", "parameters.Directory", "myWorkSpace"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_association.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSSSMAssociationBasicConfigWithParametersUpdated(name),
 				Check: resource.ComposeTestCheckFunc(
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -17,6 +18,9 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 		Read:   resourceAwsSsmMaintenanceWindowTargetRead,
 		Update: resourceAwsSsmMaintenanceWindowTargetUpdate,
 		Delete: resourceAwsSsmMaintenanceWindowTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmMaintenanceWindowTargetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"window_id": {
@@ -197,3 +201,18 @@ func resourceAwsSsmMaintenanceWindowTargetDelete(d *schema.ResourceData, meta in
 
 	return nil
 }
+
+func resourceAwsSsmMaintenanceWindowTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <window-id>/<window-target-id>", d.Id())
+	}
+
+	windowID := idParts[0]
+	windowTargetID := idParts[1]
+
+	d.Set("window_id", windowID)
+	d.SetId(windowTargetID)
+
+	return []*schema.ResourceData{d}, nil
+}
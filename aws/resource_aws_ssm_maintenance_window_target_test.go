@@ -35,6 +35,12 @@ func TestAccAWSSSMMaintenanceWindowTarget_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_ssm_maintenance_window_target.target", "description", "This resource is for test purpose only"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_maintenance_window_target.target",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSSMMaintenanceWindowTargetImportStateIdFunc("aws_ssm_maintenance_window_target.target"),
+				ImportStateVerify: true,
+			},
 			{
 				ResourceName:      "aws_ssm_maintenance_window.foo",
 				ImportState:       true,
@@ -44,6 +50,17 @@ func TestAccAWSSSMMaintenanceWindowTarget_basic(t *testing.T) {
 	})
 }
 
+func testAccAWSSSMMaintenanceWindowTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["window_id"], rs.Primary.ID), nil
+	}
+}
+
 func TestAccAWSSSMMaintenanceWindowTarget_noNameOrDescription(t *testing.T) {
 	name := acctest.RandString(10)
 	resource.ParallelTest(t, resource.TestCase{
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsSsmParameter() *schema.Resource {
@@ -79,7 +80,8 @@ func resourceAwsSsmParameter() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -88,6 +90,7 @@ func resourceAwsSsmParameter() *schema.Resource {
 			customdiff.ForceNewIfChange("tier", func(old, new, meta interface{}) bool {
 				return old.(string) == ssm.ParameterTierAdvanced && new.(string) == ssm.ParameterTierStandard
 			}),
+			setTagsDiff,
 		),
 	}
 }
@@ -162,7 +165,9 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	}); err != nil {
 		return fmt.Errorf("Failed to get SSM parameter tags for %s: %s", d.Get("name"), err)
 	} else {
-		d.Set("tags", tagsToMapSSM(tagList.TagList))
+		if err := setTagsAll(d, meta, keyvaluetags.SsmKeyValueTags(tagList.TagList).IgnoreAws().Map()); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
 	}
 
 	arn := arn.ARN{
@@ -228,8 +233,12 @@ func resourceAwsSsmParameterPut(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error creating SSM parameter: %s", err)
 	}
 
-	if err := setTagsSSM(ssmconn, d, d.Get("name").(string), "Parameter"); err != nil {
-		return fmt.Errorf("error creating SSM parameter tags: %s", err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.SsmUpdateTags(ssmconn, d.Get("name").(string), "Parameter", o, n); err != nil {
+			return fmt.Errorf("error creating SSM parameter tags: %s", err)
+		}
 	}
 
 	d.SetId(d.Get("name").(string))
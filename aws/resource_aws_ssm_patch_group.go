@@ -14,6 +14,9 @@ func resourceAwsSsmPatchGroup() *schema.Resource {
 		Create: resourceAwsSsmPatchGroupCreate,
 		Read:   resourceAwsSsmPatchGroupRead,
 		Delete: resourceAwsSsmPatchGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"baseline_id": {
@@ -10,6 +10,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
 )
 
 func resourceAwsSubnet() *schema.Resource {
@@ -23,13 +26,15 @@ func resourceAwsSubnet() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(20 * time.Minute),
+			Create: schema.DefaultTimeout(waiter.SubnetCreateTimeout),
+			Delete: schema.DefaultTimeout(waiter.SubnetDeleteTimeout),
 		},
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsSubnetMigrateState,
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -87,7 +92,8 @@ func resourceAwsSubnet() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"owner_id": {
 				Type:     schema.TypeString,
@@ -125,14 +131,7 @@ func resourceAwsSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 
 	// Wait for the Subnet to become available
 	log.Printf("[DEBUG] Waiting for subnet (%s) to become available", *subnet.SubnetId)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pending"},
-		Target:  []string{"available"},
-		Refresh: SubnetStateRefreshFunc(conn, *subnet.SubnetId),
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-
-	_, err = stateConf.WaitForState()
+	_, err = waiter.SubnetAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf(
@@ -146,23 +145,17 @@ func resourceAwsSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	resp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
-		SubnetIds: []*string{aws.String(d.Id())},
-	})
+	subnet, err := finder.SubnetByID(conn, d.Id())
 
-	if err != nil {
-		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidSubnetID.NotFound" {
-			// Update state to indicate the subnet no longer exists.
-			d.SetId("")
-			return nil
-		}
-		return err
-	}
-	if resp == nil {
+	if isResourceNotFoundError(err) {
+		log.Printf("[WARN] Subnet (%s) not found, removing from state", d.Id())
+		d.SetId("")
 		return nil
 	}
 
-	subnet := resp.Subnets[0]
+	if err != nil {
+		return fmt.Errorf("error reading Subnet (%s): %s", d.Id(), err)
+	}
 
 	d.Set("vpc_id", subnet.VpcId)
 	d.Set("availability_zone", subnet.AvailabilityZone)
@@ -184,7 +177,9 @@ func resourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("arn", subnet.SubnetArn)
-	d.Set("tags", tagsToMap(subnet.Tags))
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(subnet.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 	d.Set("owner_id", subnet.OwnerId)
 
 	return nil
@@ -199,6 +194,7 @@ func resourceAwsSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("map_public_ip_on_launch") {
@@ -361,32 +357,6 @@ func resourceAwsSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-// SubnetStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch a Subnet.
-func SubnetStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
-			SubnetIds: []*string{aws.String(id)},
-		})
-		if err != nil {
-			if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidSubnetID.NotFound" {
-				resp = nil
-			} else {
-				log.Printf("Error on SubnetStateRefresh: %s", err)
-				return nil, "", err
-			}
-		}
-
-		if resp == nil {
-			// Sometimes AWS just has consistency issues and doesn't see
-			// our instance yet. Return an empty state.
-			return nil, "", nil
-		}
-
-		subnet := resp.Subnets[0]
-		return subnet, *subnet.State, nil
-	}
-}
-
 func SubnetIpv6CidrStateRefreshFunc(conn *ec2.EC2, id string, associationId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		opts := &ec2.DescribeSubnetsInput{
@@ -34,10 +34,27 @@ func TestAccAWSVolumeAttachment_basic(t *testing.T) {
 						"aws_volume_attachment.ebs_att", &i, &v),
 				),
 			},
+			{
+				ResourceName:      "aws_volume_attachment.ebs_att",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSVolumeAttachmentImportStateIdFunc("aws_volume_attachment.ebs_att"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccAWSVolumeAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s:%s", rs.Primary.Attributes["device_name"], rs.Primary.Attributes["volume_id"], rs.Primary.Attributes["instance_id"]), nil
+	}
+}
+
 func TestAccAWSVolumeAttachment_skipDestroy(t *testing.T) {
 	var i ec2.Instance
 	var v ec2.Volume
@@ -1,11 +1,13 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 )
 
 func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
@@ -14,6 +16,9 @@ func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
 		Read:   resourceAwsVpcDhcpOptionsAssociationRead,
 		Update: resourceAwsVpcDhcpOptionsAssociationUpdate,
 		Delete: resourceAwsVpcDhcpOptionsAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpcDhcpOptionsAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -57,17 +62,16 @@ func resourceAwsVpcDhcpOptionsAssociationCreate(d *schema.ResourceData, meta int
 func resourceAwsVpcDhcpOptionsAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	// Get the VPC that this association belongs to
-	vpcRaw, _, err := VPCStateRefreshFunc(conn, d.Get("vpc_id").(string))()
+	vpc, err := finder.VpcByID(conn, d.Get("vpc_id").(string))
 
-	if err != nil {
-		return err
+	if isResourceNotFoundError(err) {
+		return nil
 	}
 
-	if vpcRaw == nil {
-		return nil
+	if err != nil {
+		return fmt.Errorf("error reading VPC (%s): %s", d.Get("vpc_id").(string), err)
 	}
 
-	vpc := vpcRaw.(*ec2.Vpc)
 	if *vpc.VpcId != d.Get("vpc_id") || *vpc.DhcpOptionsId != d.Get("dhcp_options_id") {
 		log.Printf("[INFO] It seems the DHCP Options association is gone. Deleting reference from Graph...")
 		d.SetId("")
@@ -76,6 +80,27 @@ func resourceAwsVpcDhcpOptionsAssociationRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceAwsVpcDhcpOptionsAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	// The import ID is the VPC ID
+	vpc, err := finder.VpcByID(conn, d.Id())
+
+	if isResourceNotFoundError(err) {
+		return nil, fmt.Errorf("VPC (%s) not found", d.Id())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading VPC (%s): %s", d.Id(), err)
+	}
+
+	d.Set("vpc_id", vpc.VpcId)
+	d.Set("dhcp_options_id", vpc.DhcpOptionsId)
+	d.SetId(aws.StringValue(vpc.DhcpOptionsId) + "-" + aws.StringValue(vpc.VpcId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // DHCP Options Asociations cannot be updated.
 func resourceAwsVpcDhcpOptionsAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceAwsVpcDhcpOptionsAssociationCreate(d, meta)
@@ -26,10 +26,27 @@ func TestAccAWSDHCPOptionsAssociation_basic(t *testing.T) {
 					testAccCheckDHCPOptionsAssociationExist("aws_vpc_dhcp_options_association.foo", &v),
 				),
 			},
+			{
+				ResourceName:      "aws_vpc_dhcp_options_association.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccDHCPOptionsAssociationVPCImportIdFunc("aws_vpc_dhcp_options_association.foo"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccDHCPOptionsAssociationVPCImportIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["vpc_id"], nil
+	}
+}
+
 func testAccCheckDHCPOptionsAssociationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
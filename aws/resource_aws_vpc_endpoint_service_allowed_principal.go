@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -15,6 +16,21 @@ func resourceAwsVpcEndpointServiceAllowedPrincipal() *schema.Resource {
 		Create: resourceAwsVpcEndpointServiceAllowedPrincipalCreate,
 		Read:   resourceAwsVpcEndpointServiceAllowedPrincipalRead,
 		Delete: resourceAwsVpcEndpointServiceAllowedPrincipalDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Principal ARNs can contain slashes, so only split on the first one
+				idParts := strings.SplitN(d.Id(), "/", 2)
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPC-ENDPOINT-SERVICE-ID/PRINCIPAL-ARN", d.Id())
+				}
+				svcId := idParts[0]
+				arn := idParts[1]
+				d.Set("vpc_endpoint_service_id", svcId)
+				d.Set("principal_arn", arn)
+				d.SetId(vpcEndpointServiceIdPrincipalArnHash(svcId, arn))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_endpoint_service_id": {
@@ -27,10 +27,27 @@ func TestAccAWSVpcEndpointServiceAllowedPrincipal_basic(t *testing.T) {
 					testAccCheckVpcEndpointServiceAllowedPrincipalExists("aws_vpc_endpoint_service_allowed_principal.foo"),
 				),
 			},
+			{
+				ResourceName:      "aws_vpc_endpoint_service_allowed_principal.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSVpcEndpointServiceAllowedPrincipalImportStateIdFunc("aws_vpc_endpoint_service_allowed_principal.foo"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccAWSVpcEndpointServiceAllowedPrincipalImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["vpc_endpoint_service_id"], rs.Primary.Attributes["principal_arn"]), nil
+	}
+}
+
 func testAccCheckVpcEndpointServiceAllowedPrincipalDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &sweep.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    testSweepEc2VpcEndpointServices,
 		Dependencies: []string{
@@ -65,6 +66,10 @@ func testSweepEc2VpcEndpointServices(region string) error {
 				return fmt.Errorf("error deleting EC2 VPC Endpoint Service (%s): %s", id, err)
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			if err := waitForVpcEndpointServiceDeletion(conn, id); err != nil {
 				return fmt.Errorf("error waiting for VPC Endpoint Service (%s) to delete: %s", id, err)
 			}
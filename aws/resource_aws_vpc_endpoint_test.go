@@ -15,10 +15,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &sweep.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    testSweepEc2VpcEndpoints,
 	})
@@ -66,6 +67,10 @@ func testSweepEc2VpcEndpoints(region string) error {
 				return fmt.Errorf("error deleting EC2 VPC Endpoint (%s): %s", id, err)
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			if err := vpcEndpointWaitUntilDeleted(conn, id, 10*time.Minute); err != nil {
 				return fmt.Errorf("error waiting for VPC Endpoint (%s) to delete: %s", id, err)
 			}
@@ -21,6 +21,19 @@ func resourceAwsVpnConnectionRoute() *schema.Resource {
 		Create: resourceAwsVpnConnectionRouteCreate,
 		Read:   resourceAwsVpnConnectionRouteRead,
 		Delete: resourceAwsVpnConnectionRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), ":")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected DESTINATION-CIDR-BLOCK:VPN-CONNECTION-ID", d.Id())
+				}
+				cidrBlock := idParts[0]
+				vpnConnectionId := idParts[1]
+				d.Set("destination_cidr_block", cidrBlock)
+				d.Set("vpn_connection_id", vpnConnectionId)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"destination_cidr_block": {
@@ -14,10 +14,11 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_connection", &sweep.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    testSweepEc2VpnConnections,
 	})
@@ -65,6 +66,10 @@ func testSweepEc2VpnConnections(region string) error {
 			return fmt.Errorf("error deleting EC2 VPN Connection (%s): %s", id, err)
 		}
 
+		if testSweepDryRun() {
+			continue
+		}
+
 		if err := waitForEc2VpnConnectionDeletion(conn, id); err != nil {
 			return fmt.Errorf("error waiting for VPN connection (%s) to delete: %s", id, err)
 		}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,20 @@ func resourceAwsVpnGatewayAttachment() *schema.Resource {
 		Create: resourceAwsVpnGatewayAttachmentCreate,
 		Read:   resourceAwsVpnGatewayAttachmentRead,
 		Delete: resourceAwsVpnGatewayAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPC-ID/VPN-GATEWAY-ID", d.Id())
+				}
+				vpcId := idParts[0]
+				vgwId := idParts[1]
+				d.Set("vpc_id", vpcId)
+				d.Set("vpn_gateway_id", vgwId)
+				d.SetId(vpnGatewayAttachmentId(vpcId, vgwId))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -34,10 +34,27 @@ func TestAccAWSVpnGatewayAttachment_basic(t *testing.T) {
 						&vpc, &vgw),
 				),
 			},
+			{
+				ResourceName:      "aws_vpn_gateway_attachment.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSVpnGatewayAttachmentImportStateIdFunc("aws_vpn_gateway_attachment.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccAWSVpnGatewayAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["vpc_id"], rs.Primary.Attributes["vpn_gateway_id"]), nil
+	}
+}
+
 func TestAccAWSVpnGatewayAttachment_deleted(t *testing.T) {
 	var vpc ec2.Vpc
 	var vgw ec2.VpnGateway
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -14,6 +15,19 @@ func resourceAwsVpnGatewayRoutePropagation() *schema.Resource {
 		Create: resourceAwsVpnGatewayRoutePropagationEnable,
 		Read:   resourceAwsVpnGatewayRoutePropagationRead,
 		Delete: resourceAwsVpnGatewayRoutePropagationDisable,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "_")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPN-GATEWAY-ID_ROUTE-TABLE-ID", d.Id())
+				}
+				gwID := idParts[0]
+				rtID := idParts[1]
+				d.Set("vpn_gateway_id", gwID)
+				d.Set("route_table_id", rtID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
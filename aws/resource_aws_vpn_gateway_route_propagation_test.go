@@ -53,6 +53,11 @@ func TestAccAWSVPNGatewayRoutePropagation_basic(t *testing.T) {
 					return nil
 				},
 			},
+			{
+				ResourceName:      "aws_vpn_gateway_route_propagation.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 		CheckDestroy: func(state *terraform.State) error {
 			conn := testAccProvider.Meta().(*AWSClient).ec2conn
@@ -11,11 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // add sweeper to delete known test VPN Gateways
 func init() {
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &sweep.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    testSweepVPNGateways,
 		Dependencies: []string{
@@ -72,6 +73,10 @@ func testSweepVPNGateways(region string) error {
 				return fmt.Errorf("error detaching VPN Gateway (%s) from VPC (%s): %s", aws.StringValue(vpng.VpnGatewayId), aws.StringValue(vpcAttachment.VpcId), err)
 			}
 
+			if testSweepDryRun() {
+				continue
+			}
+
 			stateConf := &resource.StateChangeConf{
 				Pending: []string{"attached", "detaching"},
 				Target:  []string{"detached"},
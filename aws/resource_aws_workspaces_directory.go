@@ -0,0 +1,327 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsWorkspacesDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesDirectoryCreate,
+		Read:   resourceAwsWorkspacesDirectoryRead,
+		Update: resourceAwsWorkspacesDirectoryUpdate,
+		Delete: resourceAwsWorkspacesDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"directory_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_ip_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"iam_role_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"registration_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"workspace_creation_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_ou": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_internet_access": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_work_docs": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"user_enabled_as_local_administrator": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"workspace_security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsWorkspacesDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	directoryID := d.Get("directory_id").(string)
+	input := &workspaces.RegisterWorkspaceDirectoryInput{
+		DirectoryId:    aws.String(directoryID),
+		EnableWorkDocs: aws.Bool(false),
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().WorkspacesTags()
+	}
+
+	log.Printf("[DEBUG] Registering WorkSpaces Directory: %s", input)
+	_, err := conn.RegisterWorkspaceDirectory(input)
+
+	if err != nil {
+		return fmt.Errorf("error registering WorkSpaces Directory (%s): %s", directoryID, err)
+	}
+
+	d.SetId(directoryID)
+
+	if _, err := waiter.DirectoryRegistered(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Directory (%s) to be registered: %s", d.Id(), err)
+	}
+
+	if v := d.Get("ip_group_ids").(*schema.Set); v.Len() > 0 {
+		if err := workspacesAssociateIpGroups(conn, d.Id(), v); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsWorkspacesDirectoryRead(d, meta)
+}
+
+func resourceAwsWorkspacesDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	directory, err := finder.DirectoryByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Directory (%s): %s", d.Id(), err)
+	}
+
+	d.Set("alias", directory.Alias)
+	d.Set("customer_user_name", directory.CustomerUserName)
+	d.Set("directory_id", directory.DirectoryId)
+	d.Set("directory_name", directory.DirectoryName)
+	d.Set("directory_type", directory.DirectoryType)
+	d.Set("iam_role_id", directory.IamRoleId)
+	d.Set("registration_code", directory.RegistrationCode)
+	d.Set("state", directory.State)
+	d.Set("workspace_security_group_id", directory.WorkspaceSecurityGroupId)
+
+	if err := d.Set("dns_ip_addresses", flattenStringSet(directory.DnsIpAddresses)); err != nil {
+		return fmt.Errorf("error setting dns_ip_addresses: %s", err)
+	}
+
+	if err := d.Set("ip_group_ids", flattenStringSet(directory.IpGroupIds)); err != nil {
+		return fmt.Errorf("error setting ip_group_ids: %s", err)
+	}
+
+	if err := d.Set("subnet_ids", flattenStringSet(directory.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+
+	if err := d.Set("workspace_creation_properties", flattenWorkspacesDefaultWorkspaceCreationProperties(directory.WorkspaceCreationProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_creation_properties: %s", err)
+	}
+
+	tags, err := keyvaluetags.WorkspacesListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces Directory (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("ip_group_ids") {
+		o, n := d.GetChange("ip_group_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			if err := workspacesDisassociateIpGroups(conn, d.Id(), del); err != nil {
+				return err
+			}
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			if err := workspacesAssociateIpGroups(conn, d.Id(), add); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.WorkspacesUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpaces Directory (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWorkspacesDirectoryRead(d, meta)
+}
+
+func resourceAwsWorkspacesDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if v := d.Get("ip_group_ids").(*schema.Set); v.Len() > 0 {
+		log.Printf("[DEBUG] Disassociating WorkSpaces Directory (%s) IP Groups", d.Id())
+		_, err := conn.DisassociateIpGroups(&workspaces.DisassociateIpGroupsInput{
+			DirectoryId: aws.String(d.Id()),
+			GroupIds:    expandStringSet(v),
+		})
+
+		// The IP groups may have been deleted outside Terraform, so only a
+		// missing directory, reported by the deregistration below, ends the
+		// deletion early.
+		if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Unable to disassociate WorkSpaces Directory (%s) IP Groups: %s", d.Id(), err)
+		} else if err != nil {
+			return fmt.Errorf("error disassociating WorkSpaces Directory (%s) IP Groups: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deregistering WorkSpaces Directory: %s", d.Id())
+	_, err := conn.DeregisterWorkspaceDirectory(&workspaces.DeregisterWorkspaceDirectoryInput{
+		DirectoryId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering WorkSpaces Directory (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.DirectoryDeregistered(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Directory (%s) to be deregistered: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func workspacesAssociateIpGroups(conn *workspaces.WorkSpaces, directoryID string, groupIDs *schema.Set) error {
+	input := &workspaces.AssociateIpGroupsInput{
+		DirectoryId: aws.String(directoryID),
+		GroupIds:    expandStringSet(groupIDs),
+	}
+
+	log.Printf("[DEBUG] Associating WorkSpaces IP Groups: %s", input)
+	_, err := conn.AssociateIpGroups(input)
+
+	if err != nil {
+		return fmt.Errorf("error associating WorkSpaces Directory (%s) IP Groups: %s", directoryID, err)
+	}
+
+	return nil
+}
+
+func workspacesDisassociateIpGroups(conn *workspaces.WorkSpaces, directoryID string, groupIDs *schema.Set) error {
+	input := &workspaces.DisassociateIpGroupsInput{
+		DirectoryId: aws.String(directoryID),
+		GroupIds:    expandStringSet(groupIDs),
+	}
+
+	log.Printf("[DEBUG] Disassociating WorkSpaces IP Groups: %s", input)
+	_, err := conn.DisassociateIpGroups(input)
+
+	if err != nil {
+		return fmt.Errorf("error disassociating WorkSpaces Directory (%s) IP Groups: %s", directoryID, err)
+	}
+
+	return nil
+}
+
+func flattenWorkspacesDefaultWorkspaceCreationProperties(apiObject *workspaces.DefaultWorkspaceCreationProperties) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"custom_security_group_id":            aws.StringValue(apiObject.CustomSecurityGroupId),
+		"default_ou":                          aws.StringValue(apiObject.DefaultOu),
+		"enable_internet_access":              aws.BoolValue(apiObject.EnableInternetAccess),
+		"enable_work_docs":                    aws.BoolValue(apiObject.EnableWorkDocs),
+		"user_enabled_as_local_administrator": aws.BoolValue(apiObject.UserEnabledAsLocalAdministrator),
+	}
+
+	return []interface{}{tfMap}
+}
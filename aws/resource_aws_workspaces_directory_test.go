@@ -0,0 +1,351 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_workspaces_directory", &sweep.Sweeper{
+		Name: "aws_workspaces_directory",
+		F:    testSweepWorkspacesDirectories,
+		Dependencies: []string{
+			"aws_workspaces_workspace",
+		},
+	})
+}
+
+func testSweepWorkspacesDirectories(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).workspacesconn
+	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.DescribeWorkspaceDirectories(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpaces Directory sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing WorkSpaces Directories: %s", err))
+			return sweeperErrs
+		}
+
+		for _, directory := range output.Directories {
+			id := aws.StringValue(directory.DirectoryId)
+
+			if aws.StringValue(directory.State) != workspaces.WorkspaceDirectoryStateRegistered {
+				continue
+			}
+
+			if len(directory.IpGroupIds) > 0 {
+				_, err := conn.DisassociateIpGroups(&workspaces.DisassociateIpGroupsInput{
+					DirectoryId: aws.String(id),
+					GroupIds:    directory.IpGroupIds,
+				})
+
+				if err != nil && !isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error disassociating WorkSpaces Directory (%s) IP Groups: %s", id, err))
+					continue
+				}
+			}
+
+			log.Printf("[INFO] Deregistering WorkSpaces Directory: %s", id)
+			_, err := conn.DeregisterWorkspaceDirectory(&workspaces.DeregisterWorkspaceDirectoryInput{
+				DirectoryId: aws.String(id),
+			})
+
+			if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deregistering WorkSpaces Directory (%s): %s", id, err))
+				continue
+			}
+
+			if testSweepDryRun() {
+				continue
+			}
+
+			if _, err := waiter.DirectoryDeregistered(conn, id); err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error waiting for WorkSpaces Directory (%s) to be deregistered: %s", id, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+// These tests create the account-wide workspaces_DefaultRole IAM role, so
+// they are not run in parallel.
+
+func TestAccAwsWorkspacesDirectory_basic(t *testing.T) {
+	var directory workspaces.WorkspaceDirectory
+	resourceName := "aws_workspaces_directory.test"
+	directoryResourceName := "aws_directory_service_directory.test"
+	ipGroupResourceName := "aws_workspaces_ip_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspacesDirectoryConfigIpGroups(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &directory),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", directoryResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_name", directoryResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "directory_type", workspaces.WorkspaceDirectoryTypeSimpleAd),
+					resource.TestCheckResourceAttrSet(resourceName, "registration_code"),
+					resource.TestCheckResourceAttr(resourceName, "state", workspaces.WorkspaceDirectoryStateRegistered),
+					resource.TestCheckResourceAttr(resourceName, "ip_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "ip_group_ids.0", ipGroupResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_creation_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspacesDirectoryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &directory),
+					resource.TestCheckResourceAttr(resourceName, "ip_group_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesDirectory_disappears(t *testing.T) {
+	var directory workspaces.WorkspaceDirectory
+	resourceName := "aws_workspaces_directory.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspacesDirectoryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &directory),
+					testAccCheckAwsWorkspacesDirectoryDisappears(&directory),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWorkspacesDirectoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_directory" {
+			continue
+		}
+
+		_, err := finder.DirectoryByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Directory %s is still registered", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesDirectoryDisappears(directory *workspaces.WorkspaceDirectory) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+		id := aws.StringValue(directory.DirectoryId)
+
+		_, err := conn.DeregisterWorkspaceDirectory(&workspaces.DeregisterWorkspaceDirectoryInput{
+			DirectoryId: aws.String(id),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = waiter.DirectoryDeregistered(conn, id)
+
+		return err
+	}
+}
+
+func testAccCheckAwsWorkspacesDirectoryExists(n string, v *workspaces.WorkspaceDirectory) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Directory ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		directory, err := finder.DirectoryByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *directory
+
+		return nil
+	}
+}
+
+func testAccWorkspacesDirectoryConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+  cidr_block        = "10.0.${count.index}.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_directory_service_directory" "test" {
+  name     = "corp.notexample.com"
+  password = "SuperSecretPassw0rd"
+  size     = "Small"
+
+  vpc_settings {
+    subnet_ids = ["${aws_subnet.test.*.id}"]
+    vpc_id     = "${aws_vpc.test.id}"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "workspaces" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["workspaces.amazonaws.com"]
+    }
+  }
+}
+
+# WorkSpaces requires this role to exist before a directory can be registered.
+resource "aws_iam_role" "workspaces_default" {
+  name               = "workspaces_DefaultRole"
+  assume_role_policy = "${data.aws_iam_policy_document.workspaces.json}"
+}
+
+resource "aws_iam_role_policy_attachment" "workspaces_default_service_access" {
+  role       = "${aws_iam_role.workspaces_default.name}"
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonWorkSpacesServiceAccess"
+}
+
+resource "aws_iam_role_policy_attachment" "workspaces_default_self_service_access" {
+  role       = "${aws_iam_role.workspaces_default.name}"
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonWorkSpacesSelfServiceAccess"
+}
+`, rName)
+}
+
+func testAccWorkspacesDirectoryConfigBasic(rName string) string {
+	return testAccWorkspacesDirectoryConfigBase(rName) + `
+resource "aws_workspaces_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+  subnet_ids   = ["${aws_subnet.test.*.id}"]
+
+  depends_on = [
+    "aws_iam_role_policy_attachment.workspaces_default_service_access",
+    "aws_iam_role_policy_attachment.workspaces_default_self_service_access",
+  ]
+}
+`
+}
+
+func testAccWorkspacesDirectoryConfigIpGroups(rName string) string {
+	return testAccWorkspacesDirectoryConfigBase(rName) + fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name = %[1]q
+
+  rules {
+    source = "10.0.0.0/16"
+  }
+}
+
+resource "aws_workspaces_directory" "test" {
+  directory_id = "${aws_directory_service_directory.test.id}"
+  ip_group_ids = ["${aws_workspaces_ip_group.test.id}"]
+  subnet_ids   = ["${aws_subnet.test.*.id}"]
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [
+    "aws_iam_role_policy_attachment.workspaces_default_service_access",
+    "aws_iam_role_policy_attachment.workspaces_default_self_service_access",
+  ]
+}
+`, rName)
+}
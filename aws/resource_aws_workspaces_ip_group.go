@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsWorkspacesIpGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesIpGroupCreate,
+		Read:   resourceAwsWorkspacesIpGroupRead,
+		Update: resourceAwsWorkspacesIpGroupUpdate,
+		Delete: resourceAwsWorkspacesIpGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsWorkspacesIpGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.CreateIpGroupInput{
+		GroupName: aws.String(d.Get("name").(string)),
+		UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.GroupDesc = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().WorkspacesTags()
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces IP Group: %s", input)
+	output, err := conn.CreateIpGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces IP Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.GroupId))
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	ipGroup, err := finder.IpGroupByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces IP Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", ipGroup.GroupDesc)
+	d.Set("name", ipGroup.GroupName)
+
+	if err := d.Set("rules", flattenWorkspacesIpGroupRules(ipGroup.UserRules)); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	tags, err := keyvaluetags.WorkspacesListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesIpGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("rules") {
+		input := &workspaces.UpdateRulesOfIpGroupInput{
+			GroupId:   aws.String(d.Id()),
+			UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces IP Group rules: %s", input)
+		_, err := conn.UpdateRulesOfIpGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating WorkSpaces IP Group (%s) rules: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.WorkspacesUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpaces IP Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces IP Group: %s", d.Id())
+	_, err := conn.DeleteIpGroup(&workspaces.DeleteIpGroupInput{
+		GroupId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWorkspacesIpGroupRules(tfList []interface{}) []*workspaces.IpRuleItem {
+	apiObjects := []*workspaces.IpRuleItem{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &workspaces.IpRuleItem{
+			IpRule: aws.String(tfMap["source"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.RuleDesc = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenWorkspacesIpGroupRules(apiObjects []*workspaces.IpRuleItem) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description": aws.StringValue(apiObject.RuleDesc),
+			"source":      aws.StringValue(apiObject.IpRule),
+		})
+	}
+
+	return tfList
+}
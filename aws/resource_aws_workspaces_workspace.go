@@ -0,0 +1,393 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsWorkspacesWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesWorkspaceCreate,
+		Read:   resourceAwsWorkspacesWorkspaceRead,
+		Update: resourceAwsWorkspacesWorkspaceUpdate,
+		Delete: resourceAwsWorkspacesWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.WorkspaceAvailableTimeout),
+			Update: schema.DefaultTimeout(waiter.WorkspaceUpdatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.WorkspaceTerminatedTimeout),
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"computer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"root_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"volume_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workspace_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_type_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.ComputeValue,
+								workspaces.ComputeStandard,
+								workspaces.ComputePerformance,
+								workspaces.ComputePower,
+								workspaces.ComputeGraphics,
+								workspaces.ComputePowerpro,
+								workspaces.ComputeGraphicspro,
+							}, false),
+						},
+						"root_volume_size_gib": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(80),
+						},
+						"running_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.RunningModeAlwaysOn,
+								workspaces.RunningModeAutoStop,
+							}, false),
+						},
+						"running_mode_auto_stop_timeout_in_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								val := v.(int)
+								if val%60 != 0 {
+									errors = append(errors, fmt.Errorf("%q should be configured in 60-minute intervals, got: %d", k, val))
+								}
+								return
+							},
+						},
+						"user_volume_size_gib": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(10),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWorkspacesWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	request := &workspaces.WorkspaceRequest{
+		BundleId:                    aws.String(d.Get("bundle_id").(string)),
+		DirectoryId:                 aws.String(d.Get("directory_id").(string)),
+		RootVolumeEncryptionEnabled: aws.Bool(d.Get("root_volume_encryption_enabled").(bool)),
+		UserName:                    aws.String(d.Get("user_name").(string)),
+		UserVolumeEncryptionEnabled: aws.Bool(d.Get("user_volume_encryption_enabled").(bool)),
+		WorkspaceProperties:         expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("volume_encryption_key"); ok {
+		request.VolumeEncryptionKey = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		request.Tags = keyvaluetags.New(v).IgnoreAws().WorkspacesTags()
+	}
+
+	input := &workspaces.CreateWorkspacesInput{
+		Workspaces: []*workspaces.WorkspaceRequest{request},
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpace: %s", input)
+	output, err := conn.CreateWorkspaces(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpace: %s", err)
+	}
+
+	if len(output.FailedRequests) > 0 {
+		failedRequest := output.FailedRequests[0]
+		return fmt.Errorf("error creating WorkSpace: %s: %s", aws.StringValue(failedRequest.ErrorCode), aws.StringValue(failedRequest.ErrorMessage))
+	}
+
+	if len(output.PendingRequests) == 0 || output.PendingRequests[0] == nil {
+		return fmt.Errorf("error creating WorkSpace: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.PendingRequests[0].WorkspaceId))
+
+	if _, err := waiter.WorkspaceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpace (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	workspace, err := finder.WorkspaceByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpace (%s): %s", d.Id(), err)
+	}
+
+	if state := aws.StringValue(workspace.State); state == workspaces.WorkspaceStateTerminating || state == workspaces.WorkspaceStateTerminated {
+		log.Printf("[WARN] WorkSpace (%s) is %s, removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bundle_id", workspace.BundleId)
+	d.Set("computer_name", workspace.ComputerName)
+	d.Set("directory_id", workspace.DirectoryId)
+	d.Set("ip_address", workspace.IpAddress)
+	d.Set("root_volume_encryption_enabled", workspace.RootVolumeEncryptionEnabled)
+	d.Set("state", workspace.State)
+	d.Set("user_name", workspace.UserName)
+	d.Set("user_volume_encryption_enabled", workspace.UserVolumeEncryptionEnabled)
+	d.Set("volume_encryption_key", workspace.VolumeEncryptionKey)
+
+	if err := d.Set("workspace_properties", flattenWorkspacesWorkspaceProperties(workspace.WorkspaceProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_properties: %s", err)
+	}
+
+	tags, err := keyvaluetags.WorkspacesListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpace (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	// WorkSpace properties must be modified one at a time.
+	if d.HasChange("workspace_properties.0.compute_type_name") {
+		properties := &workspaces.WorkspaceProperties{
+			ComputeTypeName: aws.String(d.Get("workspace_properties.0.compute_type_name").(string)),
+		}
+
+		if err := workspacesModifyWorkspaceProperties(conn, d.Id(), properties, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.root_volume_size_gib") {
+		properties := &workspaces.WorkspaceProperties{
+			RootVolumeSizeGib: aws.Int64(int64(d.Get("workspace_properties.0.root_volume_size_gib").(int))),
+		}
+
+		if err := workspacesModifyWorkspaceProperties(conn, d.Id(), properties, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.running_mode") || d.HasChange("workspace_properties.0.running_mode_auto_stop_timeout_in_minutes") {
+		runningMode := d.Get("workspace_properties.0.running_mode").(string)
+		properties := &workspaces.WorkspaceProperties{
+			RunningMode: aws.String(runningMode),
+		}
+
+		if v, ok := d.GetOk("workspace_properties.0.running_mode_auto_stop_timeout_in_minutes"); ok && runningMode == workspaces.RunningModeAutoStop {
+			properties.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v.(int)))
+		}
+
+		if err := workspacesModifyWorkspaceProperties(conn, d.Id(), properties, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.user_volume_size_gib") {
+		properties := &workspaces.WorkspaceProperties{
+			UserVolumeSizeGib: aws.Int64(int64(d.Get("workspace_properties.0.user_volume_size_gib").(int))),
+		}
+
+		if err := workspacesModifyWorkspaceProperties(conn, d.Id(), properties, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := keyvaluetags.WorkspacesUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpace (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	log.Printf("[DEBUG] Terminating WorkSpace: %s", d.Id())
+	output, err := conn.TerminateWorkspaces(&workspaces.TerminateWorkspacesInput{
+		TerminateWorkspaceRequests: []*workspaces.TerminateRequest{
+			{
+				WorkspaceId: aws.String(d.Id()),
+			},
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("error terminating WorkSpace (%s): %s", d.Id(), err)
+	}
+
+	if len(output.FailedRequests) > 0 {
+		failedRequest := output.FailedRequests[0]
+		return fmt.Errorf("error terminating WorkSpace (%s): %s: %s", d.Id(), aws.StringValue(failedRequest.ErrorCode), aws.StringValue(failedRequest.ErrorMessage))
+	}
+
+	if _, err := waiter.WorkspaceTerminated(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpace (%s) to be terminated: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func workspacesModifyWorkspaceProperties(conn *workspaces.WorkSpaces, id string, properties *workspaces.WorkspaceProperties, timeout time.Duration) error {
+	input := &workspaces.ModifyWorkspacePropertiesInput{
+		WorkspaceId:         aws.String(id),
+		WorkspaceProperties: properties,
+	}
+
+	log.Printf("[DEBUG] Modifying WorkSpace properties: %s", input)
+	_, err := conn.ModifyWorkspaceProperties(input)
+
+	if err != nil {
+		return fmt.Errorf("error modifying WorkSpace (%s) properties: %s", id, err)
+	}
+
+	if _, err := waiter.WorkspaceUpdated(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for WorkSpace (%s) properties update: %s", id, err)
+	}
+
+	return nil
+}
+
+func expandWorkspacesWorkspaceProperties(tfList []interface{}) *workspaces.WorkspaceProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &workspaces.WorkspaceProperties{}
+
+	if v, ok := tfMap["compute_type_name"].(string); ok && v != "" {
+		apiObject.ComputeTypeName = aws.String(v)
+	}
+
+	if v, ok := tfMap["root_volume_size_gib"].(int); ok && v != 0 {
+		apiObject.RootVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["running_mode"].(string); ok && v != "" {
+		apiObject.RunningMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["running_mode_auto_stop_timeout_in_minutes"].(int); ok && v != 0 {
+		apiObject.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["user_volume_size_gib"].(int); ok && v != 0 {
+		apiObject.UserVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenWorkspacesWorkspaceProperties(apiObject *workspaces.WorkspaceProperties) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"compute_type_name":                         aws.StringValue(apiObject.ComputeTypeName),
+		"root_volume_size_gib":                      int(aws.Int64Value(apiObject.RootVolumeSizeGib)),
+		"running_mode":                              aws.StringValue(apiObject.RunningMode),
+		"running_mode_auto_stop_timeout_in_minutes": int(aws.Int64Value(apiObject.RunningModeAutoStopTimeoutInMinutes)),
+		"user_volume_size_gib":                      int(aws.Int64Value(apiObject.UserVolumeSizeGib)),
+	}
+
+	return []interface{}{tfMap}
+}
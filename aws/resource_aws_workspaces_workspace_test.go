@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_workspaces_workspace", &sweep.Sweeper{
+		Name: "aws_workspaces_workspace",
+		F:    testSweepWorkspacesWorkspaces,
+	})
+}
+
+func testSweepWorkspacesWorkspaces(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).workspacesconn
+	input := &workspaces.DescribeWorkspacesInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.DescribeWorkspaces(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpace sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing WorkSpaces: %s", err))
+			return sweeperErrs
+		}
+
+		for _, workspace := range output.Workspaces {
+			id := aws.StringValue(workspace.WorkspaceId)
+
+			if state := aws.StringValue(workspace.State); state == workspaces.WorkspaceStateTerminating || state == workspaces.WorkspaceStateTerminated {
+				continue
+			}
+
+			log.Printf("[INFO] Terminating WorkSpace: %s", id)
+			terminateOutput, err := conn.TerminateWorkspaces(&workspaces.TerminateWorkspacesInput{
+				TerminateWorkspaceRequests: []*workspaces.TerminateRequest{
+					{
+						WorkspaceId: aws.String(id),
+					},
+				},
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error terminating WorkSpace (%s): %s", id, err))
+				continue
+			}
+
+			if len(terminateOutput.FailedRequests) > 0 {
+				failedRequest := terminateOutput.FailedRequests[0]
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error terminating WorkSpace (%s): %s: %s", id, aws.StringValue(failedRequest.ErrorCode), aws.StringValue(failedRequest.ErrorMessage)))
+				continue
+			}
+
+			if testSweepDryRun() {
+				continue
+			}
+
+			if _, err := waiter.WorkspaceTerminated(conn, id, waiter.WorkspaceTerminatedTimeout); err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error waiting for WorkSpace (%s) to be terminated: %s", id, err))
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+// A WorkSpace is provisioned per directory user, so these tests are not run in parallel.
+
+func TestAccAwsWorkspacesWorkspace_basic(t *testing.T) {
+	var workspace workspaces.Workspace
+	resourceName := "aws_workspaces_workspace.test"
+	bundleDataSourceName := "data.aws_workspaces_bundle.test"
+	directoryID := testAccAwsWorkspacesDirectoryID(t)
+	userName := testAccAwsWorkspacesUserName(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspacesWorkspaceConfigBasic(directoryID, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttrPair(resourceName, "bundle_id", bundleDataSourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", workspaces.WorkspaceStateAvailable),
+					resource.TestCheckResourceAttr(resourceName, "user_name", userName),
+					resource.TestCheckResourceAttr(resourceName, "user_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", workspaces.RunningModeAlwaysOn),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.TerraformProviderAwsTest", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspacesWorkspaceConfigAutoStop(directoryID, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", workspaces.RunningModeAutoStop),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "120"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWorkspacesWorkspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_workspace" {
+			continue
+		}
+
+		workspace, err := finder.WorkspaceByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(workspace.State) == workspaces.WorkspaceStateTerminated {
+			continue
+		}
+
+		return fmt.Errorf("WorkSpace %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesWorkspaceExists(n string, v *workspaces.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpace ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		workspace, err := finder.WorkspaceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *workspace
+
+		return nil
+	}
+}
+
+// testAccAwsWorkspacesUserName returns the name of a user in the WorkSpaces
+// directory without a WorkSpace, skipping the test if unset.
+// testAccAwsWorkspacesDirectoryID returns the identifier of a directory
+// registered with WorkSpaces, skipping the test if unset.
+func testAccAwsWorkspacesDirectoryID(t *testing.T) string {
+	v := os.Getenv("WORKSPACES_DIRECTORY_ID")
+
+	if v == "" {
+		t.Skip("Environment variable WORKSPACES_DIRECTORY_ID is not set")
+	}
+
+	return v
+}
+
+func testAccAwsWorkspacesUserName(t *testing.T) string {
+	v := os.Getenv("WORKSPACES_USER_NAME")
+
+	if v == "" {
+		t.Skip("Environment variable WORKSPACES_USER_NAME is not set")
+	}
+
+	return v
+}
+
+func testAccWorkspacesWorkspaceConfigBasic(directoryID, userName string) string {
+	return fmt.Sprintf(`
+data "aws_workspaces_bundle" "test" {
+  bundle_id = "wsb-b0s22j3d7"
+}
+
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = %[1]q
+  user_name    = %[2]q
+
+  workspace_properties {
+    running_mode = "ALWAYS_ON"
+  }
+
+  tags = {
+    TerraformProviderAwsTest = true
+  }
+}
+`, directoryID, userName)
+}
+
+func testAccWorkspacesWorkspaceConfigAutoStop(directoryID, userName string) string {
+	return fmt.Sprintf(`
+data "aws_workspaces_bundle" "test" {
+  bundle_id = "wsb-b0s22j3d7"
+}
+
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = %[1]q
+  user_name    = %[2]q
+
+  workspace_properties {
+    running_mode                              = "AUTO_STOP"
+    running_mode_auto_stop_timeout_in_minutes = 120
+  }
+
+  tags = {
+    TerraformProviderAwsTest = true
+  }
+}
+`, directoryID, userName)
+}
@@ -3,17 +3,16 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
+	"reflect"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/elbv2"
-	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // tagsSchema returns the schema to use for tags.
@@ -41,341 +40,221 @@ func tagsSchemaForceNew() *schema.Schema {
 	}
 }
 
-func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			var tagKeys []*string
-			for _, tag := range remove {
-				tagKeys = append(tagKeys, tag.Key)
-			}
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				TagKeys:      tagKeys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			_, err := conn.AddTags(&elbv2.AddTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+// tagsSchemaTrulyComputed returns the schema to use for the tags_all
+// attribute, which holds the resource tags merged onto the provider
+// default_tags and is set by setTagsDiff.
+func tagsSchemaTrulyComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
 	}
-
-	return nil
 }
 
-func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("volume_tags") {
-		oraw, nraw := d.GetChange("volume_tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
-		if err != nil {
-			return err
-		}
+// tagsSchemaTrulyComputedForceNew returns the schema to use for the tags_all
+// attribute of resources whose tags cannot be updated in place.
+func tagsSchemaTrulyComputedForceNew() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		ForceNew: true,
+	}
+}
 
-		if len(remove) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing volume tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: volumeIds,
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Removing volume tags: %#v from %s", remove, d.Id())
-					_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-						Resources: volumeIds,
-						Tags:      remove,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating vol tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: volumeIds,
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Creating vol tags: %s for %s", create, d.Id())
-					_, err := conn.CreateTags(&ec2.CreateTagsInput{
-						Resources: volumeIds,
-						Tags:      create,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
-			}
-		}
+// mergeDefaultTags returns the given resource tags merged onto the provider
+// default_tags. Resource tags take precedence over default tags with the
+// same key.
+func (c *AWSClient) mergeDefaultTags(tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(c.defaultTags)+len(tags))
+	for k, v := range c.defaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
 	}
 
-	return nil
+	return result
 }
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-					_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-						Resources: []*string{aws.String(d.Id())},
-						Tags:      remove,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-					_, err := conn.CreateTags(&ec2.CreateTagsInput{
-						Resources: []*string{aws.String(d.Id())},
-						Tags:      create,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
+// removeDefaultTags returns the given remote tags without the provider
+// default_tags, so that they do not show as a difference against the
+// resource configuration. Tags whose key is also present in configured, or
+// whose value differs from the default, are kept.
+func (c *AWSClient) removeDefaultTags(tags map[string]string, configured map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if _, ok := configured[k]; !ok {
+			if dv, ok := c.defaultTags[k]; ok && dv.(string) == v {
+				continue
 			}
 		}
+		result[k] = v
 	}
 
-	return nil
+	return result
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+// tagIgnoredByConfig returns whether the given tag key matches the provider
+// ignore_tags configuration.
+func (c *AWSClient) tagIgnoredByConfig(k string) bool {
+	for _, key := range c.ignoreTagKeys {
+		if k == key {
+			return true
+		}
 	}
 
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
+	for _, prefix := range c.ignoreTagKeyPrefixes {
+		if strings.HasPrefix(k, prefix) {
+			return true
 		}
 	}
 
-	return tagsFromMap(create), remove
+	return false
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ec2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
+// removeIgnoredTags returns the given remote tags without those matching the
+// provider ignore_tags configuration, so that tags managed outside of
+// Terraform do not show as a difference.
+func (c *AWSClient) removeIgnoredTags(tags map[string]string) map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if c.tagIgnoredByConfig(k) {
+			log.Printf("[DEBUG] Found tag %s matching ignore_tags, ignoring.", k)
+			continue
 		}
+		result[k] = v
 	}
 
 	return result
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
-		}
+// setTagsDiff is a CustomizeDiffFunc which sets the planned value of the
+// tags_all attribute to the resource tags merged onto the provider
+// default_tags. Resources must use tags_all when creating or updating tags.
+func setTagsDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
 	}
 
-	return result
-}
+	allTags := meta.(*AWSClient).mergeDefaultTags(diff.Get("tags").(map[string]interface{}))
 
-func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+	if reflect.DeepEqual(allTags, diff.Get("tags_all").(map[string]interface{})) {
+		return nil
 	}
 
-	// Build the list of what to remove
-	var remove []*elbv2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+	if err := diff.SetNew("tags_all", allTags); err != nil {
+		return fmt.Errorf("error setting tags_all diff: %s", err)
 	}
 
-	return tagsFromMapELBv2(create), remove
+	return nil
 }
 
-// tagsToMapELBv2 turns the list of tags into a map.
-func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
-			result[*t.Key] = *t.Value
-		}
+// setTagsAll sets the tags and tags_all attributes from the tags applied to
+// the remote resource. Tags matching the provider ignore_tags are dropped
+// first. tags_all receives every remaining tag, while provider default_tags
+// are removed from tags unless overridden in the resource configuration.
+func setTagsAll(d *schema.ResourceData, meta interface{}, tags map[string]string) error {
+	client := meta.(*AWSClient)
+	tags = client.removeIgnoredTags(tags)
+
+	if err := d.Set("tags", client.removeDefaultTags(tags, d.Get("tags").(map[string]interface{}))); err != nil {
+		return err
 	}
 
-	return result
+	return d.Set("tags_all", tags)
 }
 
-// tagsFromMapELBv2 returns the tags for the given map of data.
-func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
-	var result []*elbv2.Tag
-	for k, v := range m {
-		t := &elbv2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
+func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+	if d.HasChange("volume_tags") {
+		o, n := d.GetChange("volume_tags")
+
+		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
+		if err != nil {
+			return err
 		}
-		if !tagIgnoredELBv2(t) {
-			result = append(result, t)
+
+		if err := ec2UpdateTagsWithRetry(conn, volumeIds, o, n, 2*time.Minute); err != nil {
+			return fmt.Errorf("error updating volume tags for %s: %s", d.Id(), err)
 		}
 	}
 
-	return result
+	return nil
 }
 
-// tagIgnored compares a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
+// setTags is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags_all"
+func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := ec2UpdateTagsWithRetry(conn, []*string{aws.String(d.Id())}, o, n, 5*time.Minute); err != nil {
+			return err
 		}
 	}
-	return false
+
+	return nil
 }
 
-// and for ELBv2 as well
-func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
+// ec2UpdateTagsWithRetry updates the tags of the given EC2 resources,
+// retrying while they are not yet visible to the tagging API.
+func ec2UpdateTagsWithRetry(conn *ec2.EC2, resources []*string, oldTagsMap, newTagsMap interface{}, timeout time.Duration) error {
+	oldTags := keyvaluetags.New(oldTagsMap)
+	newTags := keyvaluetags.New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &ec2.DeleteTagsInput{
+			Resources: resources,
+			Tags:      removedTags.Ec2Tags(),
+		}
+
+		log.Printf("[DEBUG] Removing tags: %s", input)
+		err := retryEc2Tagging(timeout, func() error {
+			_, err := conn.DeleteTags(input)
+			return err
+		})
+		if err != nil {
+			return err
 		}
 	}
-	return false
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &ec2.CreateTagsInput{
+			Resources: resources,
+			Tags:      updatedTags.Ec2Tags(),
+		}
+
+		log.Printf("[DEBUG] Creating tags: %s", input)
+		err := retryEc2Tagging(timeout, func() error {
+			_, err := conn.CreateTags(input)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// tagsMapToHash returns a stable hash value for a raw tags map.
-// The returned value map be negative (i.e. not suitable for a 'Set' function).
-func tagsMapToHash(tags map[string]interface{}) int {
-	total := 0
-	for k, v := range tags {
-		total = total ^ hashcode.String(fmt.Sprintf("%s-%s", k, v))
+// retryEc2Tagging retries the given EC2 tagging operation on .NotFound errors,
+// which occur while newly created resources propagate.
+func retryEc2Tagging(timeout time.Duration, f func() error) error {
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		err := f()
+		if err != nil {
+			ec2err, ok := err.(awserr.Error)
+			if ok && strings.Contains(ec2err.Code(), ".NotFound") {
+				return resource.RetryableError(err) // retry
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	// Retry without time bounds for EC2 throttling
+	if isResourceTimeoutError(err) {
+		err = f()
 	}
-	return total
+
+	return err
 }
 
 // tagsMapToRaw converts a tags map to its "raw" type.
@@ -397,7 +276,7 @@ func ec2TagSpecificationsFromMap(m map[string]interface{}, t string) []*ec2.TagS
 	return []*ec2.TagSpecification{
 		{
 			ResourceType: aws.String(t),
-			Tags:         tagsFromMap(m),
+			Tags:         keyvaluetags.New(m).IgnoreAws().Ec2Tags(),
 		},
 	}
 }
@@ -5,153 +5,173 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-func TestDiffTags(t *testing.T) {
+func TestMergeDefaultTags(t *testing.T) {
 	cases := []struct {
-		Old, New       map[string]interface{}
-		Create, Remove map[string]string
+		DefaultTags, Tags, Expected map[string]interface{}
 	}{
-		// Add
+		// No default tags
 		{
-			Old: map[string]interface{}{
+			DefaultTags: nil,
+			Tags: map[string]interface{}{
 				"foo": "bar",
 			},
-			New: map[string]interface{}{
+			Expected: map[string]interface{}{
 				"foo": "bar",
-				"bar": "baz",
 			},
-			Create: map[string]string{
-				"bar": "baz",
-			},
-			Remove: map[string]string{},
 		},
 
-		// Modify
+		// No resource tags
 		{
-			Old: map[string]interface{}{
+			DefaultTags: map[string]interface{}{
 				"foo": "bar",
 			},
-			New: map[string]interface{}{
-				"foo": "baz",
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"foo": "bar",
 			},
-			Create: map[string]string{
+		},
+
+		// Resource tags override default tags
+		{
+			DefaultTags: map[string]interface{}{
+				"foo":   "bar",
+				"hello": "world",
+			},
+			Tags: map[string]interface{}{
 				"foo": "baz",
 			},
-			Remove: map[string]string{
-				"foo": "bar",
+			Expected: map[string]interface{}{
+				"foo":   "baz",
+				"hello": "world",
 			},
 		},
+	}
 
-		// Overlap
+	for i, tc := range cases {
+		client := &AWSClient{defaultTags: tc.DefaultTags}
+		actual := client.mergeDefaultTags(tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad merged tags: %#v", i, actual)
+		}
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	cases := []struct {
+		DefaultTags, Configured map[string]interface{}
+		Tags, Expected          map[string]string
+	}{
+		// Default tag not configured on the resource
 		{
-			Old: map[string]interface{}{
+			DefaultTags: map[string]interface{}{
+				"foo": "bar",
+			},
+			Configured: map[string]interface{}{
+				"hello": "world",
+			},
+			Tags: map[string]string{
 				"foo":   "bar",
 				"hello": "world",
 			},
-			New: map[string]interface{}{
-				"foo":   "baz",
+			Expected: map[string]string{
 				"hello": "world",
 			},
-			Create: map[string]string{
-				"foo": "baz",
+		},
+
+		// Default tag also configured on the resource
+		{
+			DefaultTags: map[string]interface{}{
+				"foo": "bar",
 			},
-			Remove: map[string]string{
+			Configured: map[string]interface{}{
+				"foo": "bar",
+			},
+			Tags: map[string]string{
+				"foo": "bar",
+			},
+			Expected: map[string]string{
 				"foo": "bar",
 			},
 		},
 
-		// Remove
+		// Default tag modified outside of Terraform
 		{
-			Old: map[string]interface{}{
+			DefaultTags: map[string]interface{}{
 				"foo": "bar",
-				"bar": "baz",
 			},
-			New: map[string]interface{}{
-				"foo": "bar",
+			Configured: map[string]interface{}{},
+			Tags: map[string]string{
+				"foo": "baz",
 			},
-			Create: map[string]string{},
-			Remove: map[string]string{
-				"bar": "baz",
+			Expected: map[string]string{
+				"foo": "baz",
 			},
 		},
 	}
 
 	for i, tc := range cases {
-		c, r := diffTags(tagsFromMap(tc.Old), tagsFromMap(tc.New))
-		cm := tagsToMap(c)
-		rm := tagsToMap(r)
-		if !reflect.DeepEqual(cm, tc.Create) {
-			t.Fatalf("%d: bad create: %#v", i, cm)
-		}
-		if !reflect.DeepEqual(rm, tc.Remove) {
-			t.Fatalf("%d: bad remove: %#v", i, rm)
+		client := &AWSClient{defaultTags: tc.DefaultTags}
+		actual := client.removeDefaultTags(tc.Tags, tc.Configured)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad tags: %#v", i, actual)
 		}
 	}
 }
 
-func TestIgnoringTags(t *testing.T) {
-	var ignoredTags []*ec2.Tag
-	ignoredTags = append(ignoredTags, &ec2.Tag{
-
-		Key:   aws.String("aws:cloudformation:logical-id"),
-		Value: aws.String("foo"),
-	})
-	ignoredTags = append(ignoredTags, &ec2.Tag{
-		Key:   aws.String("aws:foo:bar"),
-		Value: aws.String("baz"),
-	})
-	for _, tag := range ignoredTags {
-		if !tagIgnored(tag) {
-			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
-		}
-	}
-}
-
-func TestTagsMapToHash(t *testing.T) {
+func TestRemoveIgnoredTags(t *testing.T) {
 	cases := []struct {
-		Left, Right map[string]interface{}
-		MustBeEqual bool
+		IgnoreTagKeys, IgnoreTagKeyPrefixes []string
+		Tags, Expected                      map[string]string
 	}{
+		// No ignore_tags configuration
 		{
-			Left:        map[string]interface{}{},
-			Right:       map[string]interface{}{},
-			MustBeEqual: true,
-		},
-		{
-			Left: map[string]interface{}{
+			Tags: map[string]string{
 				"foo": "bar",
-				"bar": "baz",
 			},
-			Right: map[string]interface{}{
-				"bar": "baz",
+			Expected: map[string]string{
 				"foo": "bar",
 			},
-			MustBeEqual: true,
 		},
+
+		// Ignored key
+		{
+			IgnoreTagKeys: []string{"foo"},
+			Tags: map[string]string{
+				"foo":    "bar",
+				"foobar": "baz",
+			},
+			Expected: map[string]string{
+				"foobar": "baz",
+			},
+		},
+
+		// Ignored key prefix
 		{
-			Left: map[string]interface{}{
-				"foo": "bar",
+			IgnoreTagKeyPrefixes: []string{"kubernetes.io/"},
+			Tags: map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"Name":                       "test",
 			},
-			Right: map[string]interface{}{
-				"bar": "baz",
+			Expected: map[string]string{
+				"Name": "test",
 			},
-			MustBeEqual: false,
 		},
 	}
 
 	for i, tc := range cases {
-		l := tagsMapToHash(tc.Left)
-		r := tagsMapToHash(tc.Right)
-		if tc.MustBeEqual && (l != r) {
-			t.Fatalf("%d: Hashes don't match", i)
+		client := &AWSClient{
+			ignoreTagKeys:        tc.IgnoreTagKeys,
+			ignoreTagKeyPrefixes: tc.IgnoreTagKeyPrefixes,
 		}
-		if !tc.MustBeEqual && (l == r) {
-			t.Logf("%d: Hashes match", i)
+		actual := client.removeIgnoredTags(tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad tags: %#v", i, actual)
 		}
 	}
 }
@@ -160,7 +180,7 @@ func TestTagsMapToHash(t *testing.T) {
 func testAccCheckTags(
 	ts *[]*ec2.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMap(*ts)
+		m := keyvaluetags.Ec2KeyValueTags(*ts).IgnoreAws().Map()
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
@@ -1401,6 +1401,22 @@ func validateOpenIdURL(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+func validateHTTPProxyURL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	u, err := url.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q has to be a valid URL", k))
+		return
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		errors = append(errors, fmt.Errorf("%q has to use HTTP or HTTPS scheme (i.e. begin with http:// or https://)", k))
+	}
+	if u.Host == "" {
+		errors = append(errors, fmt.Errorf("%q has to contain a host", k))
+	}
+	return
+}
+
 func validateAwsKmsName(v interface{}, k string) (ws []string, es []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^(alias\/)[a-zA-Z0-9:/_-]+$`).MatchString(value) {